@@ -0,0 +1,316 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// fixture-coverage ties conformance vectors to the consensus API they
+// exercise. It reads three inputs:
+//
+//   - the exported top-level functions of the consensus package (the API
+//     surface, parsed from source);
+//   - the rubin-consensus-cli dispatcher, where each `case "<op>":` of the
+//     runFromStdin switch is resolved to the consensus.* functions it
+//     references, directly or through package-local helpers;
+//   - the ops named by every CV-*.json vector.
+//
+// A consensus function is covered when at least one vector's op reaches
+// it from the CLI. Calls made inside the consensus package are not
+// followed, so a wrapper that fixtures never invoke directly is reported
+// even if the function it wraps is covered. The report lists uncovered
+// functions and fixture ops the dispatcher does not handle.
+
+const consensusImportPath = "github.com/2tbmz9y2xt-lang/rubin-protocol/clients/go/consensus"
+
+type report struct {
+	Exported     []string
+	Covered      map[string][]string // consensus func -> ops reaching it
+	Uncovered    []string
+	VectorsPerOp map[string]int
+	UnmappedOps  []string
+}
+
+// parsePackageDir parses the non-test Go files of one directory.
+func parsePackageDir(dir string) (*token.FileSet, []*ast.File, error) {
+	matches, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, nil, err
+	}
+	sort.Strings(matches)
+	fset := token.NewFileSet()
+	var files []*ast.File
+	for _, path := range matches {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, path, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, nil, err
+		}
+		files = append(files, f)
+	}
+	if len(files) == 0 {
+		return nil, nil, fmt.Errorf("no Go files in %s", dir)
+	}
+	return fset, files, nil
+}
+
+// exportedFuncs lists exported package-level functions (methods excluded).
+func exportedFuncs(dir string) ([]string, error) {
+	_, files, err := parsePackageDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	seen := map[string]bool{}
+	for _, f := range files {
+		for _, decl := range f.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv != nil || !fn.Name.IsExported() {
+				continue
+			}
+			seen[fn.Name.Name] = true
+		}
+	}
+	out := make([]string, 0, len(seen))
+	for name := range seen {
+		out = append(out, name)
+	}
+	sort.Strings(out)
+	return out, nil
+}
+
+// refs records what one body of code references: consensus.* selectors
+// and calls to package-local functions.
+type refs struct {
+	consensus map[string]bool
+	local     map[string]bool
+}
+
+func collectRefs(node ast.Node, alias string, localFuncs map[string]*ast.FuncDecl) refs {
+	r := refs{consensus: map[string]bool{}, local: map[string]bool{}}
+	ast.Inspect(node, func(n ast.Node) bool {
+		switch x := n.(type) {
+		case *ast.SelectorExpr:
+			if id, ok := x.X.(*ast.Ident); ok && id.Name == alias {
+				r.consensus[x.Sel.Name] = true
+			}
+		case *ast.Ident:
+			if _, ok := localFuncs[x.Name]; ok {
+				r.local[x.Name] = true
+			}
+		}
+		return true
+	})
+	return r
+}
+
+// opFunctions maps each op handled by the CLI dispatcher to the consensus
+// identifiers it reaches, following package-local helpers transitively.
+func opFunctions(cliDir string) (map[string]map[string]bool, error) {
+	_, files, err := parsePackageDir(cliDir)
+	if err != nil {
+		return nil, err
+	}
+
+	alias := ""
+	localFuncs := map[string]*ast.FuncDecl{}
+	for _, f := range files {
+		for _, imp := range f.Imports {
+			path, _ := strconv.Unquote(imp.Path.Value)
+			if path != consensusImportPath {
+				continue
+			}
+			alias = "consensus"
+			if imp.Name != nil {
+				alias = imp.Name.Name
+			}
+		}
+		for _, decl := range f.Decls {
+			if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv == nil {
+				localFuncs[fn.Name.Name] = fn
+			}
+		}
+	}
+	if alias == "" {
+		return nil, fmt.Errorf("%s does not import %s", cliDir, consensusImportPath)
+	}
+	dispatch, ok := localFuncs["runFromStdin"]
+	if !ok {
+		return nil, fmt.Errorf("%s: runFromStdin not found", cliDir)
+	}
+
+	// Per-helper closure, memoised; inProgress breaks recursion cycles.
+	closure := map[string]map[string]bool{}
+	inProgress := map[string]bool{}
+	var resolve func(name string) map[string]bool
+	resolve = func(name string) map[string]bool {
+		if c, ok := closure[name]; ok {
+			return c
+		}
+		if inProgress[name] {
+			return nil
+		}
+		inProgress[name] = true
+		r := collectRefs(localFuncs[name].Body, alias, localFuncs)
+		out := r.consensus
+		for callee := range r.local {
+			for fn := range resolve(callee) {
+				out[fn] = true
+			}
+		}
+		delete(inProgress, name)
+		closure[name] = out
+		return out
+	}
+
+	ops := map[string]map[string]bool{}
+	ast.Inspect(dispatch.Body, func(n ast.Node) bool {
+		sw, ok := n.(*ast.SwitchStmt)
+		if !ok || !isReqOp(sw.Tag) {
+			return true
+		}
+		for _, stmt := range sw.Body.List {
+			cc := stmt.(*ast.CaseClause)
+			r := collectRefs(&ast.BlockStmt{List: cc.Body}, alias, localFuncs)
+			fns := r.consensus
+			for callee := range r.local {
+				for fn := range resolve(callee) {
+					fns[fn] = true
+				}
+			}
+			for _, e := range cc.List {
+				lit, ok := e.(*ast.BasicLit)
+				if !ok || lit.Kind != token.STRING {
+					continue
+				}
+				op, err := strconv.Unquote(lit.Value)
+				if err != nil {
+					continue
+				}
+				ops[op] = fns
+			}
+		}
+		return false
+	})
+	if len(ops) == 0 {
+		return nil, fmt.Errorf("%s: no `switch req.Op` cases found in runFromStdin", cliDir)
+	}
+	return ops, nil
+}
+
+func isReqOp(tag ast.Expr) bool {
+	sel, ok := tag.(*ast.SelectorExpr)
+	return ok && sel.Sel.Name == "Op"
+}
+
+// fixtureOps counts vectors per op across every CV-*.json in dir.
+func fixtureOps(dir string) (map[string]int, error) {
+	matches, err := filepath.Glob(filepath.Join(dir, "CV-*.json"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(matches)
+	counts := map[string]int{}
+	for _, path := range matches {
+		b, err := fs.ReadFile(os.DirFS(filepath.Dir(path)), filepath.Base(path))
+		if err != nil {
+			return nil, err
+		}
+		var fx struct {
+			Vectors []struct {
+				Op string `json:"op"`
+			} `json:"vectors"`
+		}
+		if err := json.Unmarshal(b, &fx); err != nil {
+			return nil, fmt.Errorf("parse %s: %w", path, err)
+		}
+		for _, v := range fx.Vectors {
+			if v.Op != "" {
+				counts[v.Op]++
+			}
+		}
+	}
+	if len(counts) == 0 {
+		return nil, fmt.Errorf("no fixture vectors with an op under %s", dir)
+	}
+	return counts, nil
+}
+
+func buildReport(fixturesDir, consensusDir, cliDir string) (*report, error) {
+	exported, err := exportedFuncs(consensusDir)
+	if err != nil {
+		return nil, fmt.Errorf("consensus API: %w", err)
+	}
+	ops, err := opFunctions(cliDir)
+	if err != nil {
+		return nil, fmt.Errorf("cli dispatcher: %w", err)
+	}
+	vectors, err := fixtureOps(fixturesDir)
+	if err != nil {
+		return nil, fmt.Errorf("fixtures: %w", err)
+	}
+
+	rep := &report{Exported: exported, Covered: map[string][]string{}, VectorsPerOp: vectors}
+	for op := range vectors {
+		fns, ok := ops[op]
+		if !ok {
+			rep.UnmappedOps = append(rep.UnmappedOps, op)
+			continue
+		}
+		for fn := range fns {
+			rep.Covered[fn] = append(rep.Covered[fn], op)
+		}
+	}
+	for _, ops := range rep.Covered {
+		sort.Strings(ops)
+	}
+	sort.Strings(rep.UnmappedOps)
+	for _, fn := range exported {
+		if _, ok := rep.Covered[fn]; !ok {
+			rep.Uncovered = append(rep.Uncovered, fn)
+		}
+	}
+	return rep, nil
+}
+
+func (r *report) write(w io.Writer) error {
+	covered := len(r.Exported) - len(r.Uncovered)
+	lines := []string{fmt.Sprintf("covered: %d/%d exported consensus functions", covered, len(r.Exported))}
+	for _, fn := range r.Uncovered {
+		lines = append(lines, "uncovered: "+fn)
+	}
+	for _, op := range r.UnmappedOps {
+		lines = append(lines, fmt.Sprintf("unmapped op: %s (%d vectors)", op, r.VectorsPerOp[op]))
+	}
+	_, err := io.WriteString(w, strings.Join(lines, "\n")+"\n")
+	return err
+}
+
+func main() {
+	var fixturesDir, consensusDir, cliDir string
+	flag.StringVar(&fixturesDir, "fixtures-dir", "conformance/fixtures", "path to conformance fixtures dir")
+	flag.StringVar(&consensusDir, "consensus-dir", "clients/go/consensus", "path to the consensus package source")
+	flag.StringVar(&cliDir, "cli-dir", "clients/go/cmd/rubin-consensus-cli", "path to the rubin-consensus-cli source")
+	flag.Parse()
+
+	rep, err := buildReport(fixturesDir, consensusDir, cliDir)
+	if err == nil {
+		err = rep.write(os.Stdout)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func mustWriteFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		t.Fatalf("mkdir %s: %v", filepath.Dir(path), err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("write %s: %v", path, err)
+	}
+}
+
+// syntheticTree lays out a two-function consensus package, a CLI that
+// dispatches one op to ParseThing (directly) and one op to CheckThing
+// (through a local helper), and fixtures that only use the first op.
+func syntheticTree(t *testing.T) (fixturesDir, consensusDir, cliDir string) {
+	t.Helper()
+	root := t.TempDir()
+	consensusDir = filepath.Join(root, "consensus")
+	cliDir = filepath.Join(root, "cli")
+	fixturesDir = filepath.Join(root, "fixtures")
+
+	mustWriteFile(t, filepath.Join(consensusDir, "api.go"), `package consensus
+
+func ParseThing() error { return nil }
+func CheckThing() error { return nil }
+func helper() {}
+
+type T struct{}
+
+func (T) Method() {}
+`)
+	mustWriteFile(t, filepath.Join(consensusDir, "api_test.go"), `package consensus
+
+func TestOnlyExported() {}
+`)
+	mustWriteFile(t, filepath.Join(cliDir, "runtime.go"), `package main
+
+import "github.com/2tbmz9y2xt-lang/rubin-protocol/clients/go/consensus"
+
+type request struct{ Op string }
+
+func check() error { return consensus.CheckThing() }
+
+func runFromStdin() {
+	var req request
+	switch req.Op {
+	case "parse_thing":
+		_ = consensus.ParseThing()
+	case "check_thing":
+		_ = check()
+	}
+}
+`)
+	mustWriteFile(t, filepath.Join(fixturesDir, "CV-THING.json"), `{"gate":"CV-THING","vectors":[
+  {"id":"T-1","op":"parse_thing"},
+  {"id":"T-2","op":"parse_thing"},
+  {"id":"T-3","op":"retired_op"}
+]}`)
+	return fixturesDir, consensusDir, cliDir
+}
+
+func TestReportFlagsUncoveredFunction(t *testing.T) {
+	fixturesDir, consensusDir, cliDir := syntheticTree(t)
+	rep, err := buildReport(fixturesDir, consensusDir, cliDir)
+	if err != nil {
+		t.Fatalf("buildReport: %v", err)
+	}
+	if !slices.Equal(rep.Exported, []string{"CheckThing", "ParseThing"}) {
+		t.Fatalf("exported=%v", rep.Exported)
+	}
+	if !slices.Equal(rep.Uncovered, []string{"CheckThing"}) {
+		t.Fatalf("uncovered=%v, want [CheckThing]", rep.Uncovered)
+	}
+	if !slices.Equal(rep.Covered["ParseThing"], []string{"parse_thing"}) {
+		t.Fatalf("covered[ParseThing]=%v", rep.Covered["ParseThing"])
+	}
+	if !slices.Equal(rep.UnmappedOps, []string{"retired_op"}) {
+		t.Fatalf("unmapped=%v", rep.UnmappedOps)
+	}
+
+	var out bytes.Buffer
+	if err := rep.write(&out); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	want := "covered: 1/2 exported consensus functions\nuncovered: CheckThing\nunmapped op: retired_op (1 vectors)\n"
+	if out.String() != want {
+		t.Fatalf("report=%q, want %q", out.String(), want)
+	}
+}
+
+func TestReportFollowsLocalHelpers(t *testing.T) {
+	fixturesDir, consensusDir, cliDir := syntheticTree(t)
+	mustWriteFile(t, filepath.Join(fixturesDir, "CV-CHECK.json"), `{"gate":"CV-CHECK","vectors":[{"id":"C-1","op":"check_thing"}]}`)
+	rep, err := buildReport(fixturesDir, consensusDir, cliDir)
+	if err != nil {
+		t.Fatalf("buildReport: %v", err)
+	}
+	if len(rep.Uncovered) != 0 {
+		t.Fatalf("uncovered=%v, want none", rep.Uncovered)
+	}
+}
+
+func TestReportOnRepoTree(t *testing.T) {
+	rep, err := buildReport(
+		filepath.Join("..", "..", "..", "..", "conformance", "fixtures"),
+		filepath.Join("..", "..", "consensus"),
+		filepath.Join("..", "rubin-consensus-cli"),
+	)
+	if err != nil {
+		t.Fatalf("buildReport: %v", err)
+	}
+	for _, fn := range []string{"ParseTx", "SighashV1Digest"} {
+		if _, ok := rep.Covered[fn]; !ok {
+			t.Errorf("%s not covered by any fixture vector", fn)
+		}
+	}
+	if len(rep.Uncovered) >= len(rep.Exported) {
+		t.Fatalf("uncovered=%d of %d exported", len(rep.Uncovered), len(rep.Exported))
+	}
+}
+
+func TestBuildReportErrors(t *testing.T) {
+	fixturesDir, consensusDir, cliDir := syntheticTree(t)
+	empty := t.TempDir()
+	for name, dirs := range map[string][3]string{
+		"no consensus sources": {fixturesDir, empty, cliDir},
+		"no cli sources":       {fixturesDir, consensusDir, empty},
+		"no fixtures":          {empty, consensusDir, cliDir},
+	} {
+		if _, err := buildReport(dirs[0], dirs[1], dirs[2]); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+
+	noDispatch := t.TempDir()
+	mustWriteFile(t, filepath.Join(noDispatch, "main.go"), "package main\n\nimport \"github.com/2tbmz9y2xt-lang/rubin-protocol/clients/go/consensus\"\n\nvar _ = consensus.ParseThing\n")
+	if _, err := buildReport(fixturesDir, consensusDir, noDispatch); err == nil || !strings.Contains(err.Error(), "runFromStdin") {
+		t.Fatalf("err=%v, want runFromStdin not found", err)
+	}
+}
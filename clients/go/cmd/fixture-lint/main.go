@@ -0,0 +1,282 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// fixture-lint checks hand-edited CV-*.json fixtures against the shapes
+// cmd/formal-trace consumes, so a missing field or malformed hex string
+// is reported up front instead of surfacing as a silently empty trace
+// entry. Every violation across every file is reported; nothing stops
+// at the first problem.
+
+// gateShape describes the vectors of one gate: the fields each op
+// requires and the field that carries the expected error.
+type gateShape struct {
+	ErrField string
+	Ops      map[string][]string
+}
+
+// gateShapes covers the gates cmd/formal-trace decodes. Gates not listed
+// here only get the generic checks (gate name, id/op, unique ids, hex).
+var gateShapes = map[string]gateShape{
+	"CV-PARSE": {ErrField: "expect_err", Ops: map[string][]string{
+		"parse_tx": {"tx_hex"},
+	}},
+	"CV-SIGHASH": {ErrField: "expect_err", Ops: map[string][]string{
+		"sighash_v1": {"tx_hex", "chain_id", "input_index", "input_value"},
+	}},
+	"CV-POW": {ErrField: "expect_err", Ops: map[string][]string{
+		"retarget_v1": {"target_old", "timestamp_first", "timestamp_last"},
+		"block_hash":  {"header_hex"},
+		"pow_check":   {"header_hex", "target_hex"},
+	}},
+	"CV-UTXO-BASIC": {ErrField: "expect_err", Ops: map[string][]string{
+		"utxo_apply_basic": {"tx_hex", "utxos", "height", "block_timestamp"},
+	}},
+	"CV-BLOCK-BASIC": {ErrField: "expect_err", Ops: map[string][]string{
+		"block_basic_check":   {"block_hex"},
+		"connect_block_basic": {"block_hex", "height", "utxos"},
+	}},
+	"CV-WEIGHT": {ErrField: "expect_err", Ops: map[string][]string{
+		"tx_weight_and_stats": {"tx_hex"},
+		"block_basic_check":   {"height", "expected_prev_hash", "expected_target"},
+	}},
+	"CV-VALIDATION-ORDER": {ErrField: "expect_first_err", Ops: map[string][]string{
+		"validation_order": {"checks", "expect_evaluated"},
+	}},
+	"CV-DA-INTEGRITY": {ErrField: "expect_err", Ops: map[string][]string{
+		"block_basic_check": {"block_hex", "height", "prev_timestamps", "expected_prev_hash", "expected_target"},
+	}},
+	"CV-SIMPLICITY-EXEC": {ErrField: "expect_err", Ops: map[string][]string{
+		"simplicity_exec_vector": {},
+	}},
+}
+
+// hexFields lists the fields that must hold even-length hex wherever they
+// appear in a vector (including nested utxo entries). A positive length
+// pins the decoded size in bytes.
+var hexFields = map[string]int{
+	"tx_hex":             0,
+	"block_hex":          0,
+	"header_hex":         0,
+	"program_hex":        0,
+	"witness_hex":        0,
+	"covenant_data":      0,
+	"covenant_data_hex":  0,
+	"txid":               32,
+	"chain_id":           32,
+	"target_old":         32,
+	"target_hex":         32,
+	"expected_prev_hash": 32,
+	"expected_target":    32,
+	"covenant_cmr_hex":   32,
+}
+
+// decodeErrPrefix starts the expect_err of vectors that deliberately
+// carry malformed input ("bad hex", "bad chain_id") to pin a decode
+// failure path; their hex fields are not linted.
+const decodeErrPrefix = "bad "
+
+type violation struct {
+	File     string
+	VectorID string
+	Msg      string
+}
+
+func (v violation) String() string {
+	if v.VectorID == "" {
+		return fmt.Sprintf("%s: %s", v.File, v.Msg)
+	}
+	return fmt.Sprintf("%s: %s: %s", v.File, v.VectorID, v.Msg)
+}
+
+func listFixtureNames(dir string) ([]string, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("not a directory: %s", dir)
+	}
+	matches, err := filepath.Glob(filepath.Join(dir, "CV-*.json"))
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(matches))
+	for _, match := range matches {
+		info, err := os.Stat(match)
+		if err != nil {
+			return nil, err
+		}
+		if info.IsDir() {
+			continue
+		}
+		names = append(names, filepath.Base(match))
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// lintDir lints every CV-*.json fixture under dir. The returned error is
+// reserved for I/O failures; shape problems come back as violations.
+func lintDir(dir string) ([]violation, error) {
+	names, err := listFixtureNames(dir)
+	if err != nil {
+		return nil, err
+	}
+	var out []violation
+	for _, name := range names {
+		b, err := fs.ReadFile(os.DirFS(dir), name)
+		if err != nil {
+			return nil, fmt.Errorf("read %s: %w", filepath.Join(dir, name), err)
+		}
+		out = append(out, lintFixture(name, b)...)
+	}
+	return out, nil
+}
+
+func lintFixture(name string, b []byte) []violation {
+	var fx struct {
+		Gate    string           `json:"gate"`
+		Vectors []map[string]any `json:"vectors"`
+	}
+	if err := json.Unmarshal(b, &fx); err != nil {
+		return []violation{{File: name, Msg: fmt.Sprintf("invalid JSON: %v", err)}}
+	}
+
+	var out []violation
+	add := func(id, format string, args ...any) {
+		out = append(out, violation{File: name, VectorID: id, Msg: fmt.Sprintf(format, args...)})
+	}
+
+	if want := strings.TrimSuffix(name, ".json"); fx.Gate != want {
+		add("", "gate %q does not match file name (want %q)", fx.Gate, want)
+	}
+	if len(fx.Vectors) == 0 {
+		add("", "no vectors")
+	}
+	shape, known := gateShapes[fx.Gate]
+
+	seen := map[string]bool{}
+	for i, v := range fx.Vectors {
+		id, _ := v["id"].(string)
+		if id == "" {
+			add(fmt.Sprintf("vectors[%d]", i), "missing required field \"id\"")
+			id = fmt.Sprintf("vectors[%d]", i)
+		} else if seen[id] {
+			add(id, "duplicate vector id")
+		}
+		seen[id] = true
+
+		op, _ := v["op"].(string)
+		if op == "" {
+			add(id, "missing required field \"op\"")
+		}
+
+		if expectErr, _ := v["expect_err"].(string); !strings.HasPrefix(expectErr, decodeErrPrefix) {
+			lintHex(v, func(field, msg string) { add(id, "%s: %s", field, msg) })
+		}
+
+		if !known {
+			continue
+		}
+		required, opKnown := shape.Ops[op]
+		if !opKnown && op != "" {
+			add(id, "op %q is not handled for gate %s", op, fx.Gate)
+		}
+		for _, field := range required {
+			if _, ok := v[field]; !ok {
+				add(id, "missing required field %q for op %s", field, op)
+			}
+		}
+
+		expectOk, isBool := v["expect_ok"].(bool)
+		errVal, _ := v[shape.ErrField].(string)
+		switch {
+		case !isBool:
+			add(id, "expect_ok must be a boolean")
+		case expectOk && errVal != "":
+			add(id, "expect_ok=true but %s is set", shape.ErrField)
+		case !expectOk && errVal == "":
+			add(id, "expect_ok=false requires a non-empty %s", shape.ErrField)
+		}
+	}
+	return out
+}
+
+// lintHex walks a vector and reports every hexFields entry that is not a
+// string of well-formed hex (of the pinned size, where one is set).
+func lintHex(v any, report func(field, msg string)) {
+	switch x := v.(type) {
+	case map[string]any:
+		keys := make([]string, 0, len(x))
+		for k := range x {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			val := x[k]
+			size, isHex := hexFields[k]
+			if !isHex {
+				lintHex(val, report)
+				continue
+			}
+			s, ok := val.(string)
+			if !ok {
+				report(k, "must be a hex string")
+				continue
+			}
+			raw, err := hex.DecodeString(s)
+			if err != nil {
+				report(k, fmt.Sprintf("malformed hex: %v", err))
+				continue
+			}
+			if size > 0 && len(raw) != size {
+				report(k, fmt.Sprintf("want %d bytes, got %d", size, len(raw)))
+			}
+		}
+	case []any:
+		for _, item := range x {
+			lintHex(item, report)
+		}
+	}
+}
+
+func run(dir string, w io.Writer) (int, error) {
+	violations, err := lintDir(dir)
+	if err != nil {
+		return 0, err
+	}
+	for _, v := range violations {
+		if _, err := fmt.Fprintln(w, v.String()); err != nil {
+			return 0, err
+		}
+	}
+	return len(violations), nil
+}
+
+func main() {
+	var fixturesDir string
+	flag.StringVar(&fixturesDir, "fixtures-dir", "conformance/fixtures", "path to conformance fixtures dir")
+	flag.Parse()
+
+	n, err := run(fixturesDir, os.Stdout)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	if n > 0 {
+		fmt.Fprintf(os.Stderr, "fixture-lint: %d violation(s)\n", n)
+		os.Exit(1)
+	}
+}
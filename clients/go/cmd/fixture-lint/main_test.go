@@ -0,0 +1,121 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeFixture(t *testing.T, dir, name, content string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+		t.Fatalf("write %s: %v", name, err)
+	}
+}
+
+func TestLintReportsMissingTxHexInParseVector(t *testing.T) {
+	dir := t.TempDir()
+	writeFixture(t, dir, "CV-PARSE.json", `{
+  "gate": "CV-PARSE",
+  "vectors": [
+    {"id": "CV-PARSE-01", "op": "parse_tx", "tx_hex": "00", "expect_ok": false, "expect_err": "TX_ERR_PARSE"},
+    {"id": "CV-PARSE-02", "op": "parse_tx", "expect_ok": false, "expect_err": "TX_ERR_PARSE"}
+  ]
+}`)
+
+	var out bytes.Buffer
+	n, err := run(dir, &out)
+	if err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	if n != 1 {
+		t.Fatalf("violations=%d, want 1; output:\n%s", n, out.String())
+	}
+	want := `CV-PARSE.json: CV-PARSE-02: missing required field "tx_hex" for op parse_tx`
+	if got := strings.TrimSpace(out.String()); got != want {
+		t.Fatalf("output=%q, want %q", got, want)
+	}
+}
+
+func TestLintReportsAllViolations(t *testing.T) {
+	dir := t.TempDir()
+	writeFixture(t, dir, "CV-UTXO-BASIC.json", `{
+  "gate": "CV-UTXO-BASIC",
+  "vectors": [
+    {"id": "U-1", "op": "utxo_apply_basic", "tx_hex": "0g", "utxos": [{"txid": "aa", "covenant_data": ""}],
+     "height": 1, "block_timestamp": 1, "expect_ok": true, "expect_err": "TX_ERR_PARSE"},
+    {"id": "U-1", "op": "utxo_apply_basic", "tx_hex": "00", "utxos": [], "height": 1, "block_timestamp": 1, "expect_ok": false},
+    {"id": "U-3", "op": "mystery", "expect_ok": "yes"}
+  ]
+}`)
+	writeFixture(t, dir, "CV-OTHER.json", `{"gate": "CV-RENAMED", "vectors": [{"id": "X-1"}]}`)
+
+	violations, err := lintDir(dir)
+	if err != nil {
+		t.Fatalf("lintDir: %v", err)
+	}
+	var got []string
+	for _, v := range violations {
+		got = append(got, v.String())
+	}
+	for _, want := range []string{
+		`CV-OTHER.json: gate "CV-RENAMED" does not match file name (want "CV-OTHER")`,
+		`CV-OTHER.json: X-1: missing required field "op"`,
+		`CV-UTXO-BASIC.json: U-1: tx_hex: malformed hex`,
+		`CV-UTXO-BASIC.json: U-1: txid: want 32 bytes, got 1`,
+		`CV-UTXO-BASIC.json: U-1: expect_ok=true but expect_err is set`,
+		`CV-UTXO-BASIC.json: U-1: duplicate vector id`,
+		`CV-UTXO-BASIC.json: U-1: expect_ok=false requires a non-empty expect_err`,
+		`CV-UTXO-BASIC.json: U-3: op "mystery" is not handled for gate CV-UTXO-BASIC`,
+		`CV-UTXO-BASIC.json: U-3: expect_ok must be a boolean`,
+	} {
+		found := false
+		for _, g := range got {
+			if strings.HasPrefix(g, want) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Errorf("missing violation %q in:\n%s", want, strings.Join(got, "\n"))
+		}
+	}
+}
+
+func TestLintSkipsHexOfDeliberateDecodeFailures(t *testing.T) {
+	b := []byte(`{"gate": "CV-SIGHASH", "vectors": [
+    {"id": "S-1", "op": "sighash_v1", "tx_hex": "zz", "chain_id": "00", "input_index": 0, "input_value": 0,
+     "expect_ok": false, "expect_err": "bad hex"}
+  ]}`)
+	if v := lintFixture("CV-SIGHASH.json", b); len(v) != 0 {
+		t.Fatalf("unexpected violations: %v", v)
+	}
+}
+
+func TestLintCommittedFixturesClean(t *testing.T) {
+	dir := filepath.Join("..", "..", "..", "..", "conformance", "fixtures")
+	violations, err := lintDir(dir)
+	if err != nil {
+		t.Fatalf("lintDir: %v", err)
+	}
+	for _, v := range violations {
+		t.Errorf("%s", v)
+	}
+}
+
+func TestLintDirErrors(t *testing.T) {
+	if _, err := lintDir(filepath.Join(t.TempDir(), "does-not-exist")); err == nil {
+		t.Fatalf("expected error")
+	}
+	dir := t.TempDir()
+	writeFixture(t, dir, "CV-BAD.json", `{not json`)
+	violations, err := lintDir(dir)
+	if err != nil {
+		t.Fatalf("lintDir: %v", err)
+	}
+	if len(violations) != 1 || !strings.Contains(violations[0].Msg, "invalid JSON") {
+		t.Fatalf("violations=%v", violations)
+	}
+}
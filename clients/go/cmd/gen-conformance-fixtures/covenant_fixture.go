@@ -0,0 +1,227 @@
+package main
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+
+	"github.com/2tbmz9y2xt-lang/rubin-protocol/clients/go/consensus"
+)
+
+// covenantFixtureGate is the gate name of the self-contained fixture
+// emitted by --covenant-fixture. Unlike every other fixture this
+// generator touches, CV-COVENANT is built from scratch: no committed
+// skeleton is loaded, every funding UTXO, spend tx and expectation is
+// derived here from the embedded keys.
+const covenantFixtureGate = "CV-COVENANT"
+
+// covenantFixtureKeys groups the signers used by buildCovenantFixture.
+type covenantFixtureKeys struct {
+	owner      digestSigner
+	vault      digestSigner
+	dest       digestSigner
+	dest2      digestSigner
+	multisig   digestSigner
+	htlcClaim  digestSigner
+	htlcRefund digestSigner
+}
+
+// covenantSpend is one funded spend before it is rendered as a vector.
+type covenantSpend struct {
+	id        string
+	utxos     []map[string]any
+	tx        *consensus.Tx
+	expectErr consensus.ErrorCode
+	fee       uint64
+}
+
+// buildCovenantFixture constructs a complete CV-COVENANT fixture: for
+// each spendable covenant type (P2PK, MULTISIG, HTLC, VAULT) one
+// funding UTXO set, one valid spend and one invalid spend, all signed
+// with real ML-DSA-87 signatures under chainID. Every vector uses the
+// utxo_apply_basic op at height 200 / block_timestamp 1000 so it can be
+// replayed by the same runner path as CV-UTXO-BASIC.
+func buildCovenantFixture(chainID [32]byte, keys covenantFixtureKeys) *fixtureFile {
+	var spends []covenantSpend
+	spends = append(spends, covenantP2PKSpends(chainID, keys)...)
+	spends = append(spends, covenantMultisigSpends(chainID, keys)...)
+	spends = append(spends, covenantHTLCSpends(chainID, keys)...)
+	spends = append(spends, covenantVaultSpends(chainID, keys)...)
+
+	f := &fixtureFile{Gate: covenantFixtureGate}
+	for _, s := range spends {
+		v := map[string]any{
+			"id":              s.id,
+			"op":              "utxo_apply_basic",
+			"height":          float64(200),
+			"block_timestamp": float64(1000),
+			"utxos":           s.utxos,
+			"tx_hex":          hex.EncodeToString(mustTxBytes(s.tx)),
+		}
+		if s.expectErr == "" {
+			v["expect_ok"] = true
+			v["expect_fee"] = float64(s.fee)
+			v["expect_utxo_count"] = float64(len(s.tx.Outputs))
+		} else {
+			v["expect_ok"] = false
+			v["expect_err"] = string(s.expectErr)
+		}
+		f.Vectors = append(f.Vectors, v)
+	}
+	return f
+}
+
+// covenantFundingUTXO returns a fixture utxo entry whose txid is the
+// 32-byte repetition of fill, so every vector spends a distinct outpoint.
+func covenantFundingUTXO(fill byte, covType uint16, covData []byte, value uint64) (map[string]any, consensus.TxInput) {
+	var txid [32]byte
+	for i := range txid {
+		txid[i] = fill
+	}
+	u := map[string]any{
+		"txid":                hex.EncodeToString(txid[:]),
+		"vout":                float64(0),
+		"value":               float64(value),
+		"covenant_type":       float64(covType),
+		"covenant_data":       hex.EncodeToString(covData),
+		"creation_height":     float64(100),
+		"created_by_coinbase": false,
+	}
+	return u, consensus.TxInput{PrevTxid: txid, PrevVout: 0, ScriptSig: nil, Sequence: 0}
+}
+
+func covenantSpendTx(inputs []consensus.TxInput, outValue uint64, outCov []byte) *consensus.Tx {
+	return &consensus.Tx{
+		Version:  1,
+		TxKind:   0x00,
+		TxNonce:  1,
+		Inputs:   inputs,
+		Outputs:  []consensus.TxOutput{{Value: outValue, CovenantType: consensus.COV_TYPE_P2PK, CovenantData: outCov}},
+		Locktime: 0,
+	}
+}
+
+// corruptSignature flips one bit inside the crypto signature, leaving the
+// trailing sighash_type byte intact so the witness stays canonical and
+// the failure is reported by verification rather than by parsing.
+func corruptSignature(sig []byte) []byte {
+	out := append([]byte(nil), sig...)
+	out[0] ^= 0x01
+	return out
+}
+
+func covenantP2PKSpends(chainID [32]byte, keys covenantFixtureKeys) []covenantSpend {
+	pub := keys.owner.PubkeyBytes()
+	cov := p2pkCovenantData(pub)
+	destCov := p2pkCovenantData(keys.dest.PubkeyBytes())
+
+	build := func(id string, fill byte, corrupt bool, expectErr consensus.ErrorCode) covenantSpend {
+		u, in := covenantFundingUTXO(fill, consensus.COV_TYPE_P2PK, cov, 100)
+		tx := covenantSpendTx([]consensus.TxInput{in}, 90, destCov)
+		sig := mustSignInputDigest(id, "input0", keys.owner, tx, 0, 100, chainID)
+		if corrupt {
+			sig = corruptSignature(sig)
+		}
+		tx.Witness = []consensus.WitnessItem{{SuiteID: consensus.SUITE_ID_ML_DSA_87, Pubkey: pub, Signature: sig}}
+		return covenantSpend{id: id, utxos: []map[string]any{u}, tx: tx, expectErr: expectErr, fee: 10}
+	}
+	return []covenantSpend{
+		build("CV-COV-P2PK-01", 0xc0, false, ""),
+		build("CV-COV-P2PK-02", 0xc1, true, consensus.TX_ERR_SIG_INVALID),
+	}
+}
+
+func covenantMultisigSpends(chainID [32]byte, keys covenantFixtureKeys) []covenantSpend {
+	pub := keys.multisig.PubkeyBytes()
+	cov := multisigCovenantData1of1(pub)
+	destCov := p2pkCovenantData(keys.dest.PubkeyBytes())
+
+	build := func(id string, fill byte, corrupt bool, expectErr consensus.ErrorCode) covenantSpend {
+		u, in := covenantFundingUTXO(fill, consensus.COV_TYPE_MULTISIG, cov, 100)
+		tx := covenantSpendTx([]consensus.TxInput{in}, 90, destCov)
+		sig := mustSignInputDigest(id, "input0", keys.multisig, tx, 0, 100, chainID)
+		if corrupt {
+			sig = corruptSignature(sig)
+		}
+		tx.Witness = []consensus.WitnessItem{{SuiteID: consensus.SUITE_ID_ML_DSA_87, Pubkey: pub, Signature: sig}}
+		return covenantSpend{id: id, utxos: []map[string]any{u}, tx: tx, expectErr: expectErr, fee: 10}
+	}
+	return []covenantSpend{
+		build("CV-COV-MULTISIG-01", 0xc2, false, ""),
+		build("CV-COV-MULTISIG-02", 0xc3, true, consensus.TX_ERR_SIG_INVALID),
+	}
+}
+
+// covenantHTLCSpends builds claim-path spends. The invalid spend carries
+// a correctly signed claim whose revealed preimage does not hash to the
+// committed value.
+func covenantHTLCSpends(chainID [32]byte, keys covenantFixtureKeys) []covenantSpend {
+	claimPub := keys.htlcClaim.PubkeyBytes()
+	claimKeyID := keyIDForPub(claimPub)
+	refundKeyID := keyIDForPub(keys.htlcRefund.PubkeyBytes())
+	destCov := p2pkCovenantData(keys.dest.PubkeyBytes())
+
+	preimage := []byte("rubin-covenant-fixture-preimage")
+	hash := sha3_256(preimage)
+
+	cov := make([]byte, 0, consensus.MAX_HTLC_COVENANT_DATA)
+	cov = append(cov, hash[:]...)
+	cov = append(cov, byte(consensus.LOCK_MODE_TIMESTAMP))
+	var lv [8]byte
+	binary.LittleEndian.PutUint64(lv[:], 2500)
+	cov = append(cov, lv[:]...)
+	cov = append(cov, claimKeyID[:]...)
+	cov = append(cov, refundKeyID[:]...)
+
+	build := func(id string, fill byte, revealed []byte, expectErr consensus.ErrorCode) covenantSpend {
+		u, in := covenantFundingUTXO(fill, consensus.COV_TYPE_HTLC, cov, 100)
+		tx := covenantSpendTx([]consensus.TxInput{in}, 90, destCov)
+		sig := mustSignInputDigest(id, "claim_input", keys.htlcClaim, tx, 0, 100, chainID)
+
+		selSig := []byte{0x00} // pathID=claim
+		var preLen [2]byte
+		binary.LittleEndian.PutUint16(preLen[:], uint16(len(revealed))) // #nosec G115 -- revealed preimages are short fixed literals.
+		selSig = append(selSig, preLen[:]...)
+		selSig = append(selSig, revealed...)
+
+		tx.Witness = []consensus.WitnessItem{
+			{SuiteID: consensus.SUITE_ID_SENTINEL, Pubkey: claimKeyID[:], Signature: selSig},
+			{SuiteID: consensus.SUITE_ID_ML_DSA_87, Pubkey: claimPub, Signature: sig},
+		}
+		return covenantSpend{id: id, utxos: []map[string]any{u}, tx: tx, expectErr: expectErr, fee: 10}
+	}
+	return []covenantSpend{
+		build("CV-COV-HTLC-01", 0xc4, preimage, ""),
+		build("CV-COV-HTLC-02", 0xc5, []byte("rubin-covenant-fixture-wrong"), consensus.TX_ERR_SIG_INVALID),
+	}
+}
+
+// covenantVaultSpends builds two-input vault spends (vault input plus an
+// owner-authorised fee input). The invalid spend pays a destination that
+// is not on the vault whitelist.
+func covenantVaultSpends(chainID [32]byte, keys covenantFixtureKeys) []covenantSpend {
+	ownerPub := keys.owner.PubkeyBytes()
+	ownerCov := p2pkCovenantData(ownerPub)
+	ownerLockID := sha3_256(consensus.OutputDescriptorBytes(consensus.COV_TYPE_P2PK, ownerCov))
+
+	vaultPub := keys.vault.PubkeyBytes()
+	destCov := p2pkCovenantData(keys.dest.PubkeyBytes())
+	destDescHash := sha3_256(consensus.OutputDescriptorBytes(consensus.COV_TYPE_P2PK, destCov))
+	vaultCov := vaultCovenantData(ownerLockID, keyIDForPub(vaultPub), destDescHash)
+
+	build := func(id string, fill byte, outCov []byte, expectErr consensus.ErrorCode) covenantSpend {
+		u0, in0 := covenantFundingUTXO(fill, consensus.COV_TYPE_VAULT, vaultCov, 100)
+		u1, in1 := covenantFundingUTXO(fill+1, consensus.COV_TYPE_P2PK, ownerCov, 10)
+		tx := covenantSpendTx([]consensus.TxInput{in0, in1}, 100, outCov)
+		vaultSig := mustSignInputDigest(id, "vault_input", keys.vault, tx, 0, 100, chainID)
+		ownerSig := mustSignInputDigest(id, "owner_input", keys.owner, tx, 1, 10, chainID)
+		tx.Witness = []consensus.WitnessItem{
+			{SuiteID: consensus.SUITE_ID_ML_DSA_87, Pubkey: vaultPub, Signature: vaultSig},
+			{SuiteID: consensus.SUITE_ID_ML_DSA_87, Pubkey: ownerPub, Signature: ownerSig},
+		}
+		return covenantSpend{id: id, utxos: []map[string]any{u0, u1}, tx: tx, expectErr: expectErr, fee: 10}
+	}
+	return []covenantSpend{
+		build("CV-COV-VAULT-01", 0xc6, destCov, ""),
+		build("CV-COV-VAULT-02", 0xc8, p2pkCovenantData(keys.dest2.PubkeyBytes()), consensus.TX_ERR_VAULT_OUTPUT_NOT_WHITELISTED),
+	}
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/2tbmz9y2xt-lang/rubin-protocol/clients/go/consensus"
+)
+
+// TestGenerator_CovenantFixtureVectorsValidate runs the --covenant-fixture
+// mode into a temp --output-dir and replays every emitted vector through
+// consensus, asserting the declared expect_ok / expect_err outcome.
+func TestGenerator_CovenantFixtureVectorsValidate(t *testing.T) {
+	skipIfMLDSA87DERUnavailable(t)
+	outDir := t.TempDir()
+	runGeneratorCLIWithArgs([]string{"-covenant-fixture", "-output-dir", outDir})
+
+	raw, err := os.ReadFile(filepath.Join(outDir, covenantFixtureGate+".json"))
+	if err != nil {
+		t.Fatalf("read generated fixture: %v", err)
+	}
+	var f fixtureFile
+	if err := json.Unmarshal(raw, &f); err != nil {
+		t.Fatalf("parse generated fixture: %v", err)
+	}
+	if f.Gate != covenantFixtureGate {
+		t.Fatalf("gate=%q, want %q", f.Gate, covenantFixtureGate)
+	}
+
+	covTypes := map[uint16]bool{}
+	var okCount, errCount int
+	for _, v := range f.Vectors {
+		id := v["id"].(string)
+		txBytes, err := hex.DecodeString(v["tx_hex"].(string))
+		if err != nil {
+			t.Fatalf("%s: tx_hex: %v", id, err)
+		}
+		tx, txid, _, _, err := consensus.ParseTx(txBytes)
+		if err != nil {
+			t.Fatalf("%s: ParseTx: %v", id, err)
+		}
+
+		utxoSet := map[consensus.Outpoint]consensus.UtxoEntry{}
+		for _, u := range anyToSliceMap(v["utxos"]) {
+			covData, err := hex.DecodeString(u["covenant_data"].(string))
+			if err != nil {
+				t.Fatalf("%s: covenant_data: %v", id, err)
+			}
+			covType := uint16(u["covenant_type"].(float64))
+			covTypes[covType] = true
+			op := consensus.Outpoint{Txid: mustHex32(u["txid"].(string)), Vout: mustJSONUint32(id+".vout", u["vout"])}
+			utxoSet[op] = consensus.UtxoEntry{
+				Value:             uint64(u["value"].(float64)),
+				CovenantType:      covType,
+				CovenantData:      covData,
+				CreationHeight:    uint64(u["creation_height"].(float64)),
+				CreatedByCoinbase: u["created_by_coinbase"].(bool),
+			}
+		}
+
+		height := uint64(v["height"].(float64))
+		ts := uint64(v["block_timestamp"].(float64))
+		s, err := consensus.ApplyNonCoinbaseTxBasic(tx, txid, utxoSet, height, ts, [32]byte{})
+		if v["expect_ok"].(bool) {
+			okCount++
+			if err != nil {
+				t.Fatalf("%s: expected ok, got %v", id, err)
+			}
+			if want := uint64(v["expect_fee"].(float64)); s.Fee != want {
+				t.Fatalf("%s: fee=%d, want %d", id, s.Fee, want)
+			}
+			continue
+		}
+		errCount++
+		var txErr *consensus.TxError
+		if !errors.As(err, &txErr) {
+			t.Fatalf("%s: expected %v, got %v", id, v["expect_err"], err)
+		}
+		if string(txErr.Code) != v["expect_err"].(string) {
+			t.Fatalf("%s: err=%s, want %v", id, txErr.Code, v["expect_err"])
+		}
+	}
+
+	for _, ct := range []uint16{consensus.COV_TYPE_P2PK, consensus.COV_TYPE_MULTISIG, consensus.COV_TYPE_HTLC, consensus.COV_TYPE_VAULT} {
+		if !covTypes[ct] {
+			t.Fatalf("covenant type 0x%04x not covered", ct)
+		}
+	}
+	if okCount != 4 || errCount != 4 {
+		t.Fatalf("valid=%d invalid=%d, want 4/4", okCount, errCount)
+	}
+}
+
+// TestGenerator_CovenantFixtureDeterministic extends the
+// TestGenerator_DeterministicOutputDir contract to --covenant-fixture:
+// keys come from the embedded DER and signing is deterministic, so two
+// runs must emit byte-identical CV-COVENANT.json.
+func TestGenerator_CovenantFixtureDeterministic(t *testing.T) {
+	skipIfMLDSA87DERUnavailable(t)
+	tmpA := t.TempDir()
+	tmpB := t.TempDir()
+
+	runGeneratorCLIWithArgs([]string{"-covenant-fixture", "-output-dir", tmpA})
+	runGeneratorCLIWithArgs([]string{"-covenant-fixture", "-output-dir", tmpB})
+
+	pathsA := collectGeneratorOutput(t, tmpA)
+	pathsB := collectGeneratorOutput(t, tmpB)
+	if len(pathsA) != 1 || len(pathsB) != 1 {
+		t.Fatalf("want exactly one generated file, got tmpA=%d tmpB=%d", len(pathsA), len(pathsB))
+	}
+	name := covenantFixtureGate + ".json"
+	if !bytes.Equal(pathsA[name], pathsB[name]) {
+		t.Fatalf("%s bytes differ between two generator runs (deterministic-mode contract violated)", name)
+	}
+}
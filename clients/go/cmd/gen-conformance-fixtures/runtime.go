@@ -57,6 +57,10 @@ var embeddedTestKeysFS embed.FS
 //     check (Q-CONF-FIXTURE-DRIFT-CHECK-01 / #1358) to compare candidate
 //     bytes against committed bytes without mutating the repo.
 //
+// --covenant-fixture switches from updating committed fixtures to
+// generating a self-contained CV-COVENANT.json from scratch (see
+// buildCovenantFixture); it honours --output-dir the same way.
+//
 // --output-dir must be absolute. A relative value would be implicitly
 // resolved against the process cwd, which contradicts the cwd
 // independence contract proven by TestGenerator_CwdIndependence.
@@ -88,6 +92,7 @@ func stripGoTestFlags(args []string) []string {
 func runGeneratorCLIWithArgs(args []string) {
 	fs := flag.NewFlagSet("gen-conformance-fixtures", flag.ContinueOnError)
 	outputDir := fs.String("output-dir", "", "absolute path to write candidate fixtures into; if set, conformance/fixtures/** is NOT mutated")
+	covenantFixture := fs.Bool("covenant-fixture", false, "generate a self-contained CV-COVENANT.json from scratch instead of updating the committed fixtures")
 	if err := fs.Parse(args); err != nil {
 		fatalf("flag parse: %v", err)
 	}
@@ -136,6 +141,23 @@ func runGeneratorCLIWithArgs(args []string) {
 
 	zeroChainID := [32]byte{}
 
+	// --covenant-fixture: build CV-COVENANT from scratch (no committed
+	// skeleton is read) and skip the in-place fixture updates below.
+	if *covenantFixture {
+		f := buildCovenantFixture(zeroChainID, covenantFixtureKeys{
+			owner:      ownerKP,
+			vault:      vaultKP,
+			dest:       destKP,
+			dest2:      dest2KP,
+			multisig:   multisigKP,
+			htlcClaim:  htlcClaimKP,
+			htlcRefund: htlcRefundKP,
+		})
+		mustWriteFixture(filepath.Join(writeRoot, covenantFixtureGate+".json"), f)
+		fmt.Printf("ok: generated %s.json with %d vectors\n", covenantFixtureGate, len(f.Vectors))
+		return
+	}
+
 	// CV-UTXO-BASIC updates.
 	{
 		path := filepath.Join(repoRoot, "conformance/fixtures/CV-UTXO-BASIC.json")
@@ -2,6 +2,7 @@ package main
 
 import (
 	"bytes"
+	"cmp"
 	"crypto/sha3"
 	"encoding/binary"
 	"encoding/hex"
@@ -9,10 +10,13 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"maps"
 	"math"
 	"math/big"
 	"math/bits"
 	"os"
+	"reflect"
+	"slices"
 	"sort"
 	"strings"
 
@@ -48,6 +52,9 @@ type Request struct {
 	ChainIDHex           string         `json:"chain_id,omitempty"`
 	DaID                 string         `json:"da_id,omitempty"`
 	TxHex                string         `json:"tx_hex,omitempty"`
+	OtherTxHex           string         `json:"other_tx_hex,omitempty"`
+	BytesHex             string         `json:"bytes_hex,omitempty"`
+	Value                *uint64        `json:"value,omitempty"`
 	TargetOldHex         string         `json:"target_old,omitempty"`
 	Target               string         `json:"target,omitempty"`
 	ExpectedTarget       string         `json:"expected_target,omitempty"`
@@ -86,6 +93,7 @@ type Request struct {
 	PrevTimestamps       []uint64                 `json:"prev_timestamps,omitempty"`
 	InitialChunks        []int                    `json:"initial_chunks,omitempty"`
 	Utxos                []UtxoJSON               `json:"utxos,omitempty"`
+	UtxosAfter           []UtxoJSON               `json:"utxos_after,omitempty"`
 	Whitelist            []string                 `json:"whitelist,omitempty"`
 	BlocktxnIndices      []int                    `json:"blocktxn_indices,omitempty"`
 	MissingIndices       []int                    `json:"missing_indices,omitempty"`
@@ -152,6 +160,22 @@ type Request struct {
 	Name                 string                   `json:"name,omitempty"`
 	Bit                  uint8                    `json:"bit,omitempty"`
 	StartHeight          uint64                   `json:"start_height,omitempty"`
+	StartTarget          string                   `json:"start_target,omitempty"`
+	BlockIntervals       []uint64                 `json:"block_intervals,omitempty"`
+	FeeHistograms        [][]FeeBucket            `json:"fee_histograms,omitempty"`
+	ConfTarget           uint64                   `json:"conf_target,omitempty"`
+	SizeInputs           []SizeInputJSON          `json:"size_inputs,omitempty"`
+	SizeOutputs          []SizeOutputJSON         `json:"size_outputs,omitempty"`
+	TargetValue          uint64                   `json:"target_value,omitempty"`
+	FeeRate              uint64                   `json:"fee_rate,omitempty"`
+	InputSum             uint64                   `json:"input_sum,omitempty"`
+	Fee                  uint64                   `json:"fee,omitempty"`
+	DustThreshold        uint64                   `json:"dust_threshold,omitempty"`
+	Prevouts             []SizeInputJSON          `json:"prevouts,omitempty"`
+	PayloadHex           string                   `json:"payload_hex,omitempty"`
+	LockMode             *uint8                   `json:"lock_mode,omitempty"`
+	BlockBytes           uint64                   `json:"block_bytes,omitempty"`
+	PropagationPeers     []PropagationPeerJSON    `json:"propagation_peers,omitempty"`
 	TimeoutHeight        uint64                   `json:"timeout_height,omitempty"`
 	ActivationHeight     *uint64                  `json:"activation_height,omitempty"`
 	TransitionHeight     *uint64                  `json:"transition_height,omitempty"`
@@ -166,6 +190,10 @@ type Request struct {
 	FrameBitWidths       []uint64                 `json:"frame_bit_widths,omitempty"`
 	JetAccepted          *bool                    `json:"jet_accepted,omitempty"`
 	JetCost              *uint64                  `json:"jet_cost,omitempty"`
+	ReturnDelta          *bool                    `json:"return_delta,omitempty"`
+	HeaderHexes          []string                 `json:"header_hexes,omitempty"`
+	PrevWindowLastTs     *uint64                  `json:"prev_window_last_timestamp,omitempty"`
+	TimeWarpMaxBackstep  *uint64                  `json:"time_warp_max_backstep,omitempty"`
 }
 
 type requestEnvelope struct {
@@ -261,6 +289,57 @@ type UtxoJSON struct {
 	CreatedByCoinbase bool   `json:"created_by_coinbase"`
 }
 
+// UtxoDelta is the UTXO-set change of one applied transaction: the entries
+// its inputs spent, in input order, and the outputs it created, by vout.
+type UtxoDelta struct {
+	Spent   []UtxoJSON `json:"spent"`
+	Created []UtxoJSON `json:"created"`
+}
+
+// UtxoSetDiff is the change between two UTXO sets, each list ordered by
+// (txid, vout). Changed holds the after-side entry of outpoints present in
+// both sets with a different entry. The hashes are consensus.UtxoSetHash of
+// each side.
+type UtxoSetDiff struct {
+	Added      []UtxoJSON `json:"added"`
+	Removed    []UtxoJSON `json:"removed"`
+	Changed    []UtxoJSON `json:"changed"`
+	BeforeHash string     `json:"before_hash"`
+	AfterHash  string     `json:"after_hash"`
+}
+
+// GateOutcome is one tx_gate_matrix row: the spend's validation result with
+// SLH-DSA inactive or active as a native spend suite.
+type GateOutcome struct {
+	SlhActive bool   `json:"slh_active"`
+	Ok        bool   `json:"ok"`
+	Err       string `json:"err,omitempty"`
+	Reason    string `json:"reason,omitempty"`
+}
+
+// SizeInputJSON describes an input to be signed: the covenant it spends and
+// the signature suite. KeyCount and Signers shape MULTISIG/VAULT witnesses;
+// unsigned key slots carry sentinel items.
+type SizeInputJSON struct {
+	Suite        string `json:"suite,omitempty"`
+	KeyCount     int    `json:"key_count,omitempty"`
+	Signers      int    `json:"signers,omitempty"`
+	CovenantType uint16 `json:"covenant_type"`
+}
+
+// PropagationPeerJSON is a peer link: bandwidth in bytes per second and
+// round-trip time in milliseconds.
+type PropagationPeerJSON struct {
+	ID           string `json:"id"`
+	BandwidthBPS uint64 `json:"bandwidth_bps"`
+	RTTMs        uint64 `json:"rtt_ms"`
+}
+
+type SizeOutputJSON struct {
+	CovenantDataLen int    `json:"covenant_data_len"`
+	CovenantType    uint16 `json:"covenant_type"`
+}
+
 type RotationDescriptorJSON struct {
 	Name         string `json:"name"`
 	OldSuiteID   uint8  `json:"old_suite_id"`
@@ -384,6 +463,19 @@ type ForkChoiceChain struct {
 	ID      string   `json:"id"`
 	TipHash string   `json:"tip_hash"`
 	Targets []string `json:"targets"`
+	// FirstSeen is when the local node first saw this tip; on equal work
+	// the earlier tip wins before the hash tie-break. Ignored unless both
+	// compared chains carry it.
+	FirstSeen *uint64 `json:"first_seen,omitempty"`
+}
+
+// forkChoiceTieBreak reports whether candidate beats best on equal work:
+// earlier first_seen (when both are known), then smaller tip hash.
+func forkChoiceTieBreak(candidateSeen *uint64, candidateTip []byte, bestSeen *uint64, bestTip []byte) bool {
+	if candidateSeen != nil && bestSeen != nil && *candidateSeen != *bestSeen {
+		return *candidateSeen < *bestSeen
+	}
+	return bestTip == nil || bytes.Compare(candidateTip, bestTip) < 0
 }
 
 func buildSuiteRegistry(items []SuiteParamsJSON) (*consensus.SuiteRegistry, error) {
@@ -436,6 +528,37 @@ func buildCoreExtSuiteContext(req Request) (consensus.RotationProvider, *consens
 	return consensus.DescriptorRotationProvider{Descriptor: desc}, reg, nil
 }
 
+// slhActiveSuiteContext registers the SLH-DSA stand-in suite next to
+// ML-DSA-87 and holds the rotation in its transition phase from height 0, so
+// both suites can be created and spent at every height. SLH-DSA
+// has no live verifier binding, so spends reaching verification still fail
+// closed; the context only lifts the native-suite gate.
+func slhActiveSuiteContext() (consensus.RotationProvider, *consensus.SuiteRegistry) {
+	registry := consensus.NewSuiteRegistryFromParams([]consensus.SuiteParams{
+		{
+			SuiteID:    consensus.SUITE_ID_ML_DSA_87,
+			PubkeyLen:  consensus.ML_DSA_87_PUBKEY_BYTES,
+			SigLen:     consensus.ML_DSA_87_SIG_BYTES,
+			VerifyCost: consensus.VERIFY_COST_ML_DSA_87,
+			AlgName:    "ML-DSA-87",
+		},
+		{
+			SuiteID:    predictSLHDSASuiteID,
+			PubkeyLen:  consensus.SLH_DSA_SHAKE_256F_PUBKEY_BYTES,
+			SigLen:     consensus.SLH_DSA_SHAKE_256F_SIG_BYTES,
+			VerifyCost: consensus.VERIFY_COST_UNKNOWN_SUITE,
+			AlgName:    "SLH-DSA-SHAKE-256f",
+		},
+	})
+	rotation := consensus.DescriptorRotationProvider{Descriptor: consensus.CryptoRotationDescriptor{
+		Name:        "slh-gate",
+		OldSuiteID:  consensus.SUITE_ID_ML_DSA_87,
+		NewSuiteID:  predictSLHDSASuiteID,
+		SpendHeight: math.MaxUint64,
+	}}
+	return rotation, registry
+}
+
 type Check struct {
 	Name  string `json:"name"`
 	Err   string `json:"err"`
@@ -451,6 +574,30 @@ type Response struct {
 	MerkleHex          string         `json:"merkle_root,omitempty"`
 	WitnessMerkleHex   string         `json:"witness_merkle_root,omitempty"`
 	DigestHex          string         `json:"digest,omitempty"`
+	BytesHex           string         `json:"bytes_hex,omitempty"`
+	Value              *uint64        `json:"value,omitempty"`
+	Subsidy            *uint64        `json:"subsidy,omitempty"`
+	Epoch              string         `json:"epoch,omitempty"`
+	HeaderLayout       []headerField  `json:"header_layout,omitempty"`
+	NonceField         string         `json:"nonce_field,omitempty"`
+	Retargets          []retargetStep `json:"retargets,omitempty"`
+	FeeRate            *uint64        `json:"fee_rate,omitempty"`
+	SelectedIndices    []int          `json:"selected_indices,omitempty"`
+	Change             *uint64        `json:"change,omitempty"`
+	CreateChange       *bool          `json:"create_change,omitempty"`
+	SigningComplete    *bool          `json:"signing_complete,omitempty"`
+	UnsignedInputs     []int          `json:"unsigned_inputs,omitempty"`
+	Chunks             []string       `json:"chunks,omitempty"`
+	ChunkCount         int            `json:"chunk_count,omitempty"`
+	FitsBlockCap       *bool          `json:"fits_block_cap,omitempty"`
+	DaID               string         `json:"da_id,omitempty"`
+	DaChunks           []daChunkEntry `json:"da_chunks,omitempty"`
+	SignalsRBF         *bool          `json:"signals_rbf,omitempty"`
+	SignalingInputs    []int          `json:"signaling_inputs,omitempty"`
+	LockValue          *uint64        `json:"lock_value,omitempty"`
+	MTP                *uint64        `json:"mtp,omitempty"`
+	MinBlockTimestamp  *uint64        `json:"min_block_timestamp,omitempty"`
+	Propagation        []relayPlan    `json:"propagation,omitempty"`
 	BlockHash          string         `json:"block_hash,omitempty"`
 	TargetNew          string         `json:"target_new,omitempty"`
 	ShortID            string         `json:"short_id,omitempty"`
@@ -483,6 +630,7 @@ type Response struct {
 	UtxoCount          uint64         `json:"utxo_count,omitempty"`
 	CountedBytes       int            `json:"counted_bytes,omitempty"`
 	Weight             uint64         `json:"weight"`
+	VSize              uint64         `json:"vsize,omitempty"`
 	WireBytes          int            `json:"wire_bytes,omitempty"`
 	Fee                uint64         `json:"fee,omitempty"`
 	IgnoredOverhead    int            `json:"ignored_overhead_bytes,omitempty"`
@@ -538,9 +686,172 @@ type Response struct {
 	SuiteIDs           []uint8        `json:"suite_ids,omitempty"`
 	Accepted           *bool          `json:"accepted,omitempty"`
 	FinalCounter       *uint64        `json:"final_counter,omitempty"`
+	SupportedOps       []string       `json:"supported_ops,omitempty"`
+	Constants          map[string]any `json:"constants,omitempty"`
+	CovenantTypes      []CovenantType `json:"covenant_types,omitempty"`
+	ErrorCodes         errorCodeTable `json:"error_codes,omitempty"`
+	UtxoDelta          *UtxoDelta     `json:"utxo_delta,omitempty"`
+	TemplateID         string         `json:"template_id,omitempty"`
+	HeaderWorks        []string       `json:"header_works,omitempty"`
+	CommitmentHex      string         `json:"witness_commitment_hex,omitempty"`
+	Locktime           *uint32        `json:"locktime,omitempty"`
+	LocktimeMatches    *bool          `json:"locktime_matches,omitempty"`
+	SameTxid           *bool          `json:"same_txid,omitempty"`
+	SameWtxid          *bool          `json:"same_wtxid,omitempty"`
+	WitnessOnly        *bool          `json:"witness_only,omitempty"`
+	WitnessCount       *int           `json:"witness_count,omitempty"`
+	ExpectedWitness    *int           `json:"expected_witness_count,omitempty"`
+	TotalFees          *uint64        `json:"total_fees,omitempty"`
+	CoinbaseValue      *uint64        `json:"coinbase_value,omitempty"`
+	WithinBounds       *bool          `json:"within_bounds,omitempty"`
+	UtxoSetDiff        *UtxoSetDiff   `json:"utxo_set_diff,omitempty"`
+	HeaderVersion      *uint32        `json:"header_version,omitempty"`
+	VersionConforming  *bool          `json:"version_bits_conforming,omitempty"`
+	SignaledBits       []int          `json:"signaled_bits,omitempty"`
+	SlhActive          *bool          `json:"slh_active,omitempty"`
+	ActivationAgrees   *bool          `json:"activation_agrees,omitempty"`
+	GateMatrix         []GateOutcome  `json:"gate_matrix,omitempty"`
+	ErrorDetail        *ErrorDetail   `json:"error_detail,omitempty"`
+	ProtocolVersion    int            `json:"protocol_version"`
+}
+
+// ErrorDetail is the structured form of Response.Err. Code is stable for
+// harness matching: the canonical consensus code (TX_ERR_*, BLOCK_ERR_*)
+// when there is one, otherwise cliErrRequest. Message is human-readable
+// and Field names the offending request field when the error points at
+// one. Response.Err keeps its historical value for compatibility.
+type ErrorDetail struct {
+	Code    string `json:"code"`
+	Message string `json:"message,omitempty"`
+	Field   string `json:"field,omitempty"`
+}
+
+// CovenantType describes one covenant_type and the covenant_data length
+// creation-time validation accepts for it. DataLenRule is "exact" (MinLen ==
+// MaxLen), "range" (any length in [MinLen, MaxLen]), "variable" (a length
+// fixed by header fields, bounded by [MinLen, MaxLen] and described by
+// Layout), or "forbidden" (outputs of this type are rejected outright).
+type CovenantType struct {
+	ID          uint16 `json:"id"`
+	Name        string `json:"name"`
+	DataLenRule string `json:"data_len_rule"`
+	MinLen      int    `json:"min_len,omitempty"`
+	MaxLen      int    `json:"max_len,omitempty"`
+	Layout      string `json:"layout,omitempty"`
+}
+
+// cliErrRequest is the ErrorDetail.Code of failures that carry no
+// canonical consensus code: malformed input, unknown ops, and other
+// request-level rejections.
+const cliErrRequest = "CLI_ERR_REQUEST"
+
+// cliProtocolVersion versions the stdin/stdout request/response contract.
+// Every response carries it so a differential harness driving this CLI
+// and the Rust one can detect version skew before comparing outputs.
+// Bump it on any incompatible change to request or response fields.
+const cliProtocolVersion = 1
+
+// supportedOps is the sorted op list reported by the capabilities op. It
+// must list exactly the cases of the runFromStdin dispatcher.
+var supportedOps = []string{
+	"anchor_chunk",
+	"apply_block_full",
+	"block_basic_check",
+	"block_basic_check_with_fees",
+	"block_hash",
+	"block_reward_audit",
+	"block_size_check",
+	"capabilities",
+	"coin_select",
+	"coinbase_height",
+	"coinbase_locktime_check",
+	"coinbase_max_value",
+	"compact_a_to_b_retention",
+	"compact_batch_verify",
+	"compact_chunk_count_cap",
+	"compact_collision_fallback",
+	"compact_duplicate_commit",
+	"compact_eviction_tiebreak",
+	"compact_grace_period",
+	"compact_orphan_limits",
+	"compact_orphan_storm",
+	"compact_peer_quality",
+	"compact_pinned_accounting",
+	"compact_prefetch_caps",
+	"compact_prefill_roundtrip",
+	"compact_sendcmpct_modes",
+	"compact_shortid",
+	"compact_state_machine",
+	"compact_storm_commit_bearing",
+	"compact_telemetry_fields",
+	"compact_telemetry_rate",
+	"compact_total_fee",
+	"compact_witness_roundtrip",
+	"compactsize_decode",
+	"compactsize_encode",
+	"compute_change",
+	"connect_block_basic",
+	"constants",
+	"covenant_data_canonical_check",
+	"covenant_genesis_check",
+	"covenant_types",
+	"covenant_witness_compat",
+	"da_commit",
+	"da_fee_floor_policy",
+	"determinism_order",
+	"difficulty_sim",
+	"error_codes",
+	"estimate_fee",
+	"featurebits_state",
+	"fork_choice_select",
+	"fork_work",
+	"genesis_preimage",
+	"header_chain_work",
+	"header_layout",
+	"htlc_ordering_policy",
+	"malleability_check",
+	"mempool_relay_metadata_policy",
+	"merkle_root",
+	"min_valid_timelock",
+	"nonce_replay_intrablock",
+	"output_descriptor_bytes",
+	"output_descriptor_hash",
+	"parse_tx",
+	"pow_check",
+	"predict_signed_size",
+	"propagation_sim",
+	"recompute_witness_commitment",
+	"retarget_v1",
+	"rotation_create_suite_check",
+	"rotation_descriptor_check",
+	"rotation_native_create_suites",
+	"rotation_spend_suite_check",
+	"sighash_v1",
+	"signals_rbf",
+	"simplicity_exec_vector",
+	"slh_activation_status",
+	"template_check",
+	"template_id",
+	"timestamp_bounds",
+	"tx_gate_matrix",
+	"tx_no_witness_bytes",
+	"tx_signing_complete",
+	"tx_weight_and_stats",
+	"utxo_apply_basic",
+	"utxo_set_diff",
+	"validation_order",
+	"vault_policy_rules",
+	"version_bits_check",
+	"witness_bytes",
+	"witness_count_check",
+	"witness_merkle_root",
 }
 
 func writeResp(w io.Writer, resp Response) {
+	resp.ProtocolVersion = cliProtocolVersion
+	if !resp.Ok && resp.Err != "" && resp.ErrorDetail == nil {
+		resp.ErrorDetail = errorDetailFor(resp.Err)
+	}
 	enc := json.NewEncoder(w)
 	enc.SetEscapeHTML(false)
 	_ = enc.Encode(resp)
@@ -549,12 +860,52 @@ func writeResp(w io.Writer, resp Response) {
 func writeConsensusErr(w io.Writer, err error) {
 	var te *consensus.TxError
 	if errors.As(err, &te) {
-		writeResp(w, Response{Ok: false, Err: string(te.Code)})
+		writeResp(w, Response{Ok: false, Err: string(te.Code), ErrorDetail: &ErrorDetail{Code: string(te.Code), Message: te.Msg}})
 		return
 	}
 	writeResp(w, Response{Ok: false, Err: err.Error()})
 }
 
+// errorDetailFor derives the structured error for a bare Response.Err.
+// Errors of the form "bad <field>" name the request field when <field>
+// is one of Request's JSON keys.
+func errorDetailFor(errStr string) *ErrorDetail {
+	if isCanonicalErrCode(errStr) {
+		return &ErrorDetail{Code: errStr}
+	}
+	detail := &ErrorDetail{Code: cliErrRequest, Message: errStr}
+	if name, ok := strings.CutPrefix(errStr, "bad "); ok && requestJSONFields[name] {
+		detail.Field = name
+	}
+	return detail
+}
+
+// isCanonicalErrCode reports whether s looks like a consensus error code
+// (upper-case words joined by underscores, e.g. TX_ERR_PARSE).
+func isCanonicalErrCode(s string) bool {
+	if !strings.Contains(s, "_ERR_") {
+		return false
+	}
+	for _, c := range s {
+		if (c < 'A' || c > 'Z') && (c < '0' || c > '9') && c != '_' {
+			return false
+		}
+	}
+	return true
+}
+
+var requestJSONFields = func() map[string]bool {
+	out := map[string]bool{}
+	t := reflect.TypeOf(Request{})
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name != "" && name != "-" {
+			out[name] = true
+		}
+	}
+	return out
+}()
+
 func parseHexU256To32(s string) ([32]byte, error) {
 	var out [32]byte
 	stripped := strings.TrimSpace(strings.ToLower(s))
@@ -769,6 +1120,835 @@ func parseHex32List(items []string, badErr string) ([][32]byte, error) {
 	return parsed, nil
 }
 
+// headerField is one fixed-width field of the serialized block header.
+type headerField struct {
+	Name   string `json:"name"`
+	Offset int    `json:"offset"`
+	Length int    `json:"length"`
+}
+
+// blockHeaderLayout is the BLOCK_HEADER_BYTES serialization in field order,
+// for mining tools that grind the nonce without a header parser.
+var blockHeaderLayout = []headerField{
+	{Name: "version", Offset: 0, Length: 4},
+	{Name: "prev_block_hash", Offset: 4, Length: 32},
+	{Name: "merkle_root", Offset: 36, Length: 32},
+	{Name: "timestamp", Offset: 68, Length: 8},
+	{Name: "target", Offset: 76, Length: 32},
+	{Name: "nonce", Offset: 108, Length: 8},
+}
+
+// retargetStep is the target that applies from Height onward.
+type retargetStep struct {
+	Height uint64 `json:"height"`
+	Target string `json:"target"`
+}
+
+// simulateDifficulty replays a block-arrival schedule from startHeight, where
+// intervals[i] is the time between block startHeight+i and the next one, and
+// retargets at every WINDOW_SIZE boundary with RetargetV1Clamped. Blocks of
+// the first window that precede startHeight are assumed to have arrived on
+// schedule.
+func simulateDifficulty(target [32]byte, startHeight uint64, intervals []uint64) ([]retargetStep, error) {
+	window := uint64(consensus.WINDOW_SIZE)
+	windowStart := startHeight - startHeight%window
+	timestamps := make([]uint64, 0, window)
+	for h := windowStart; h <= startHeight; h++ {
+		timestamps = append(timestamps, (h-windowStart)*consensus.TARGET_BLOCK_INTERVAL)
+	}
+	steps := make([]retargetStep, 0, uint64(len(intervals))/window+1)
+	height := startHeight
+	for _, interval := range intervals {
+		last := timestamps[len(timestamps)-1]
+		if interval > math.MaxUint64-last {
+			return nil, fmt.Errorf("timestamp overflow at height %d", height+1)
+		}
+		height++
+		if height%window == 0 {
+			next, err := consensus.RetargetV1Clamped(target, timestamps)
+			if err != nil {
+				return nil, err
+			}
+			target = next
+			steps = append(steps, retargetStep{Height: height, Target: hex.EncodeToString(target[:])})
+			timestamps = timestamps[:0]
+		}
+		timestamps = append(timestamps, last+interval)
+	}
+	return steps, nil
+}
+
+// FeeBucket counts the transactions a block confirmed at one fee rate
+// (per vbyte).
+type FeeBucket struct {
+	FeeRate uint64 `json:"fee_rate"`
+	Count   uint64 `json:"count"`
+}
+
+// estimateFeeRate pools the recent blocks' histograms and returns the fee rate
+// at the 1/(confTarget+1) percentile of confirmed transactions: a next-block
+// target asks for the median, and longer targets settle for lower rates.
+func estimateFeeRate(histograms [][]FeeBucket, confTarget uint64) (uint64, error) {
+	if confTarget == 0 {
+		return 0, errors.New("bad conf_target")
+	}
+	var buckets []FeeBucket
+	var total uint64
+	for _, histogram := range histograms {
+		for _, bucket := range histogram {
+			if bucket.Count > math.MaxUint64-total {
+				return 0, errors.New("fee histogram count overflow")
+			}
+			total += bucket.Count
+			buckets = append(buckets, bucket)
+		}
+	}
+	if total == 0 {
+		return 0, errors.New("empty fee histograms")
+	}
+	sort.Slice(buckets, func(i, j int) bool { return buckets[i].FeeRate < buckets[j].FeeRate })
+	rank := total / (confTarget + 1)
+	if total%(confTarget+1) != 0 {
+		rank++
+	}
+	var seen uint64
+	for _, bucket := range buckets {
+		seen += bucket.Count
+		if seen >= rank {
+			return bucket.FeeRate, nil
+		}
+	}
+	return buckets[len(buckets)-1].FeeRate, nil
+}
+
+// predictSLHDSASuiteID stands in for SLH-DSA in predicted witnesses and the
+// tx_gate_matrix SLH gate. The suite is not native, so weight only depends on
+// it being a non-sentinel unknown ID.
+const predictSLHDSASuiteID uint8 = 0x02
+
+// predictedWitnessItem returns a witness item with the canonical signed
+// lengths for suite (ML-DSA-87 when empty); signatures include the trailing
+// sighash_type byte.
+func predictedWitnessItem(suite string) (consensus.WitnessItem, error) {
+	switch strings.ToUpper(strings.TrimSpace(suite)) {
+	case "", "ML-DSA-87":
+		pubLen, sigLen, _, err := consensus.WitnessSuiteSizes(consensus.SUITE_ID_ML_DSA_87)
+		if err != nil {
+			return consensus.WitnessItem{}, err
+		}
+		return consensus.WitnessItem{
+			SuiteID:   consensus.SUITE_ID_ML_DSA_87,
+			Pubkey:    make([]byte, pubLen),
+			Signature: make([]byte, sigLen),
+		}, nil
+	case "SLH-DSA-SHAKE-256F":
+		return consensus.WitnessItem{
+			SuiteID:   predictSLHDSASuiteID,
+			Pubkey:    make([]byte, consensus.SLH_DSA_SHAKE_256F_PUBKEY_BYTES),
+			Signature: make([]byte, consensus.SLH_DSA_SHAKE_256F_SIG_BYTES+1),
+		}, nil
+	default:
+		return consensus.WitnessItem{}, fmt.Errorf("bad suite")
+	}
+}
+
+// predictSignedTx builds a transaction with the shape of the signed result:
+// zeroed prevouts, zeroed covenant data of the given lengths and one
+// canonical-length witness item per signing key slot.
+func predictSignedTx(inputs []SizeInputJSON, outputs []SizeOutputJSON) (*consensus.Tx, error) {
+	tx := &consensus.Tx{Version: 1}
+	for _, in := range inputs {
+		item, err := predictedWitnessItem(in.Suite)
+		if err != nil {
+			return nil, err
+		}
+		slots, signers := 1, 1
+		switch in.CovenantType {
+		case consensus.COV_TYPE_P2PK:
+		case consensus.COV_TYPE_MULTISIG, consensus.COV_TYPE_VAULT:
+			slots, signers = in.KeyCount, in.Signers
+			if slots <= 0 || signers <= 0 || signers > slots || slots > consensus.MAX_MULTISIG_KEYS {
+				return nil, fmt.Errorf("bad key_count")
+			}
+		default:
+			return nil, fmt.Errorf("unsupported covenant_type")
+		}
+		tx.Inputs = append(tx.Inputs, consensus.TxInput{})
+		for slot := 0; slot < slots; slot++ {
+			if slot < signers {
+				tx.Witness = append(tx.Witness, item)
+			} else {
+				tx.Witness = append(tx.Witness, consensus.WitnessItem{SuiteID: consensus.SUITE_ID_SENTINEL})
+			}
+		}
+	}
+	for _, out := range outputs {
+		if out.CovenantDataLen < 0 {
+			return nil, fmt.Errorf("bad covenant_data_len")
+		}
+		tx.Outputs = append(tx.Outputs, consensus.TxOutput{
+			CovenantType: out.CovenantType,
+			CovenantData: make([]byte, out.CovenantDataLen),
+		})
+	}
+	return tx, nil
+}
+
+// coinSelectMaxTries bounds the branch-and-bound search before falling back
+// to largest-first.
+const coinSelectMaxTries = 100_000
+
+// coinSelection is a chosen input set; Fee absorbs any excess when there is
+// no change output.
+type coinSelection struct {
+	Indices []int
+	Fee     uint64
+	Change  uint64
+}
+
+// isSignedWitnessItem reports whether w has the canonical lengths of a
+// registered suite's signature, including the sighash_type byte.
+func isSignedWitnessItem(registry *consensus.SuiteRegistry, w consensus.WitnessItem) bool {
+	params, ok := registry.Lookup(w.SuiteID)
+	return ok && len(w.Pubkey) == params.PubkeyLen && len(w.Signature) == params.SigLen+1
+}
+
+// unsignedInputs walks tx.Witness against the slots each prevout consumes and
+// returns the inputs whose witness is not yet in signed form: a single-key
+// input needs a signed item, a MULTISIG/VAULT input needs Signers signed
+// items with sentinels in the remaining slots.
+func unsignedInputs(tx *consensus.Tx, prevouts []SizeInputJSON) ([]int, error) {
+	if len(prevouts) != len(tx.Inputs) {
+		return nil, fmt.Errorf("bad prevouts")
+	}
+	registry := consensus.DefaultSuiteRegistry()
+	var out []int
+	cursor := 0
+	for i, prev := range prevouts {
+		slots, required := 1, 1
+		switch prev.CovenantType {
+		case consensus.COV_TYPE_P2PK:
+		case consensus.COV_TYPE_MULTISIG, consensus.COV_TYPE_VAULT:
+			slots, required = prev.KeyCount, prev.Signers
+			if slots <= 0 || required <= 0 || required > slots {
+				return nil, fmt.Errorf("bad key_count")
+			}
+		default:
+			return nil, fmt.Errorf("unsupported covenant_type")
+		}
+		signed, malformed := 0, false
+		for slot := 0; slot < slots; slot++ {
+			if cursor >= len(tx.Witness) {
+				malformed = true
+				break
+			}
+			w := tx.Witness[cursor]
+			cursor++
+			switch {
+			case isSignedWitnessItem(registry, w):
+				signed++
+			case w.SuiteID != consensus.SUITE_ID_SENTINEL:
+				malformed = true
+			}
+		}
+		if malformed || signed < required {
+			out = append(out, i)
+		}
+	}
+	return out, nil
+}
+
+// daChunkEntry is one DA_CHUNK_TX payload slice of a DA set.
+type daChunkEntry struct {
+	Index     uint16 `json:"index"`
+	Offset    int    `json:"offset"`
+	Length    int    `json:"length"`
+	ChunkHash string `json:"chunk_hash"`
+}
+
+// daCommitLayout splits payload into CHUNK_BYTES chunks and returns their
+// chunk_hash values alongside the payload commitment that the DA_COMMIT_TX
+// output must carry. Consensus lets the committer pick any da_id; tooling
+// uses the payload commitment so identical payloads share one da_id.
+func daCommitLayout(payload []byte) ([32]byte, []daChunkEntry, error) {
+	count := (len(payload) + consensus.CHUNK_BYTES - 1) / consensus.CHUNK_BYTES
+	if count == 0 || count > consensus.MAX_DA_CHUNK_COUNT {
+		return [32]byte{}, nil, fmt.Errorf("bad payload_hex")
+	}
+	chunks := make([]daChunkEntry, 0, count)
+	for i := 0; i < count; i++ {
+		offset := i * consensus.CHUNK_BYTES
+		end := min(offset+consensus.CHUNK_BYTES, len(payload))
+		chunkHash := sha3.Sum256(payload[offset:end])
+		chunks = append(chunks, daChunkEntry{
+			Index:     uint16(i),
+			Offset:    offset,
+			Length:    end - offset,
+			ChunkHash: hex.EncodeToString(chunkHash[:]),
+		})
+	}
+	return sha3.Sum256(payload), chunks, nil
+}
+
+// rbfFinalSequence is the largest sequence a non-coinbase input may carry
+// (TX_ERR_SEQUENCE_INVALID above it). Following BIP125, an input opts in to
+// replacement with any lower sequence. The node mempool does not replace
+// transactions; this only reports the signal for relay tooling.
+const rbfFinalSequence uint32 = 0x7fffffff
+
+// rbfSignalingInputs returns the indices of inputs that signal
+// replaceability.
+func rbfSignalingInputs(tx *consensus.Tx) []int {
+	var out []int
+	for i, in := range tx.Inputs {
+		if in.Sequence < rbfFinalSequence {
+			out = append(out, i)
+		}
+	}
+	return out
+}
+
+// propagationShortIDBytes is the length of consensus.CompactShortID.
+const propagationShortIDBytes = 6
+
+// relayPlan compares delivering a block to one peer in full against
+// a cmpctblock; Mode is "full" or "compact".
+type relayPlan struct {
+	ID        string  `json:"id"`
+	FullMs    float64 `json:"full_ms"`
+	CompactMs float64 `json:"compact_ms"`
+	Mode      string  `json:"mode"`
+}
+
+// simulatePropagation estimates transfer time per peer. Both modes are pushed
+// unsolicited, so announcement latency cancels out. A cmpctblock carries the
+// header, nonces, one short ID per transaction and the prefilled coinbase;
+// when missPct of transactions are not in the peer's mempool it costs an
+// extra getblocktxn round trip plus those transactions' bytes.
+func simulatePropagation(peers []PropagationPeerJSON, blockBytes uint64, txCount int, missPct float64) ([]relayPlan, error) {
+	if blockBytes < consensus.BLOCK_HEADER_BYTES || txCount <= 0 || missPct < 0 || missPct > 100 {
+		return nil, fmt.Errorf("bad block shape")
+	}
+	bodyBytes := float64(blockBytes - consensus.BLOCK_HEADER_BYTES)
+	avgTxBytes := bodyBytes / float64(txCount)
+	compactBytes := float64(consensus.BLOCK_HEADER_BYTES+16) +
+		float64(len(consensus.EncodeCompactSize(uint64(txCount)))) +
+		float64(txCount*propagationShortIDBytes) +
+		avgTxBytes
+	missingBytes := (bodyBytes - avgTxBytes) * missPct / 100
+
+	out := make([]relayPlan, 0, len(peers))
+	for _, peer := range peers {
+		if peer.BandwidthBPS == 0 {
+			return nil, fmt.Errorf("bad bandwidth_bps")
+		}
+		bytesPerMs := float64(peer.BandwidthBPS) / 1000
+		est := relayPlan{
+			ID:        peer.ID,
+			FullMs:    float64(blockBytes) / bytesPerMs,
+			CompactMs: compactBytes / bytesPerMs,
+			Mode:      "compact",
+		}
+		if missingBytes > 0 {
+			est.CompactMs += float64(peer.RTTMs) + missingBytes/bytesPerMs
+		}
+		if est.FullMs < est.CompactMs {
+			est.Mode = "full"
+		}
+		out = append(out, est)
+	}
+	return out, nil
+}
+
+// computeChange splits what is left of inputSum after target and fee. A
+// remainder below dust is not worth an output and is folded into the fee.
+func computeChange(inputSum, target, fee, dust uint64) (change uint64, create bool, finalFee uint64, err error) {
+	if target > inputSum || fee > inputSum-target {
+		return 0, false, 0, fmt.Errorf("insufficient funds")
+	}
+	remainder := inputSum - target - fee
+	if remainder == 0 || remainder < dust {
+		return 0, false, fee + remainder, nil
+	}
+	return remainder, true, fee, nil
+}
+
+// utxoSizeInput derives the witness shape for spending u from its covenant.
+func utxoSizeInput(u UtxoJSON) (SizeInputJSON, error) {
+	in := SizeInputJSON{CovenantType: u.CovenantType}
+	covData, err := hex.DecodeString(u.CovenantDataHex)
+	if err != nil {
+		return in, fmt.Errorf("bad utxo covenant_data")
+	}
+	switch u.CovenantType {
+	case consensus.COV_TYPE_MULTISIG:
+		m, err := consensus.ParseMultisigCovenantData(covData)
+		if err != nil {
+			return in, fmt.Errorf("bad utxo covenant_data")
+		}
+		in.KeyCount, in.Signers = int(m.KeyCount), int(m.Threshold)
+	case consensus.COV_TYPE_VAULT:
+		v, err := consensus.ParseVaultCovenantDataForSpend(covData)
+		if err != nil {
+			return in, fmt.Errorf("bad utxo covenant_data")
+		}
+		in.KeyCount, in.Signers = int(v.KeyCount), int(v.Threshold)
+	}
+	return in, nil
+}
+
+// coinSelectFee prices a payment spending inputs to one P2PK output, plus a
+// P2PK change output when withChange is set, at feeRate per weight unit.
+func coinSelectFee(inputs []SizeInputJSON, withChange bool, feeRate uint64) (uint64, error) {
+	outputs := []SizeOutputJSON{{CovenantType: consensus.COV_TYPE_P2PK, CovenantDataLen: consensus.MAX_P2PK_COVENANT_DATA}}
+	if withChange {
+		outputs = append(outputs, outputs[0])
+	}
+	tx, err := predictSignedTx(inputs, outputs)
+	if err != nil {
+		return 0, err
+	}
+	weight, _, _, err := consensus.TxWeightAndStats(tx)
+	if err != nil {
+		return 0, err
+	}
+	if feeRate != 0 && weight > math.MaxUint64/feeRate {
+		return 0, fmt.Errorf("fee overflow")
+	}
+	return weight * feeRate, nil
+}
+
+// selectCoins first searches (branch-and-bound over effective values, i.e.
+// value minus the input's own fee) for an input set that pays target without
+// change and wastes less than a change output would cost. Failing that it
+// takes inputs largest-first and returns the remainder as change, unless it
+// is below dust.
+func selectCoins(utxos []UtxoJSON, target uint64, feeRate uint64, dust uint64) (coinSelection, error) {
+	inputs := make([]SizeInputJSON, len(utxos))
+	var total uint64
+	for i, u := range utxos {
+		in, err := utxoSizeInput(u)
+		if err != nil {
+			return coinSelection{}, err
+		}
+		if u.Value > math.MaxUint64-total {
+			return coinSelection{}, fmt.Errorf("utxo value overflow")
+		}
+		total += u.Value
+		inputs[i] = in
+	}
+	baseFee, err := coinSelectFee(nil, false, feeRate)
+	if err != nil {
+		return coinSelection{}, err
+	}
+	changeFee, err := coinSelectFee(nil, true, feeRate)
+	if err != nil {
+		return coinSelection{}, err
+	}
+	changeCost := changeFee - baseFee
+
+	type candidate struct {
+		index     int
+		effective uint64
+	}
+	candidates := make([]candidate, 0, len(utxos))
+	for i, u := range utxos {
+		fee, err := coinSelectFee(inputs[i:i+1], false, feeRate)
+		if err != nil {
+			return coinSelection{}, err
+		}
+		if inputFee := fee - baseFee; u.Value > inputFee {
+			candidates = append(candidates, candidate{index: i, effective: u.Value - inputFee})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].effective > candidates[j].effective })
+
+	selectedInputs := func(indices []int) ([]SizeInputJSON, uint64) {
+		out := make([]SizeInputJSON, len(indices))
+		var sum uint64
+		for k, i := range indices {
+			out[k] = inputs[i]
+			sum += utxos[i].Value
+		}
+		return out, sum
+	}
+
+	if target <= math.MaxUint64-baseFee-changeCost {
+		low := target + baseFee
+		high := low + changeCost
+		remaining := make([]uint64, len(candidates)+1)
+		for i := len(candidates) - 1; i >= 0; i-- {
+			remaining[i] = remaining[i+1] + candidates[i].effective
+		}
+		var best []int
+		bestWaste := uint64(math.MaxUint64)
+		var path []int
+		tries := 0
+		var search func(depth int, sum uint64)
+		search = func(depth int, sum uint64) {
+			tries++
+			if tries > coinSelectMaxTries || sum > high {
+				return
+			}
+			if sum >= low {
+				if waste := sum - low; waste < bestWaste {
+					bestWaste = waste
+					best = append(best[:0], path...)
+				}
+				return
+			}
+			if depth == len(candidates) || sum+remaining[depth] < low {
+				return
+			}
+			path = append(path, candidates[depth].index)
+			search(depth+1, sum+candidates[depth].effective)
+			path = path[:len(path)-1]
+			search(depth+1, sum)
+		}
+		search(0, 0)
+		if best != nil {
+			sort.Ints(best)
+			sel, sum := selectedInputs(best)
+			fee, err := coinSelectFee(sel, false, feeRate)
+			if err == nil && sum >= target && sum-target >= fee {
+				return coinSelection{Indices: best, Fee: sum - target}, nil
+			}
+		}
+	}
+
+	order := make([]int, len(candidates))
+	for k, c := range candidates {
+		order[k] = c.index
+	}
+	return selectLargestFirst(utxos, inputs, order, target, feeRate, dust)
+}
+
+// selectLargestFirst takes utxos in order until they pay target and the fee.
+// The fee is priced on the transaction actually built: with a change output
+// when the remainder is worth one, otherwise without it, the whole remainder
+// then going to the fee.
+func selectLargestFirst(utxos []UtxoJSON, inputs []SizeInputJSON, order []int, target, feeRate, dust uint64) (coinSelection, error) {
+	var indices []int
+	var sel []SizeInputJSON
+	var sum uint64
+	for _, i := range order {
+		indices = append(indices, i)
+		sel = append(sel, inputs[i])
+		sum += utxos[i].Value
+		if sum < target {
+			continue
+		}
+		changeFee, err := coinSelectFee(sel, true, feeRate)
+		if err != nil {
+			return coinSelection{}, err
+		}
+		if sum-target >= changeFee {
+			if change, create, fee, err := computeChange(sum, target, changeFee, dust); err == nil && create {
+				return coinSelection{Indices: sortedIndices(indices), Fee: fee, Change: change}, nil
+			}
+		}
+		fee, err := coinSelectFee(sel, false, feeRate)
+		if err != nil {
+			return coinSelection{}, err
+		}
+		if sum-target >= fee {
+			return coinSelection{Indices: sortedIndices(indices), Fee: sum - target}, nil
+		}
+	}
+	return coinSelection{}, fmt.Errorf("insufficient funds")
+}
+
+func sortedIndices(indices []int) []int {
+	out := slices.Clone(indices)
+	sort.Ints(out)
+	return out
+}
+
+// consensusConstants returns every exported numeric consensus constant
+// keyed by its Go name, plus POW_LIMIT as big-endian hex. Values are read
+// from the consensus package at compile time, so they cannot drift; the
+// constants test pins the key set against the package source.
+func consensusConstants() map[string]any {
+	return map[string]any{
+		"BASE_UNITS_PER_RBN":              uint64(consensus.BASE_UNITS_PER_RBN),
+		"BLOCK_HEADER_BYTES":              uint64(consensus.BLOCK_HEADER_BYTES),
+		"CHUNK_BYTES":                     uint64(consensus.CHUNK_BYTES),
+		"COINBASE_MATURITY":               uint64(consensus.COINBASE_MATURITY),
+		"CORE_STEALTH_WITNESS_SLOTS":      uint64(consensus.CORE_STEALTH_WITNESS_SLOTS),
+		"COV_TYPE_ANCHOR":                 uint64(consensus.COV_TYPE_ANCHOR),
+		"COV_TYPE_CORE_EXT":               uint64(consensus.COV_TYPE_CORE_EXT),
+		"COV_TYPE_CORE_SIMPLICITY":        uint64(consensus.COV_TYPE_CORE_SIMPLICITY),
+		"COV_TYPE_CORE_STEALTH":           uint64(consensus.COV_TYPE_CORE_STEALTH),
+		"COV_TYPE_DA_COMMIT":              uint64(consensus.COV_TYPE_DA_COMMIT),
+		"COV_TYPE_HTLC":                   uint64(consensus.COV_TYPE_HTLC),
+		"COV_TYPE_MULTISIG":               uint64(consensus.COV_TYPE_MULTISIG),
+		"COV_TYPE_P2PK":                   uint64(consensus.COV_TYPE_P2PK),
+		"COV_TYPE_RESERVED_FUTURE":        uint64(consensus.COV_TYPE_RESERVED_FUTURE),
+		"COV_TYPE_VAULT":                  uint64(consensus.COV_TYPE_VAULT),
+		"EMISSION_SPEED_FACTOR":           uint64(consensus.EMISSION_SPEED_FACTOR),
+		"FALLOW_PERIOD":                   uint64(consensus.FALLOW_PERIOD),
+		"GENESIS_ALLOCATION":              uint64(consensus.GENESIS_ALLOCATION),
+		"LOCK_MODE_HEIGHT":                uint64(consensus.LOCK_MODE_HEIGHT),
+		"LOCK_MODE_TIMESTAMP":             uint64(consensus.LOCK_MODE_TIMESTAMP),
+		"MAX_ANCHOR_BYTES_PER_BLOCK":      uint64(consensus.MAX_ANCHOR_BYTES_PER_BLOCK),
+		"MAX_ANCHOR_PAYLOAD_SIZE":         uint64(consensus.MAX_ANCHOR_PAYLOAD_SIZE),
+		"MAX_BLOCK_BYTES":                 uint64(consensus.MAX_BLOCK_BYTES),
+		"MAX_BLOCK_TX_COUNT":              uint64(consensus.MAX_BLOCK_TX_COUNT),
+		"MAX_BLOCK_VERIFY_COST":           uint64(consensus.MAX_BLOCK_VERIFY_COST),
+		"MAX_BLOCK_WEIGHT":                uint64(consensus.MAX_BLOCK_WEIGHT),
+		"MAX_COVENANT_DATA_PER_OUTPUT":    uint64(consensus.MAX_COVENANT_DATA_PER_OUTPUT),
+		"MAX_DA_BATCHES_PER_BLOCK":        uint64(consensus.MAX_DA_BATCHES_PER_BLOCK),
+		"MAX_DA_BYTES_PER_BLOCK":          uint64(consensus.MAX_DA_BYTES_PER_BLOCK),
+		"MAX_DA_CHUNK_COUNT":              uint64(consensus.MAX_DA_CHUNK_COUNT),
+		"MAX_DA_MANIFEST_BYTES_PER_TX":    uint64(consensus.MAX_DA_MANIFEST_BYTES_PER_TX),
+		"MAX_FUTURE_DRIFT":                uint64(consensus.MAX_FUTURE_DRIFT),
+		"MAX_HTLC_COVENANT_DATA":          uint64(consensus.MAX_HTLC_COVENANT_DATA),
+		"MAX_HTLC_PREIMAGE_BYTES":         uint64(consensus.MAX_HTLC_PREIMAGE_BYTES),
+		"MAX_MULTISIG_KEYS":               uint64(consensus.MAX_MULTISIG_KEYS),
+		"MAX_P2PK_COVENANT_DATA":          uint64(consensus.MAX_P2PK_COVENANT_DATA),
+		"MAX_RELAY_MSG_BYTES":             uint64(consensus.MAX_RELAY_MSG_BYTES),
+		"MAX_SCRIPT_SIG_BYTES":            uint64(consensus.MAX_SCRIPT_SIG_BYTES),
+		"MAX_SIMPLICITY_ENVELOPE_BYTES":   uint64(consensus.MAX_SIMPLICITY_ENVELOPE_BYTES),
+		"MAX_SIMPLICITY_PROGRAM_BYTES":    uint64(consensus.MAX_SIMPLICITY_PROGRAM_BYTES),
+		"MAX_SIMPLICITY_STATE_BYTES":      uint64(consensus.MAX_SIMPLICITY_STATE_BYTES),
+		"MAX_STEALTH_COVENANT_DATA":       uint64(consensus.MAX_STEALTH_COVENANT_DATA),
+		"MAX_SUPPLY":                      uint64(consensus.MAX_SUPPLY),
+		"MAX_TIMESTAMP_STEP_PER_BLOCK":    uint64(consensus.MAX_TIMESTAMP_STEP_PER_BLOCK),
+		"MAX_TX_INPUTS":                   uint64(consensus.MAX_TX_INPUTS),
+		"MAX_TX_OUTPUTS":                  uint64(consensus.MAX_TX_OUTPUTS),
+		"MAX_VAULT_KEYS":                  uint64(consensus.MAX_VAULT_KEYS),
+		"MAX_VAULT_WHITELIST_ENTRIES":     uint64(consensus.MAX_VAULT_WHITELIST_ENTRIES),
+		"MAX_WITNESS_BYTES_PER_TX":        uint64(consensus.MAX_WITNESS_BYTES_PER_TX),
+		"MAX_WITNESS_ITEMS":               uint64(consensus.MAX_WITNESS_ITEMS),
+		"MINEABLE_CAP":                    uint64(consensus.MINEABLE_CAP),
+		"MIN_DA_RETENTION_BLOCKS":         uint64(consensus.MIN_DA_RETENTION_BLOCKS),
+		"MIN_HTLC_PREIMAGE_BYTES":         uint64(consensus.MIN_HTLC_PREIMAGE_BYTES),
+		"ML_DSA_87_PUBKEY_BYTES":          uint64(consensus.ML_DSA_87_PUBKEY_BYTES),
+		"ML_DSA_87_SIG_BYTES":             uint64(consensus.ML_DSA_87_SIG_BYTES),
+		"ML_KEM_1024_CT_BYTES":            uint64(consensus.ML_KEM_1024_CT_BYTES),
+		"SIGHASH_ALL":                     uint64(consensus.SIGHASH_ALL),
+		"SIGHASH_ANYONECANPAY":            uint64(consensus.SIGHASH_ANYONECANPAY),
+		"SIGHASH_NONE":                    uint64(consensus.SIGHASH_NONE),
+		"SIGHASH_SINGLE":                  uint64(consensus.SIGHASH_SINGLE),
+		"SIGNAL_THRESHOLD":                uint64(consensus.SIGNAL_THRESHOLD),
+		"SIGNAL_WINDOW":                   uint64(consensus.SIGNAL_WINDOW),
+		"SIMPLICITY_BASE_VERIFY_COST":     uint64(consensus.SIMPLICITY_BASE_VERIFY_COST),
+		"SIMPLICITY_MAX_GROUP_INPUTS":     uint64(consensus.SIMPLICITY_MAX_GROUP_INPUTS),
+		"SIMPLICITY_MAX_GROUP_OUTPUTS":    uint64(consensus.SIMPLICITY_MAX_GROUP_OUTPUTS),
+		"SIMPLICITY_WITNESS_SLOTS":        uint64(consensus.SIMPLICITY_WITNESS_SLOTS),
+		"SLH_DSA_SHAKE_256F_PUBKEY_BYTES": uint64(consensus.SLH_DSA_SHAKE_256F_PUBKEY_BYTES),
+		"SLH_DSA_SHAKE_256F_SIG_BYTES":    uint64(consensus.SLH_DSA_SHAKE_256F_SIG_BYTES),
+		"SUITE_ID_ML_DSA_87":              uint64(consensus.SUITE_ID_ML_DSA_87),
+		"SUITE_ID_SENTINEL":               uint64(consensus.SUITE_ID_SENTINEL),
+		"SUITE_ID_SIMPLICITY_ENVELOPE":    uint64(consensus.SUITE_ID_SIMPLICITY_ENVELOPE),
+		"TAIL_EMISSION_PER_BLOCK":         uint64(consensus.TAIL_EMISSION_PER_BLOCK),
+		"TARGET_BLOCK_INTERVAL":           uint64(consensus.TARGET_BLOCK_INTERVAL),
+		"TX_WIRE_VERSION":                 uint64(consensus.TX_WIRE_VERSION),
+		"VERIFY_COST_ML_DSA_87":           uint64(consensus.VERIFY_COST_ML_DSA_87),
+		"VERIFY_COST_UNKNOWN_SUITE":       uint64(consensus.VERIFY_COST_UNKNOWN_SUITE),
+		"WINDOW_SIZE":                     uint64(consensus.WINDOW_SIZE),
+		"WITNESS_DISCOUNT_DIVISOR":        uint64(consensus.WITNESS_DISCOUNT_DIVISOR),
+		"POW_LIMIT":                       hex.EncodeToString(consensus.POW_LIMIT[:]),
+	}
+}
+
+// errorCodeTable groups consensus error codes by category.
+type errorCodeTable map[string][]consensus.ErrorCode
+
+// consensusErrorCodes returns every consensus ErrorCode grouped by
+// category ("tx" for TX_ERR_*, "block" for BLOCK_ERR_*), each in
+// consensus/errors.go declaration order. The error_codes test pins the
+// set against that file.
+func consensusErrorCodes() errorCodeTable {
+	return errorCodeTable{
+		"tx": {
+			consensus.TX_ERR_PARSE,
+			consensus.TX_ERR_WITNESS_OVERFLOW,
+			consensus.TX_ERR_SIG_NONCANONICAL,
+			consensus.TX_ERR_SIG_ALG_INVALID,
+			consensus.TX_ERR_SIG_INVALID,
+			consensus.TX_ERR_SIGHASH_TYPE_INVALID,
+			consensus.TX_ERR_TIMELOCK_NOT_MET,
+			consensus.TX_ERR_VALUE_CONSERVATION,
+			consensus.TX_ERR_TX_NONCE_INVALID,
+			consensus.TX_ERR_SEQUENCE_INVALID,
+			consensus.TX_ERR_NONCE_REPLAY,
+			consensus.TX_ERR_COVENANT_TYPE_INVALID,
+			consensus.TX_ERR_SIMPLICITY_DECODE,
+			consensus.TX_ERR_SIMPLICITY_PROGRAM_TOO_LARGE,
+			consensus.TX_ERR_SIMPLICITY_ENVELOPE_TOO_LARGE,
+			consensus.TX_ERR_SIMPLICITY_CMR_MISMATCH,
+			consensus.TX_ERR_SIMPLICITY_JET_DISALLOWED,
+			consensus.TX_ERR_SIMPLICITY_BUDGET_EXCEEDED,
+			consensus.TX_ERR_SIMPLICITY_REJECTED,
+			consensus.TX_ERR_VAULT_MALFORMED,
+			consensus.TX_ERR_VAULT_PARAMS_INVALID,
+			consensus.TX_ERR_VAULT_KEYS_NOT_CANONICAL,
+			consensus.TX_ERR_VAULT_WHITELIST_NOT_CANONICAL,
+			consensus.TX_ERR_VAULT_OWNER_DESTINATION_FORBIDDEN,
+			consensus.TX_ERR_VAULT_OWNER_AUTH_REQUIRED,
+			consensus.TX_ERR_VAULT_FEE_SPONSOR_FORBIDDEN,
+			consensus.TX_ERR_VAULT_MULTI_INPUT_FORBIDDEN,
+			consensus.TX_ERR_VAULT_OUTPUT_NOT_WHITELISTED,
+			consensus.TX_ERR_MISSING_UTXO,
+			consensus.TX_ERR_COINBASE_IMMATURE,
+		},
+		"block": {
+			consensus.BLOCK_ERR_PARSE,
+			consensus.BLOCK_ERR_WEIGHT_EXCEEDED,
+			consensus.BLOCK_ERR_ANCHOR_BYTES_EXCEEDED,
+			consensus.BLOCK_ERR_POW_INVALID,
+			consensus.BLOCK_ERR_TARGET_INVALID,
+			consensus.BLOCK_ERR_LINKAGE_INVALID,
+			consensus.BLOCK_ERR_MERKLE_INVALID,
+			consensus.BLOCK_ERR_WITNESS_COMMITMENT,
+			consensus.BLOCK_ERR_COINBASE_INVALID,
+			consensus.BLOCK_ERR_SUBSIDY_EXCEEDED,
+			consensus.BLOCK_ERR_TIMESTAMP_OLD,
+			consensus.BLOCK_ERR_TIMESTAMP_FUTURE,
+			consensus.BLOCK_ERR_DA_INCOMPLETE,
+			consensus.BLOCK_ERR_DA_CHUNK_HASH_INVALID,
+			consensus.BLOCK_ERR_DA_SET_INVALID,
+			consensus.BLOCK_ERR_DA_PAYLOAD_COMMIT_INVALID,
+			consensus.BLOCK_ERR_DA_BATCH_EXCEEDED,
+		},
+	}
+}
+
+// covenantTypes is the covenant_type registry reported by the
+// covenant_types op, in id order. MinLen/MaxLen describe the bounds of
+// consensus.CovenantDataLengthValid, which decides every length check; the
+// covenant_types test holds the two together at every MinLen/MaxLen
+// boundary.
+var covenantTypes = []CovenantType{
+	{ID: consensus.COV_TYPE_P2PK, Name: "CORE_P2PK", DataLenRule: "exact",
+		MinLen: consensus.MAX_P2PK_COVENANT_DATA, MaxLen: consensus.MAX_P2PK_COVENANT_DATA,
+		Layout: "suite_id(1) || key_id(32)"},
+	{ID: consensus.COV_TYPE_ANCHOR, Name: "CORE_ANCHOR", DataLenRule: "range",
+		MinLen: 1, MaxLen: consensus.MAX_ANCHOR_PAYLOAD_SIZE},
+	{ID: consensus.COV_TYPE_RESERVED_FUTURE, Name: "CORE_RESERVED_FUTURE", DataLenRule: "forbidden"},
+	{ID: consensus.COV_TYPE_HTLC, Name: "CORE_HTLC", DataLenRule: "exact",
+		MinLen: consensus.MAX_HTLC_COVENANT_DATA, MaxLen: consensus.MAX_HTLC_COVENANT_DATA,
+		Layout: "hash(32) || lock_mode(1) || lock_value(8) || claim_key_id(32) || refund_key_id(32)"},
+	{ID: consensus.COV_TYPE_VAULT, Name: "CORE_VAULT", DataLenRule: "variable",
+		MinLen: 32 + 1 + 1 + 32 + 2 + 32,
+		MaxLen: 32 + 1 + 1 + 32*consensus.MAX_VAULT_KEYS + 2 + 32*consensus.MAX_VAULT_WHITELIST_ENTRIES,
+		Layout: "owner_lock_id(32) || threshold(1) || key_count(1) || keys(32*key_count) || whitelist_count(2) || whitelist(32*whitelist_count)"},
+	{ID: consensus.COV_TYPE_CORE_EXT, Name: "CORE_EXT", DataLenRule: "forbidden"},
+	{ID: consensus.COV_TYPE_DA_COMMIT, Name: "CORE_DA_COMMIT", DataLenRule: "exact",
+		MinLen: 32, MaxLen: 32,
+		Layout: "da_commitment(32); tx_kind=0x01 only"},
+	{ID: consensus.COV_TYPE_MULTISIG, Name: "CORE_MULTISIG", DataLenRule: "variable",
+		MinLen: 2 + 32, MaxLen: 2 + 32*consensus.MAX_MULTISIG_KEYS,
+		Layout: "threshold(1) || key_count(1) || keys(32*key_count)"},
+	{ID: consensus.COV_TYPE_CORE_STEALTH, Name: "CORE_STEALTH", DataLenRule: "exact",
+		MinLen: consensus.MAX_STEALTH_COVENANT_DATA, MaxLen: consensus.MAX_STEALTH_COVENANT_DATA,
+		Layout: "ml_kem_ciphertext(1568) || one_time_key_id(32)"},
+	{ID: consensus.COV_TYPE_CORE_SIMPLICITY, Name: "CORE_SIMPLICITY", DataLenRule: "variable",
+		MinLen: 32 + 1, MaxLen: 32 + 3 + consensus.MAX_SIMPLICITY_STATE_BYTES,
+		Layout: "program_cmr(32) || compactsize(state_len) || state(state_len); deployment-gated"},
+}
+
+func covenantTypeByID(id uint16) (CovenantType, bool) {
+	for _, ct := range covenantTypes {
+		if ct.ID == id {
+			return ct, true
+		}
+	}
+	return CovenantType{}, false
+}
+
+// utxoApplyDelta diffs the UTXO set around one applied transaction. Spent
+// entries come from before, so the delta doubles as the tx's undo record;
+// created entries are read back from after, so unspendable outputs the
+// apply did not insert (ANCHOR, DA_COMMIT) are left out.
+func utxoApplyDelta(tx *consensus.Tx, txid [32]byte, before, after map[consensus.Outpoint]consensus.UtxoEntry) *UtxoDelta {
+	delta := &UtxoDelta{Spent: []UtxoJSON{}, Created: []UtxoJSON{}}
+	for _, in := range tx.Inputs {
+		op := consensus.Outpoint{Txid: in.PrevTxid, Vout: in.PrevVout}
+		delta.Spent = append(delta.Spent, utxoJSONFor(op, before[op]))
+	}
+	for vout := range tx.Outputs {
+		op := consensus.Outpoint{Txid: txid, Vout: uint32(vout)}
+		if entry, ok := after[op]; ok {
+			delta.Created = append(delta.Created, utxoJSONFor(op, entry))
+		}
+	}
+	return delta
+}
+
+func utxoJSONFor(op consensus.Outpoint, entry consensus.UtxoEntry) UtxoJSON {
+	return UtxoJSON{
+		Txid:              hex.EncodeToString(op.Txid[:]),
+		CovenantDataHex:   hex.EncodeToString(entry.CovenantData),
+		Value:             entry.Value,
+		CreationHeight:    entry.CreationHeight,
+		Vout:              op.Vout,
+		CovenantType:      entry.CovenantType,
+		CreatedByCoinbase: entry.CreatedByCoinbase,
+	}
+}
+
+func utxoSetDiff(before, after map[consensus.Outpoint]consensus.UtxoEntry) *UtxoSetDiff {
+	beforeHash := consensus.UtxoSetHash(before)
+	afterHash := consensus.UtxoSetHash(after)
+	diff := &UtxoSetDiff{
+		Added:      []UtxoJSON{},
+		Removed:    []UtxoJSON{},
+		Changed:    []UtxoJSON{},
+		BeforeHash: hex.EncodeToString(beforeHash[:]),
+		AfterHash:  hex.EncodeToString(afterHash[:]),
+	}
+	for _, op := range sortedOutpoints(before) {
+		entry := before[op]
+		next, ok := after[op]
+		switch {
+		case !ok:
+			diff.Removed = append(diff.Removed, utxoJSONFor(op, entry))
+		case !utxoEntriesEqual(entry, next):
+			diff.Changed = append(diff.Changed, utxoJSONFor(op, next))
+		}
+	}
+	for _, op := range sortedOutpoints(after) {
+		if _, ok := before[op]; !ok {
+			diff.Added = append(diff.Added, utxoJSONFor(op, after[op]))
+		}
+	}
+	return diff
+}
+
+func sortedOutpoints(utxos map[consensus.Outpoint]consensus.UtxoEntry) []consensus.Outpoint {
+	ops := slices.Collect(maps.Keys(utxos))
+	slices.SortFunc(ops, func(a, b consensus.Outpoint) int {
+		if c := bytes.Compare(a.Txid[:], b.Txid[:]); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Vout, b.Vout)
+	})
+	return ops
+}
+
+func utxoEntriesEqual(a, b consensus.UtxoEntry) bool {
+	return a.Value == b.Value &&
+		a.CovenantType == b.CovenantType &&
+		bytes.Equal(a.CovenantData, b.CovenantData) &&
+		a.CreationHeight == b.CreationHeight &&
+		a.CreatedByCoinbase == b.CreatedByCoinbase
+}
+
+// subsidyEpoch names the emission phase block_subsidy(h) is drawn from:
+// "genesis" (no subsidy), "emission" (decaying base reward), or "tail".
+func subsidyEpoch(height uint64, alreadyGenerated uint64) string {
+	switch {
+	case height == 0:
+		return "genesis"
+	case consensus.BlockSubsidy(height, alreadyGenerated) == consensus.TAIL_EMISSION_PER_BLOCK:
+		return "tail"
+	default:
+		return "emission"
+	}
+}
+
 func parseBlockValidationInputs(req Request) ([]byte, *[32]byte, *[32]byte, error) {
 	blockBytes, err := hex.DecodeString(req.BlockHex)
 	if err != nil {
@@ -785,6 +1965,66 @@ func parseBlockValidationInputs(req Request) ([]byte, *[32]byte, *[32]byte, erro
 	return blockBytes, expectedPrev, expectedTarget, nil
 }
 
+// ancestorBlockContext derives the parent hash and the newest-first MTP
+// timestamp window from ancestor headers ordered oldest to newest. The
+// headers must form a linked chain; the last one is the block's parent.
+func ancestorBlockContext(headerHexes []string) (*[32]byte, []uint64, error) {
+	var parent [32]byte
+	timestamps := make([]uint64, 0, len(headerHexes))
+	for i, headerHex := range headerHexes {
+		headerBytes, err := hex.DecodeString(headerHex)
+		if err != nil {
+			return nil, nil, fmt.Errorf("bad header")
+		}
+		header, err := consensus.ParseBlockHeaderBytes(headerBytes)
+		if err != nil {
+			return nil, nil, fmt.Errorf("bad header")
+		}
+		if i > 0 && header.PrevBlockHash != parent {
+			return nil, nil, fmt.Errorf("bad header_hexes: not linked")
+		}
+		if parent, err = consensus.BlockHash(headerBytes); err != nil {
+			return nil, nil, fmt.Errorf("bad header")
+		}
+		timestamps = append(timestamps, header.Timestamp)
+	}
+	slices.Reverse(timestamps)
+	if len(timestamps) > 11 {
+		timestamps = timestamps[:11]
+	}
+	return &parent, timestamps, nil
+}
+
+// expectedWitnessCount returns the number of witness items connecting tx
+// must consume: zero for a coinbase, otherwise the sum of WitnessSlots over
+// the spent prevouts. Without utxos every input is taken as a one-slot
+// (P2PK-style) spend, so the count must equal the input count.
+func expectedWitnessCount(tx *consensus.Tx, items []UtxoJSON) (int, error) {
+	if _, err := consensus.CoinbaseHeight(tx); err == nil {
+		return 0, nil
+	}
+	if len(items) == 0 {
+		return len(tx.Inputs), nil
+	}
+	utxos, err := buildUtxoMap(items)
+	if err != nil {
+		return 0, err
+	}
+	expected := 0
+	for _, in := range tx.Inputs {
+		entry, ok := utxos[consensus.Outpoint{Txid: in.PrevTxid, Vout: in.PrevVout}]
+		if !ok {
+			return 0, fmt.Errorf("bad utxos")
+		}
+		slots, err := consensus.WitnessSlots(entry.CovenantType, entry.CovenantData)
+		if err != nil || slots <= 0 {
+			return 0, fmt.Errorf("bad utxos")
+		}
+		expected += slots
+	}
+	return expected, nil
+}
+
 func buildUtxoMap(items []UtxoJSON) (map[consensus.Outpoint]consensus.UtxoEntry, error) {
 	utxos := make(map[consensus.Outpoint]consensus.UtxoEntry, len(items))
 	for _, item := range items {
@@ -901,10 +2141,51 @@ func feeFromPolicyUTXOs(tx *consensus.Tx, utxos map[consensus.Outpoint]consensus
 	return totalIn - totalOut, nil
 }
 
-func feeBelowRollingFloorPolicy(fee, weight, floor uint64) bool {
-	if weight == 0 {
-		return true
-	}
+// blockRewardBreakdown returns the fees paid by pb's non-coinbase txs and the
+// value its coinbase claims. Inputs resolve against utxos and then against
+// outputs created earlier in the same block; utxos is not modified.
+func blockRewardBreakdown(pb *consensus.ParsedBlock, utxos map[consensus.Outpoint]consensus.UtxoEntry) (uint64, uint64, error) {
+	if len(pb.Txs) == 0 {
+		return 0, 0, fmt.Errorf("missing coinbase")
+	}
+	view := maps.Clone(utxos)
+	var totalFees uint64
+	for i, tx := range pb.Txs[1:] {
+		fee, err := feeFromPolicyUTXOs(tx, view)
+		if err != nil {
+			return 0, 0, err
+		}
+		next, ok := addU64Policy(totalFees, fee)
+		if !ok {
+			return 0, 0, fmt.Errorf("total_fees overflow")
+		}
+		totalFees = next
+		for _, in := range tx.Inputs {
+			delete(view, consensus.Outpoint{Txid: in.PrevTxid, Vout: in.PrevVout})
+		}
+		for vout, out := range tx.Outputs {
+			view[consensus.Outpoint{Txid: pb.Txids[i+1], Vout: uint32(vout)}] = consensus.UtxoEntry{
+				Value:        out.Value,
+				CovenantType: out.CovenantType,
+				CovenantData: out.CovenantData,
+			}
+		}
+	}
+	var coinbaseValue uint64
+	for _, out := range pb.Txs[0].Outputs {
+		next, ok := addU64Policy(coinbaseValue, out.Value)
+		if !ok {
+			return 0, 0, fmt.Errorf("coinbase value overflow")
+		}
+		coinbaseValue = next
+	}
+	return totalFees, coinbaseValue, nil
+}
+
+func feeBelowRollingFloorPolicy(fee, weight, floor uint64) bool {
+	if weight == 0 {
+		return true
+	}
 	if floor < conformanceDefaultMempoolMinFeeRate {
 		floor = conformanceDefaultMempoolMinFeeRate
 	}
@@ -1442,6 +2723,22 @@ func runFromStdin() {
 	req := envelope.Request
 
 	switch req.Op {
+	case "capabilities":
+		writeResp(os.Stdout, Response{Ok: true, SupportedOps: supportedOps})
+		return
+
+	case "constants":
+		writeResp(os.Stdout, Response{Ok: true, Constants: consensusConstants()})
+		return
+
+	case "covenant_types":
+		writeResp(os.Stdout, Response{Ok: true, CovenantTypes: covenantTypes})
+		return
+
+	case "error_codes":
+		writeResp(os.Stdout, Response{Ok: true, ErrorCodes: consensusErrorCodes()})
+		return
+
 	case "simplicity_exec_vector":
 		writeResp(os.Stdout, runSimplicityExecVector(req))
 		return
@@ -1465,6 +2762,106 @@ func runFromStdin() {
 		})
 		return
 
+	case "malleability_check":
+		// txid commits to TxNoWitnessBytes only, so two encodings that differ
+		// solely in witness data (re-signing, padding) share a txid and differ
+		// in wtxid; any other difference changes the txid.
+		txBytes, err := hex.DecodeString(req.TxHex)
+		if err != nil {
+			writeResp(os.Stdout, Response{Ok: false, Err: "bad tx_hex"})
+			return
+		}
+		otherBytes, err := hex.DecodeString(req.OtherTxHex)
+		if err != nil {
+			writeResp(os.Stdout, Response{Ok: false, Err: "bad other_tx_hex"})
+			return
+		}
+		_, txid, wtxid, _, err := consensus.ParseTx(txBytes)
+		if err != nil {
+			writeConsensusErr(os.Stdout, err)
+			return
+		}
+		_, otherTxid, otherWtxid, _, err := consensus.ParseTx(otherBytes)
+		if err != nil {
+			writeConsensusErr(os.Stdout, err)
+			return
+		}
+		sameTxid := txid == otherTxid
+		sameWtxid := wtxid == otherWtxid
+		witnessOnly := sameTxid && !sameWtxid
+		writeResp(os.Stdout, Response{
+			Ok:          true,
+			TxidHex:     hex.EncodeToString(txid[:]),
+			WtxidHex:    hex.EncodeToString(wtxid[:]),
+			SameTxid:    &sameTxid,
+			SameWtxid:   &sameWtxid,
+			WitnessOnly: &witnessOnly,
+		})
+		return
+
+	case "tx_no_witness_bytes":
+		txBytes, err := hex.DecodeString(req.TxHex)
+		if err != nil {
+			writeResp(os.Stdout, Response{Ok: false, Err: "bad hex"})
+			return
+		}
+		tx, txid, _, _, err := consensus.ParseTx(txBytes)
+		if err != nil {
+			writeConsensusErr(os.Stdout, err)
+			return
+		}
+		core, err := consensus.TxNoWitnessBytes(tx)
+		if err != nil {
+			writeConsensusErr(os.Stdout, err)
+			return
+		}
+		writeResp(os.Stdout, Response{
+			Ok:       true,
+			BytesHex: hex.EncodeToString(core),
+			TxidHex:  hex.EncodeToString(txid[:]),
+		})
+		return
+
+	case "witness_bytes":
+		txBytes, err := hex.DecodeString(req.TxHex)
+		if err != nil {
+			writeResp(os.Stdout, Response{Ok: false, Err: "bad hex"})
+			return
+		}
+		tx, _, wtxid, _, err := consensus.ParseTx(txBytes)
+		if err != nil {
+			writeConsensusErr(os.Stdout, err)
+			return
+		}
+		writeResp(os.Stdout, Response{
+			Ok:       true,
+			BytesHex: hex.EncodeToString(consensus.WitnessBytes(tx.Witness)),
+			WtxidHex: hex.EncodeToString(wtxid[:]),
+		})
+		return
+
+	case "compactsize_encode":
+		if req.Value == nil {
+			writeResp(os.Stdout, Response{Ok: false, Err: "bad value"})
+			return
+		}
+		writeResp(os.Stdout, Response{Ok: true, BytesHex: hex.EncodeToString(consensus.EncodeCompactSize(*req.Value))})
+		return
+
+	case "compactsize_decode":
+		raw, err := hex.DecodeString(req.BytesHex)
+		if err != nil {
+			writeResp(os.Stdout, Response{Ok: false, Err: "bad hex"})
+			return
+		}
+		v, n, err := consensus.DecodeCompactSize(raw)
+		if err != nil {
+			writeConsensusErr(os.Stdout, err)
+			return
+		}
+		writeResp(os.Stdout, Response{Ok: true, Value: &v, Consumed: n})
+		return
+
 	case "fork_work":
 		t, err := parseHexU256To32(req.Target)
 		if err != nil {
@@ -1479,6 +2876,56 @@ func runFromStdin() {
 		writeResp(os.Stdout, Response{Ok: true, WorkHex: "0x" + work.Text(16)})
 		return
 
+	case "header_chain_work":
+		if len(req.HeaderHexes) == 0 {
+			writeResp(os.Stdout, Response{Ok: false, Err: "bad header_hexes"})
+			return
+		}
+		total := new(big.Int)
+		works := make([]string, 0, len(req.HeaderHexes))
+		for _, headerHex := range req.HeaderHexes {
+			headerBytes, err := hex.DecodeString(headerHex)
+			if err != nil {
+				writeResp(os.Stdout, Response{Ok: false, Err: "bad header"})
+				return
+			}
+			header, err := consensus.ParseBlockHeaderBytes(headerBytes)
+			if err != nil {
+				writeConsensusErr(os.Stdout, err)
+				return
+			}
+			// WorkFromTarget rejects a zero target and one above POW_LIMIT.
+			w, err := consensus.WorkFromTarget(header.Target)
+			if err != nil {
+				writeConsensusErr(os.Stdout, err)
+				return
+			}
+			total.Add(total, w)
+			works = append(works, "0x"+w.Text(16))
+		}
+		writeResp(os.Stdout, Response{Ok: true, Chainwork: "0x" + total.Text(16), HeaderWorks: works})
+		return
+
+	case "genesis_preimage":
+		header, err := hex.DecodeString(req.HeaderHex)
+		if err != nil || len(header) != consensus.BLOCK_HEADER_BYTES {
+			writeResp(os.Stdout, Response{Ok: false, Err: "bad header_hex"})
+			return
+		}
+		txBytes, err := hex.DecodeString(req.TxHex)
+		if err != nil || len(txBytes) == 0 {
+			writeResp(os.Stdout, Response{Ok: false, Err: "bad tx_hex"})
+			return
+		}
+		preimage := node.GenesisChainIDPreimage(header, txBytes)
+		chainID := sha3.Sum256(preimage)
+		writeResp(os.Stdout, Response{
+			Ok:        true,
+			BytesHex:  hex.EncodeToString(preimage),
+			DigestHex: hex.EncodeToString(chainID[:]),
+		})
+		return
+
 	case "fork_choice_select":
 		if len(req.Chains) == 0 {
 			writeResp(os.Stdout, Response{Ok: false, Err: "bad chains"})
@@ -1487,6 +2934,7 @@ func runFromStdin() {
 		var bestID string
 		var bestWork *big.Int
 		var bestTip []byte
+		var bestSeen *uint64
 
 		for _, c := range req.Chains {
 			if c.ID == "" || len(c.Targets) == 0 {
@@ -1517,10 +2965,11 @@ func runFromStdin() {
 
 			if bestWork == nil ||
 				total.Cmp(bestWork) > 0 ||
-				(total.Cmp(bestWork) == 0 && (bestTip == nil || bytes.Compare(tipb, bestTip) < 0)) {
+				(total.Cmp(bestWork) == 0 && forkChoiceTieBreak(c.FirstSeen, tipb, bestSeen, bestTip)) {
 				bestID = c.ID
 				bestWork = total
 				bestTip = append(bestTip[:0], tipb...)
+				bestSeen = c.FirstSeen
 			}
 		}
 
@@ -1573,6 +3022,46 @@ func runFromStdin() {
 		})
 		return
 
+	case "slh_activation_status":
+		// SLH-DSA is not a native suite yet, so no consensus path gates on it;
+		// the featurebit state machine is the single source for whether it
+		// would be active, and activation_height is cross-checked against it.
+		name := req.Name
+		if name == "" {
+			name = "slh_dsa"
+		}
+		d := consensus.FeatureBitDeployment{
+			Name:          name,
+			Bit:           req.Bit,
+			StartHeight:   req.StartHeight,
+			TimeoutHeight: req.TimeoutHeight,
+		}
+		ev, err := consensus.FeatureBitStateAtHeightFromWindowCounts(d, req.Height, req.WindowSignalCounts)
+		if err != nil {
+			writeConsensusErr(os.Stdout, err)
+			return
+		}
+		slhActive := ev.State == consensus.FEATUREBIT_ACTIVE
+		var consensusActive *bool
+		var agrees *bool
+		if req.ActivationHeight != nil {
+			v := req.Height >= *req.ActivationHeight
+			consensusActive = &v
+			same := v == slhActive
+			agrees = &same
+		}
+		bh := ev.BoundaryHeight
+		writeResp(os.Stdout, Response{
+			Ok:               true,
+			State:            string(ev.State),
+			BoundaryHeight:   &bh,
+			ActivationHeight: req.ActivationHeight,
+			ConsensusActive:  consensusActive,
+			SlhActive:        &slhActive,
+			ActivationAgrees: agrees,
+		})
+		return
+
 	case "merkle_root":
 		txids, err := parseHex32List(req.Txids, "bad txid")
 		if err != nil {
@@ -1601,6 +3090,27 @@ func runFromStdin() {
 		writeResp(os.Stdout, Response{Ok: true, WitnessMerkleHex: hex.EncodeToString(root[:])})
 		return
 
+	case "recompute_witness_commitment":
+		// wtxids is the full template tx set in block order; index 0 is the
+		// coinbase and is zeroed by WitnessMerkleRootWtxids.
+		wtxids, err := parseHex32List(req.Wtxids, "bad wtxid")
+		if err != nil {
+			writeResp(os.Stdout, Response{Ok: false, Err: err.Error()})
+			return
+		}
+		root, err := consensus.WitnessMerkleRootWtxids(wtxids)
+		if err != nil {
+			writeConsensusErr(os.Stdout, err)
+			return
+		}
+		commitment := consensus.WitnessCommitmentHash(root)
+		writeResp(os.Stdout, Response{
+			Ok:               true,
+			WitnessMerkleHex: hex.EncodeToString(root[:]),
+			CommitmentHex:    hex.EncodeToString(commitment[:]),
+		})
+		return
+
 	case "sighash_v1":
 		txBytes, err := hex.DecodeString(req.TxHex)
 		if err != nil {
@@ -1613,23 +3123,197 @@ func runFromStdin() {
 			return
 		}
 
-		chainIDBytes, err := hex.DecodeString(req.ChainIDHex)
-		if err != nil || len(chainIDBytes) != 32 {
-			writeResp(os.Stdout, Response{Ok: false, Err: "bad chain_id"})
+		chainIDBytes, err := hex.DecodeString(req.ChainIDHex)
+		if err != nil || len(chainIDBytes) != 32 {
+			writeResp(os.Stdout, Response{Ok: false, Err: "bad chain_id"})
+			return
+		}
+		var chainID [32]byte
+		copy(chainID[:], chainIDBytes)
+
+		d, err := consensus.SighashV1Digest(tx, req.InputIndex, req.InputValue, chainID)
+		if err != nil {
+			writeConsensusErr(os.Stdout, err)
+			return
+		}
+		writeResp(os.Stdout, Response{Ok: true, DigestHex: hex.EncodeToString(d[:])})
+		return
+
+	case "tx_weight_and_stats":
+		txBytes, err := hex.DecodeString(req.TxHex)
+		if err != nil {
+			writeResp(os.Stdout, Response{Ok: false, Err: "bad hex"})
+			return
+		}
+		tx, _, _, _, err := consensus.ParseTx(txBytes)
+		if err != nil {
+			writeConsensusErr(os.Stdout, err)
+			return
+		}
+		var w uint64
+		var da uint64
+		var anchor uint64
+		if req.RotationDescriptor != nil && len(req.SuiteRegistry) == 0 {
+			writeResp(os.Stdout, Response{Ok: false, Err: "bad suite_registry"})
+			return
+		}
+		if req.RotationDescriptor != nil && len(req.SuiteRegistry) > 0 {
+			reg, regErr := buildSuiteRegistry(req.SuiteRegistry)
+			if regErr != nil {
+				writeResp(os.Stdout, Response{Ok: false, Err: "bad suite_registry"})
+				return
+			}
+			desc := consensus.CryptoRotationDescriptor{
+				Name:         req.RotationDescriptor.Name,
+				OldSuiteID:   req.RotationDescriptor.OldSuiteID,
+				NewSuiteID:   req.RotationDescriptor.NewSuiteID,
+				CreateHeight: req.RotationDescriptor.CreateHeight,
+				SpendHeight:  req.RotationDescriptor.SpendHeight,
+				SunsetHeight: req.RotationDescriptor.SunsetHeight,
+			}
+			if validateErr := consensus.ValidateRotationDescriptorForNetwork(req.Network, desc, reg); validateErr != nil {
+				writeResp(os.Stdout, Response{Ok: false, Err: rotationDescriptorNotActivatedErr})
+				return
+			}
+			rp := consensus.DescriptorRotationProvider{Descriptor: desc}
+			w, da, anchor, err = consensus.TxWeightAndStatsAtHeight(tx, req.Height, rp, reg)
+		} else {
+			w, da, anchor, err = consensus.TxWeightAndStats(tx)
+		}
+		if err != nil {
+			writeConsensusErr(os.Stdout, err)
+			return
+		}
+		writeResp(os.Stdout, Response{Ok: true, Weight: w, DaBytes: da, AnchorBytes: anchor})
+		return
+
+	case "predict_signed_size":
+		tx, err := predictSignedTx(req.SizeInputs, req.SizeOutputs)
+		if err != nil {
+			writeResp(os.Stdout, Response{Ok: false, Err: err.Error()})
+			return
+		}
+		w, da, anchor, err := consensus.TxWeightAndStats(tx)
+		if err != nil {
+			writeConsensusErr(os.Stdout, err)
+			return
+		}
+		vsize := (w + consensus.WITNESS_DISCOUNT_DIVISOR - 1) / consensus.WITNESS_DISCOUNT_DIVISOR
+		writeResp(os.Stdout, Response{Ok: true, Weight: w, VSize: vsize, DaBytes: da, AnchorBytes: anchor})
+		return
+
+	case "coin_select":
+		selection, err := selectCoins(req.Utxos, req.TargetValue, req.FeeRate, req.DustThreshold)
+		if err != nil {
+			writeResp(os.Stdout, Response{Ok: false, Err: err.Error()})
+			return
+		}
+		writeResp(os.Stdout, Response{
+			Ok:              true,
+			SelectedIndices: selection.Indices,
+			Fee:             selection.Fee,
+			Change:          &selection.Change,
+		})
+		return
+
+	case "compute_change":
+		change, create, fee, err := computeChange(req.InputSum, req.TargetValue, req.Fee, req.DustThreshold)
+		if err != nil {
+			writeResp(os.Stdout, Response{Ok: false, Err: err.Error()})
+			return
+		}
+		writeResp(os.Stdout, Response{Ok: true, CreateChange: &create, Change: &change, Fee: fee})
+		return
+
+	case "witness_count_check":
+		txBytes, err := hex.DecodeString(req.TxHex)
+		if err != nil {
+			writeResp(os.Stdout, Response{Ok: false, Err: "bad tx_hex"})
+			return
+		}
+		tx, _, _, _, err := consensus.ParseTx(txBytes)
+		if err != nil {
+			writeConsensusErr(os.Stdout, err)
+			return
+		}
+		expected, err := expectedWitnessCount(tx, req.Utxos)
+		if err != nil {
+			writeResp(os.Stdout, Response{Ok: false, Err: err.Error()})
+			return
+		}
+		if len(tx.Witness) != expected {
+			writeConsensusErr(os.Stdout, &consensus.TxError{
+				Code: consensus.TX_ERR_PARSE,
+				Msg:  fmt.Sprintf("witness_count mismatch: have %d, want %d", len(tx.Witness), expected),
+			})
+			return
+		}
+		count := len(tx.Witness)
+		writeResp(os.Stdout, Response{Ok: true, WitnessCount: &count, ExpectedWitness: &expected})
+		return
+
+	case "tx_signing_complete":
+		txBytes, err := hex.DecodeString(req.TxHex)
+		if err != nil {
+			writeResp(os.Stdout, Response{Ok: false, Err: "bad hex"})
+			return
+		}
+		tx, _, _, _, err := consensus.ParseTx(txBytes)
+		if err != nil {
+			writeConsensusErr(os.Stdout, err)
+			return
+		}
+		unsigned, err := unsignedInputs(tx, req.Prevouts)
+		if err != nil {
+			writeResp(os.Stdout, Response{Ok: false, Err: err.Error()})
+			return
+		}
+		complete := len(unsigned) == 0
+		writeResp(os.Stdout, Response{Ok: true, SigningComplete: &complete, UnsignedInputs: unsigned})
+		return
+
+	case "anchor_chunk":
+		payload, err := hex.DecodeString(req.PayloadHex)
+		if err != nil || len(payload) == 0 {
+			writeResp(os.Stdout, Response{Ok: false, Err: "bad payload_hex"})
+			return
+		}
+		chunks := make([]string, 0, (len(payload)+consensus.MAX_ANCHOR_PAYLOAD_SIZE-1)/consensus.MAX_ANCHOR_PAYLOAD_SIZE)
+		for rest := payload; len(rest) > 0; {
+			n := min(len(rest), consensus.MAX_ANCHOR_PAYLOAD_SIZE)
+			chunks = append(chunks, hex.EncodeToString(rest[:n]))
+			rest = rest[n:]
+		}
+		fits := len(payload) <= consensus.MAX_ANCHOR_BYTES_PER_BLOCK
+		writeResp(os.Stdout, Response{
+			Ok:           true,
+			Chunks:       chunks,
+			ChunkCount:   len(chunks),
+			AnchorBytes:  uint64(len(payload)),
+			FitsBlockCap: &fits,
+		})
+		return
+
+	case "da_commit":
+		payload, err := hex.DecodeString(req.PayloadHex)
+		if err != nil {
+			writeResp(os.Stdout, Response{Ok: false, Err: "bad payload_hex"})
 			return
 		}
-		var chainID [32]byte
-		copy(chainID[:], chainIDBytes)
-
-		d, err := consensus.SighashV1Digest(tx, req.InputIndex, req.InputValue, chainID)
+		daID, chunks, err := daCommitLayout(payload)
 		if err != nil {
-			writeConsensusErr(os.Stdout, err)
+			writeResp(os.Stdout, Response{Ok: false, Err: err.Error()})
 			return
 		}
-		writeResp(os.Stdout, Response{Ok: true, DigestHex: hex.EncodeToString(d[:])})
+		writeResp(os.Stdout, Response{
+			Ok:         true,
+			DaID:       hex.EncodeToString(daID[:]),
+			DaChunks:   chunks,
+			ChunkCount: len(chunks),
+		})
 		return
 
-	case "tx_weight_and_stats":
+	case "signals_rbf":
 		txBytes, err := hex.DecodeString(req.TxHex)
 		if err != nil {
 			writeResp(os.Stdout, Response{Ok: false, Err: "bad hex"})
@@ -1640,41 +3324,47 @@ func runFromStdin() {
 			writeConsensusErr(os.Stdout, err)
 			return
 		}
-		var w uint64
-		var da uint64
-		var anchor uint64
-		if req.RotationDescriptor != nil && len(req.SuiteRegistry) == 0 {
-			writeResp(os.Stdout, Response{Ok: false, Err: "bad suite_registry"})
+		signaling := rbfSignalingInputs(tx)
+		signals := len(signaling) > 0
+		writeResp(os.Stdout, Response{Ok: true, SignalsRBF: &signals, SignalingInputs: signaling})
+		return
+
+	case "min_valid_timelock":
+		// Timelocks pass when height >= lock_value (LOCK_MODE_HEIGHT) or
+		// MTP >= lock_value (LOCK_MODE_TIMESTAMP), so lock_value is the
+		// latest lock the block at req.Height can already satisfy.
+		if req.LockMode == nil {
+			writeResp(os.Stdout, Response{Ok: false, Err: "bad lock_mode"})
 			return
 		}
-		if req.RotationDescriptor != nil && len(req.SuiteRegistry) > 0 {
-			reg, regErr := buildSuiteRegistry(req.SuiteRegistry)
-			if regErr != nil {
-				writeResp(os.Stdout, Response{Ok: false, Err: "bad suite_registry"})
+		switch *req.LockMode {
+		case consensus.LOCK_MODE_HEIGHT:
+			lockValue := req.Height
+			writeResp(os.Stdout, Response{Ok: true, LockValue: &lockValue})
+		case consensus.LOCK_MODE_TIMESTAMP:
+			mtp, ok, err := consensus.MedianTimePast(req.Height, req.PrevTimestamps)
+			if err != nil {
+				writeConsensusErr(os.Stdout, err)
 				return
 			}
-			desc := consensus.CryptoRotationDescriptor{
-				Name:         req.RotationDescriptor.Name,
-				OldSuiteID:   req.RotationDescriptor.OldSuiteID,
-				NewSuiteID:   req.RotationDescriptor.NewSuiteID,
-				CreateHeight: req.RotationDescriptor.CreateHeight,
-				SpendHeight:  req.RotationDescriptor.SpendHeight,
-				SunsetHeight: req.RotationDescriptor.SunsetHeight,
-			}
-			if validateErr := consensus.ValidateRotationDescriptorForNetwork(req.Network, desc, reg); validateErr != nil {
-				writeResp(os.Stdout, Response{Ok: false, Err: rotationDescriptorNotActivatedErr})
+			if !ok || mtp == math.MaxUint64 {
+				writeResp(os.Stdout, Response{Ok: false, Err: "bad prev_timestamps"})
 				return
 			}
-			rp := consensus.DescriptorRotationProvider{Descriptor: desc}
-			w, da, anchor, err = consensus.TxWeightAndStatsAtHeight(tx, req.Height, rp, reg)
-		} else {
-			w, da, anchor, err = consensus.TxWeightAndStats(tx)
+			minTimestamp := mtp + 1
+			writeResp(os.Stdout, Response{Ok: true, LockValue: &mtp, MTP: &mtp, MinBlockTimestamp: &minTimestamp})
+		default:
+			writeResp(os.Stdout, Response{Ok: false, Err: "bad lock_mode"})
 		}
+		return
+
+	case "propagation_sim":
+		estimates, err := simulatePropagation(req.PropagationPeers, req.BlockBytes, req.TxCount, req.MissRatePct)
 		if err != nil {
-			writeConsensusErr(os.Stdout, err)
+			writeResp(os.Stdout, Response{Ok: false, Err: err.Error()})
 			return
 		}
-		writeResp(os.Stdout, Response{Ok: true, Weight: w, DaBytes: da, AnchorBytes: anchor})
+		writeResp(os.Stdout, Response{Ok: true, Propagation: estimates})
 		return
 
 	case "da_fee_floor_policy":
@@ -1831,6 +3521,14 @@ func runFromStdin() {
 		writeResp(os.Stdout, Response{Ok: true, BlockHash: hex.EncodeToString(h[:])})
 		return
 
+	case "header_layout":
+		writeResp(os.Stdout, Response{
+			Ok:           true,
+			HeaderLayout: blockHeaderLayout,
+			NonceField:   "nonce",
+		})
+		return
+
 	case "pow_check":
 		headerBytes, err := hex.DecodeString(req.HeaderHex)
 		if err != nil {
@@ -1861,6 +3559,18 @@ func runFromStdin() {
 		copy(old[:], oldBytes)
 		var newT [32]byte
 		var retErr error
+		if req.TimeWarpMaxBackstep != nil {
+			// Strict mode: the network's time-warp bound applies to the
+			// clamped window form only.
+			if len(req.WindowTimestamps) == 0 || req.PrevWindowLastTs == nil {
+				writeResp(os.Stdout, Response{Ok: false, Err: "time_warp_max_backstep requires window_timestamps and prev_window_last_timestamp"})
+				return
+			}
+			if err := consensus.CheckRetargetTimeWarp(*req.PrevWindowLastTs, req.WindowTimestamps, *req.TimeWarpMaxBackstep); err != nil {
+				writeConsensusErr(os.Stdout, err)
+				return
+			}
+		}
 		if len(req.WindowTimestamps) > 0 {
 			newT, retErr = consensus.RetargetV1Clamped(old, req.WindowTimestamps)
 		} else {
@@ -1873,6 +3583,156 @@ func runFromStdin() {
 		writeResp(os.Stdout, Response{Ok: true, TargetNew: hex.EncodeToString(newT[:])})
 		return
 
+	case "difficulty_sim":
+		startTarget, err := parseHexU256To32(req.StartTarget)
+		if err != nil {
+			writeResp(os.Stdout, Response{Ok: false, Err: "bad start_target"})
+			return
+		}
+		steps, err := simulateDifficulty(startTarget, req.StartHeight, req.BlockIntervals)
+		if err != nil {
+			writeConsensusErr(os.Stdout, err)
+			return
+		}
+		writeResp(os.Stdout, Response{Ok: true, Retargets: steps})
+		return
+
+	case "estimate_fee":
+		feeRate, err := estimateFeeRate(req.FeeHistograms, req.ConfTarget)
+		if err != nil {
+			writeResp(os.Stdout, Response{Ok: false, Err: err.Error()})
+			return
+		}
+		writeResp(os.Stdout, Response{Ok: true, FeeRate: &feeRate})
+		return
+
+	case "coinbase_height":
+		txBytes, err := hex.DecodeString(req.TxHex)
+		if err != nil {
+			writeResp(os.Stdout, Response{Ok: false, Err: "bad hex"})
+			return
+		}
+		tx, _, _, _, err := consensus.ParseTx(txBytes)
+		if err != nil {
+			writeConsensusErr(os.Stdout, err)
+			return
+		}
+		if err := consensus.CheckCoinbaseHeight(tx, req.Height); err != nil {
+			writeConsensusErr(os.Stdout, err)
+			return
+		}
+		height, _ := consensus.CoinbaseHeight(tx)
+		writeResp(os.Stdout, Response{Ok: true, Value: &height})
+		return
+
+	case "coinbase_locktime_check":
+		// Block validation requires coinbase locktime == height and reports a
+		// mismatch only as BLOCK_ERR_COINBASE_INVALID; this isolates that cause.
+		txBytes, err := hex.DecodeString(req.TxHex)
+		if err != nil {
+			writeResp(os.Stdout, Response{Ok: false, Err: "bad hex"})
+			return
+		}
+		tx, _, _, _, err := consensus.ParseTx(txBytes)
+		if err != nil {
+			writeConsensusErr(os.Stdout, err)
+			return
+		}
+		matches := req.Height <= uint64(^uint32(0)) && tx.Locktime == uint32(req.Height)
+		writeResp(os.Stdout, Response{Ok: true, Locktime: &tx.Locktime, LocktimeMatches: &matches})
+		return
+
+	case "coinbase_max_value":
+		subsidy := consensus.BlockSubsidy(req.Height, req.AlreadyGenerated)
+		if subsidy > math.MaxUint64-req.SumFees {
+			writeResp(os.Stdout, Response{Ok: false, Err: "coinbase value overflow"})
+			return
+		}
+		maxValue := subsidy + req.SumFees
+		writeResp(os.Stdout, Response{
+			Ok:      true,
+			Value:   &maxValue,
+			Subsidy: &subsidy,
+			Epoch:   subsidyEpoch(req.Height, req.AlreadyGenerated),
+		})
+		return
+
+	case "block_reward_audit":
+		blockBytes, err := hex.DecodeString(req.BlockHex)
+		if err != nil {
+			writeResp(os.Stdout, Response{Ok: false, Err: "bad block"})
+			return
+		}
+		pb, err := consensus.ParseBlockBytes(blockBytes)
+		if err != nil {
+			writeConsensusErr(os.Stdout, err)
+			return
+		}
+		utxos, err := buildUtxoMap(req.Utxos)
+		if err != nil {
+			writeResp(os.Stdout, Response{Ok: false, Err: err.Error()})
+			return
+		}
+		totalFees, coinbaseValue, err := blockRewardBreakdown(pb, utxos)
+		if err != nil {
+			writeResp(os.Stdout, Response{Ok: false, Err: err.Error()})
+			return
+		}
+		subsidy := consensus.BlockSubsidy(req.Height, req.AlreadyGenerated)
+		within := subsidy <= math.MaxUint64-totalFees && coinbaseValue <= subsidy+totalFees
+		resp := Response{
+			Ok:            within,
+			Subsidy:       &subsidy,
+			TotalFees:     &totalFees,
+			CoinbaseValue: &coinbaseValue,
+			WithinBounds:  &within,
+		}
+		if !within {
+			resp.Err = string(consensus.BLOCK_ERR_SUBSIDY_EXCEEDED)
+		}
+		writeResp(os.Stdout, resp)
+		return
+
+	case "version_bits_check":
+		headerBytes, err := hex.DecodeString(req.HeaderHex)
+		if err != nil {
+			writeResp(os.Stdout, Response{Ok: false, Err: "bad header_hex"})
+			return
+		}
+		header, err := consensus.ParseBlockHeaderBytes(headerBytes)
+		if err != nil {
+			writeConsensusErr(os.Stdout, err)
+			return
+		}
+		conforming := consensus.VersionBitsConforming(header.Version)
+		signaled := make([]int, 0)
+		for bit := uint8(0); bit <= consensus.VERSIONBITS_MAX_BIT; bit++ {
+			if consensus.VersionSignalsBit(header.Version, bit) {
+				signaled = append(signaled, int(bit))
+			}
+		}
+		writeResp(os.Stdout, Response{
+			Ok:                true,
+			HeaderVersion:     &header.Version,
+			VersionConforming: &conforming,
+			SignaledBits:      signaled,
+		})
+		return
+
+	case "block_size_check":
+		blockBytes, err := hex.DecodeString(req.BlockHex)
+		if err != nil {
+			writeResp(os.Stdout, Response{Ok: false, Err: "bad block"})
+			return
+		}
+		if err := consensus.CheckBlockSerializedSize(blockBytes); err != nil {
+			writeConsensusErr(os.Stdout, err)
+			return
+		}
+		size := uint64(len(blockBytes))
+		writeResp(os.Stdout, Response{Ok: true, Value: &size})
+		return
+
 	case "block_basic_check":
 		blockBytes, expectedPrev, expectedTarget, err := parseBlockValidationInputs(req)
 		if err != nil {
@@ -1894,6 +3754,41 @@ func runFromStdin() {
 		writeResp(os.Stdout, Response{Ok: true, BlockHash: hex.EncodeToString(s.BlockHash[:])})
 		return
 
+	case "template_check":
+		blockBytes, expectedPrev, expectedTarget, err := parseBlockValidationInputs(req)
+		if err != nil {
+			writeResp(os.Stdout, Response{Ok: false, Err: err.Error()})
+			return
+		}
+
+		s, err := consensus.ValidateBlockTemplateWithContextAtHeight(
+			blockBytes,
+			expectedPrev,
+			expectedTarget,
+			req.Height,
+			req.PrevTimestamps,
+		)
+		if err != nil {
+			writeConsensusErr(os.Stdout, err)
+			return
+		}
+		writeResp(os.Stdout, Response{Ok: true, BlockHash: hex.EncodeToString(s.BlockHash[:])})
+		return
+
+	case "template_id":
+		blockBytes, err := hex.DecodeString(req.BlockHex)
+		if err != nil {
+			writeResp(os.Stdout, Response{Ok: false, Err: "bad block"})
+			return
+		}
+		id, err := consensus.BlockTemplateID(blockBytes)
+		if err != nil {
+			writeConsensusErr(os.Stdout, err)
+			return
+		}
+		writeResp(os.Stdout, Response{Ok: true, TemplateID: hex.EncodeToString(id[:])})
+		return
+
 	case "block_basic_check_with_fees":
 		blockBytes, expectedPrev, expectedTarget, err := parseBlockValidationInputs(req)
 		if err != nil {
@@ -1917,12 +3812,20 @@ func runFromStdin() {
 		writeResp(os.Stdout, Response{Ok: true, BlockHash: hex.EncodeToString(s.BlockHash[:])})
 		return
 
-	case "connect_block_basic":
+	case "connect_block_basic", "apply_block_full":
 		blockBytes, expectedPrev, expectedTarget, err := parseBlockValidationInputs(req)
 		if err != nil {
 			writeResp(os.Stdout, Response{Ok: false, Err: err.Error()})
 			return
 		}
+		prevTimestamps := req.PrevTimestamps
+		if req.Op == "apply_block_full" && len(req.HeaderHexes) > 0 {
+			expectedPrev, prevTimestamps, err = ancestorBlockContext(req.HeaderHexes)
+			if err != nil {
+				writeResp(os.Stdout, Response{Ok: false, Err: err.Error()})
+				return
+			}
+		}
 
 		utxos, err := buildUtxoMap(req.Utxos)
 		if err != nil {
@@ -1956,7 +3859,7 @@ func runFromStdin() {
 			expectedPrev,
 			expectedTarget,
 			req.Height,
-			req.PrevTimestamps,
+			prevTimestamps,
 			&st,
 			chainID,
 			rotation,
@@ -1995,6 +3898,20 @@ func runFromStdin() {
 		writeResp(os.Stdout, Response{Ok: true})
 		return
 
+	case "utxo_set_diff":
+		before, err := buildUtxoMap(req.Utxos)
+		if err != nil {
+			writeResp(os.Stdout, Response{Ok: false, Err: err.Error()})
+			return
+		}
+		after, err := buildUtxoMap(req.UtxosAfter)
+		if err != nil {
+			writeResp(os.Stdout, Response{Ok: false, Err: "bad utxos_after"})
+			return
+		}
+		writeResp(os.Stdout, Response{Ok: true, UtxoSetDiff: utxoSetDiff(before, after)})
+		return
+
 	case "utxo_apply_basic":
 		txBytes, err := hex.DecodeString(req.TxHex)
 		if err != nil {
@@ -2034,7 +3951,7 @@ func runFromStdin() {
 			return
 		}
 
-		_, s, err := consensus.ApplyNonCoinbaseTxBasicUpdateWithMTPAndSuiteContext(
+		next, s, err := consensus.ApplyNonCoinbaseTxBasicUpdateWithMTPAndSuiteContext(
 			tx,
 			txid,
 			utxos,
@@ -2048,7 +3965,90 @@ func runFromStdin() {
 			writeConsensusErr(os.Stdout, err)
 			return
 		}
-		writeResp(os.Stdout, Response{Ok: true, Fee: s.Fee, UtxoCount: s.UtxoCount})
+		resp := Response{Ok: true, Fee: s.Fee, UtxoCount: s.UtxoCount}
+		if boolOrDefault(req.ReturnDelta, false) {
+			resp.UtxoDelta = utxoApplyDelta(tx, txid, utxos, next)
+		}
+		writeResp(os.Stdout, resp)
+		return
+
+	case "tx_gate_matrix":
+		txBytes, err := hex.DecodeString(req.TxHex)
+		if err != nil {
+			writeResp(os.Stdout, Response{Ok: false, Err: "bad tx_hex"})
+			return
+		}
+		tx, txid, _, _, err := consensus.ParseTx(txBytes)
+		if err != nil {
+			writeConsensusErr(os.Stdout, err)
+			return
+		}
+		utxos, err := buildUtxoMap(req.Utxos)
+		if err != nil {
+			writeResp(os.Stdout, Response{Ok: false, Err: err.Error()})
+			return
+		}
+		blockMTP := req.BlockTimestamp
+		if req.BlockMTP != nil {
+			blockMTP = *req.BlockMTP
+		}
+		chainID, err := parseOptionalChainIDHex(req.ChainIDHex)
+		if err != nil {
+			writeResp(os.Stdout, Response{Ok: false, Err: err.Error()})
+			return
+		}
+
+		matrix := make([]GateOutcome, 0, 2)
+		for _, slhActive := range []bool{false, true} {
+			var rotation consensus.RotationProvider
+			var registry *consensus.SuiteRegistry
+			if slhActive {
+				rotation, registry = slhActiveSuiteContext()
+			}
+			_, _, err := consensus.ApplyNonCoinbaseTxBasicUpdateWithMTPAndSuiteContext(
+				tx,
+				txid,
+				utxos,
+				req.Height,
+				blockMTP,
+				chainID,
+				rotation,
+				registry,
+			)
+			outcome := GateOutcome{SlhActive: slhActive, Ok: err == nil}
+			if err != nil {
+				var txErr *consensus.TxError
+				if errors.As(err, &txErr) {
+					outcome.Err = string(txErr.Code)
+					outcome.Reason = txErr.Msg
+				} else {
+					outcome.Err = err.Error()
+				}
+			}
+			matrix = append(matrix, outcome)
+		}
+		writeResp(os.Stdout, Response{Ok: true, GateMatrix: matrix})
+		return
+
+	case "covenant_witness_compat":
+		if req.SuiteID == nil {
+			writeResp(os.Stdout, Response{Ok: false, Err: "bad suite_id"})
+			return
+		}
+		rotation, registry, err := buildCoreExtSuiteContext(req)
+		if err != nil {
+			writeResp(os.Stdout, Response{Ok: false, Err: err.Error()})
+			return
+		}
+		var nativeSpend *consensus.NativeSuiteSet
+		if rotation != nil {
+			nativeSpend = rotation.NativeSpendSuites(req.Height)
+		}
+		if err := consensus.CovenantWitnessSuitePermitted(req.CovenantType, *req.SuiteID, nativeSpend, registry); err != nil {
+			writeConsensusErr(os.Stdout, err)
+			return
+		}
+		writeResp(os.Stdout, Response{Ok: true})
 		return
 
 	case "compact_shortid":
@@ -2730,6 +4730,40 @@ func runFromStdin() {
 		writeResp(os.Stdout, Response{Ok: true, DigestHex: hex.EncodeToString(h[:])})
 		return
 
+	case "covenant_data_canonical_check":
+		// The length rule is consensus.CovenantDataLengthValid, the table
+		// creation and spend use. Exact-length covenants have one canonical
+		// encoding, so any other length (e.g. trailing padding) is rejected.
+		// Range and variable covenants carry no padding notion: every byte
+		// enters the output descriptor, so two encodings differing only in
+		// trailing bytes are distinct UTXOs as long as both lengths are valid.
+		ct, ok := covenantTypeByID(req.CovenantType)
+		if !ok {
+			writeResp(os.Stdout, Response{Ok: false, Err: "unknown covenant_type"})
+			return
+		}
+		desc, err := outputDescriptorBytes(req.CovenantType, req.CovenantDataHex)
+		if err != nil {
+			writeResp(os.Stdout, Response{Ok: false, Err: "bad covenant_data_hex"})
+			return
+		}
+		dataLen := len(req.CovenantDataHex) / 2
+		switch {
+		case ct.DataLenRule == "forbidden":
+			writeConsensusErr(os.Stdout, &consensus.TxError{Code: consensus.TX_ERR_COVENANT_TYPE_INVALID, Msg: ct.Name + " outputs are forbidden"})
+			return
+		case !consensus.CovenantDataLengthValid(req.CovenantType, dataLen):
+			msg := fmt.Sprintf("non-canonical %s covenant_data length %d", ct.Name, dataLen)
+			if ct.DataLenRule == "exact" {
+				msg += fmt.Sprintf(", want %d", ct.MinLen)
+			}
+			writeConsensusErr(os.Stdout, &consensus.TxError{Code: consensus.TX_ERR_COVENANT_TYPE_INVALID, Msg: msg})
+			return
+		}
+		h := sha3.Sum256(desc)
+		writeResp(os.Stdout, Response{Ok: true, CovenantTypes: []CovenantType{ct}, DigestHex: hex.EncodeToString(h[:])})
+		return
+
 	case "nonce_replay_intrablock":
 		seen := make(map[uint64]struct{}, len(req.Nonces))
 		duplicates := make([]uint64, 0)
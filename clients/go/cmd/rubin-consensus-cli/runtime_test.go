@@ -3,13 +3,20 @@ package main
 import (
 	"bytes"
 	"context"
+	"crypto/sha3"
 	"encoding/binary"
 	"encoding/hex"
 	"encoding/json"
+	"go/ast"
+	"go/parser"
+	"go/token"
 	"io"
 	"math"
+	"math/big"
 	"os"
 	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -362,6 +369,53 @@ func TestRubinConsensusCLI_RunFromStdin_CoversKeyOps(t *testing.T) {
 	t.Run("parse_tx_ok_and_error", func(t *testing.T) {
 		testRuntimeKeyOpParseTx(t, fixture)
 	})
+	t.Run("tx_no_witness_bytes", func(t *testing.T) {
+		testRuntimeKeyOpTxNoWitnessBytes(t, fixture)
+	})
+	t.Run("witness_bytes", func(t *testing.T) {
+		testRuntimeKeyOpWitnessBytes(t, fixture)
+	})
+	t.Run("malleability_check", testRuntimeKeyOpMalleabilityCheck)
+	t.Run("witness_count_check", testRuntimeKeyOpWitnessCountCheck)
+	t.Run("compactsize_encode_decode", testRuntimeKeyOpCompactSize)
+	t.Run("block_size_check", testRuntimeKeyOpBlockSizeCheck)
+	t.Run("block_reward_audit", testRuntimeKeyOpBlockRewardAudit)
+	t.Run("utxo_set_diff", testRuntimeKeyOpUtxoSetDiff)
+	t.Run("version_bits_check", testRuntimeKeyOpVersionBitsCheck)
+	t.Run("slh_activation_status", testRuntimeKeyOpSLHActivationStatus)
+	t.Run("tx_gate_matrix", testRuntimeKeyOpTxGateMatrix)
+	t.Run("covenant_witness_compat", testRuntimeKeyOpCovenantWitnessCompat)
+	t.Run("coinbase_height", testRuntimeKeyOpCoinbaseHeight)
+	t.Run("coinbase_locktime_check", testRuntimeKeyOpCoinbaseLocktimeCheck)
+	t.Run("coinbase_max_value", testRuntimeKeyOpCoinbaseMaxValue)
+	t.Run("genesis_preimage", testRuntimeKeyOpGenesisPreimage)
+	t.Run("header_layout", testRuntimeKeyOpHeaderLayout)
+	t.Run("difficulty_sim", testRuntimeKeyOpDifficultySim)
+	t.Run("estimate_fee", testRuntimeKeyOpEstimateFee)
+	t.Run("predict_signed_size", testRuntimeKeyOpPredictSignedSize)
+	t.Run("coin_select", testRuntimeKeyOpCoinSelect)
+	t.Run("compute_change", testRuntimeKeyOpComputeChange)
+	t.Run("tx_signing_complete", testRuntimeKeyOpTxSigningComplete)
+	t.Run("anchor_chunk", testRuntimeKeyOpAnchorChunk)
+	t.Run("da_commit", testRuntimeKeyOpDaCommit)
+	t.Run("signals_rbf", testRuntimeKeyOpSignalsRBF)
+	t.Run("min_valid_timelock", testRuntimeKeyOpMinValidTimelock)
+	t.Run("propagation_sim", testRuntimeKeyOpPropagationSim)
+	t.Run("capabilities", testRuntimeKeyOpCapabilities)
+	t.Run("error_detail", testRuntimeKeyOpErrorDetail)
+	t.Run("constants", testRuntimeKeyOpConstants)
+	t.Run("covenant_types", testRuntimeKeyOpCovenantTypes)
+	t.Run("covenant_data_canonical_check", testRuntimeKeyOpCovenantDataCanonicalCheck)
+	t.Run("error_codes", testRuntimeKeyOpErrorCodes)
+	t.Run("utxo_apply_basic_delta", testRuntimeKeyOpUtxoApplyDelta)
+	t.Run("template_check", func(t *testing.T) {
+		testRuntimeKeyOpTemplateCheck(t, fixture)
+	})
+	t.Run("template_id", func(t *testing.T) {
+		testRuntimeKeyOpTemplateID(t, fixture)
+	})
+	t.Run("header_chain_work", testRuntimeKeyOpHeaderChainWork)
+	t.Run("apply_block_full", testRuntimeKeyOpApplyBlockFull)
 	t.Run("fork_work_and_choice", func(t *testing.T) {
 		testRuntimeKeyOpForkWorkAndChoice(t)
 	})
@@ -394,21 +448,1599 @@ func TestRubinConsensusCLI_RunFromStdin_CoversKeyOps(t *testing.T) {
 	})
 }
 
-func testRuntimeKeyOpBadRequest(t *testing.T) {
+func testRuntimeKeyOpBadRequest(t *testing.T) {
+	t.Helper()
+	resp := runRawJSON(t, []byte("{"), runFromStdin)
+	if resp.Ok || resp.Err == "" {
+		t.Fatalf("expected error")
+	}
+}
+
+func testRuntimeKeyOpParseTx(t *testing.T, fixture runtimeKeyOpsFixture) {
+	t.Helper()
+	ok := mustRunOk(t, Request{Op: "parse_tx", TxHex: fixture.txHex})
+	if ok.TxidHex == "" || ok.WtxidHex == "" || ok.Consumed == 0 {
+		t.Fatalf("unexpected ok resp: %+v", ok)
+	}
+	_ = mustRunErrAny(t, Request{Op: "parse_tx", TxHex: "00"})
+}
+
+// witnessedRuntimeTxHex returns a non-coinbase transaction carrying witness
+// items and a DA payload length prefix, so the witness-free core differs from
+// the full wire bytes.
+func witnessedRuntimeTxHex(t *testing.T) string {
+	t.Helper()
+	tx := &consensus.Tx{
+		Version: consensus.TX_WIRE_VERSION,
+		TxNonce: 9,
+		Inputs:  []consensus.TxInput{{PrevTxid: [32]byte{0x01}, PrevVout: 1}},
+		Outputs: []consensus.TxOutput{{
+			Value:        5,
+			CovenantType: consensus.COV_TYPE_P2PK,
+			CovenantData: consensus.P2PKCovenantDataForPubkey(make([]byte, consensus.ML_DSA_87_PUBKEY_BYTES)),
+		}},
+		Witness: []consensus.WitnessItem{{
+			SuiteID:   consensus.SUITE_ID_ML_DSA_87,
+			Pubkey:    make([]byte, consensus.ML_DSA_87_PUBKEY_BYTES),
+			Signature: append(make([]byte, consensus.ML_DSA_87_SIG_BYTES), consensus.SIGHASH_ALL),
+		}},
+	}
+	raw, err := consensus.MarshalTx(tx)
+	if err != nil {
+		t.Fatalf("MarshalTx: %v", err)
+	}
+	return hex.EncodeToString(raw)
+}
+
+func testRuntimeKeyOpMalleabilityCheck(t *testing.T) {
+	t.Helper()
+	// Canonical-length witness items with different signature bytes stand in
+	// for two signings of the same transaction.
+	txHex := func(sigByte byte, outValue uint64) string {
+		t.Helper()
+		b, err := consensus.MarshalTx(&consensus.Tx{
+			Version: consensus.TX_WIRE_VERSION,
+			TxNonce: 3,
+			Inputs:  []consensus.TxInput{{PrevTxid: [32]byte{0xaa}}},
+			Outputs: []consensus.TxOutput{{Value: outValue, CovenantType: consensus.COV_TYPE_P2PK, CovenantData: make([]byte, consensus.MAX_P2PK_COVENANT_DATA)}},
+			Witness: []consensus.WitnessItem{{
+				SuiteID:   consensus.SUITE_ID_ML_DSA_87,
+				Pubkey:    bytes.Repeat([]byte{0x11}, consensus.ML_DSA_87_PUBKEY_BYTES),
+				Signature: append(bytes.Repeat([]byte{sigByte}, consensus.ML_DSA_87_SIG_BYTES), consensus.SIGHASH_ALL),
+			}},
+		})
+		if err != nil {
+			t.Fatalf("MarshalTx: %v", err)
+		}
+		return hex.EncodeToString(b)
+	}
+	signedA := txHex(0x22, 5)
+	signedB := txHex(0x33, 5)
+
+	r := mustRunOk(t, Request{Op: "malleability_check", TxHex: signedA, OtherTxHex: signedB})
+	if r.SameTxid == nil || !*r.SameTxid || r.SameWtxid == nil || *r.SameWtxid || r.WitnessOnly == nil || !*r.WitnessOnly {
+		t.Fatalf("re-signed tx: unexpected resp: %+v", r)
+	}
+	if parsed := mustRunOk(t, Request{Op: "parse_tx", TxHex: signedA}); r.TxidHex != parsed.TxidHex || r.WtxidHex != parsed.WtxidHex {
+		t.Fatalf("txid/wtxid=%s/%s, want %s/%s", r.TxidHex, r.WtxidHex, parsed.TxidHex, parsed.WtxidHex)
+	}
+
+	r = mustRunOk(t, Request{Op: "malleability_check", TxHex: signedA, OtherTxHex: txHex(0x22, 6)})
+	if *r.SameTxid || *r.SameWtxid || *r.WitnessOnly {
+		t.Fatalf("structural change: unexpected resp: %+v", r)
+	}
+
+	r = mustRunOk(t, Request{Op: "malleability_check", TxHex: signedA, OtherTxHex: signedA})
+	if !*r.SameTxid || !*r.SameWtxid || *r.WitnessOnly {
+		t.Fatalf("identical tx: unexpected resp: %+v", r)
+	}
+
+	mustRunErr(t, Request{Op: "malleability_check", TxHex: "zz", OtherTxHex: signedB}, "bad tx_hex")
+	mustRunErr(t, Request{Op: "malleability_check", TxHex: signedA, OtherTxHex: "zz"}, "bad other_tx_hex")
+	_ = mustRunErrAny(t, Request{Op: "malleability_check", TxHex: signedA, OtherTxHex: "00"})
+}
+
+func testRuntimeKeyOpWitnessCountCheck(t *testing.T) {
+	t.Helper()
+	item := consensus.WitnessItem{
+		SuiteID:   consensus.SUITE_ID_ML_DSA_87,
+		Pubkey:    bytes.Repeat([]byte{0x11}, consensus.ML_DSA_87_PUBKEY_BYTES),
+		Signature: append(bytes.Repeat([]byte{0x22}, consensus.ML_DSA_87_SIG_BYTES), consensus.SIGHASH_ALL),
+	}
+	inputs := []consensus.TxInput{{PrevTxid: [32]byte{0xaa}}, {PrevTxid: [32]byte{0xbb}}}
+	txHex := func(witnessCount int) string {
+		t.Helper()
+		b, err := consensus.MarshalTx(&consensus.Tx{
+			Version: consensus.TX_WIRE_VERSION,
+			Inputs:  inputs,
+			Outputs: []consensus.TxOutput{{Value: 1, CovenantType: consensus.COV_TYPE_P2PK, CovenantData: make([]byte, consensus.MAX_P2PK_COVENANT_DATA)}},
+			Witness: slices.Repeat([]consensus.WitnessItem{item}, witnessCount),
+		})
+		if err != nil {
+			t.Fatalf("MarshalTx: %v", err)
+		}
+		return hex.EncodeToString(b)
+	}
+
+	r := mustRunOk(t, Request{Op: "witness_count_check", TxHex: txHex(2)})
+	if r.WitnessCount == nil || *r.WitnessCount != 2 || r.ExpectedWitness == nil || *r.ExpectedWitness != 2 {
+		t.Fatalf("equal counts: unexpected resp: %+v", r)
+	}
+	mustRunErr(t, Request{Op: "witness_count_check", TxHex: txHex(1)}, "TX_ERR_PARSE")
+	mustRunErr(t, Request{Op: "witness_count_check", TxHex: txHex(3)}, "TX_ERR_PARSE")
+
+	// With prevouts supplied, a 1-of-2 multisig input consumes key_count
+	// slots, so three witness items cover a multisig input plus a P2PK one.
+	utxos := []UtxoJSON{
+		{Txid: mustHex32([32]byte{0xaa}), CovenantType: consensus.COV_TYPE_MULTISIG, CovenantDataHex: mustHexBytes([]byte{1, 2})},
+		{Txid: mustHex32([32]byte{0xbb}), CovenantType: consensus.COV_TYPE_P2PK},
+	}
+	r = mustRunOk(t, Request{Op: "witness_count_check", TxHex: txHex(3), Utxos: utxos})
+	if *r.ExpectedWitness != 3 {
+		t.Fatalf("multisig prevout: expected_witness_count=%d, want 3", *r.ExpectedWitness)
+	}
+	mustRunErr(t, Request{Op: "witness_count_check", TxHex: txHex(2), Utxos: utxos}, "TX_ERR_PARSE")
+	mustRunErr(t, Request{Op: "witness_count_check", TxHex: txHex(3), Utxos: utxos[:1]}, "bad utxos")
+	mustRunErr(t, Request{Op: "witness_count_check", TxHex: "zz"}, "bad tx_hex")
+}
+
+func testRuntimeKeyOpTxNoWitnessBytes(t *testing.T, fixture runtimeKeyOpsFixture) {
+	t.Helper()
+	for _, txHex := range []string{fixture.txHex, witnessedRuntimeTxHex(t)} {
+		parsed := mustRunOk(t, Request{Op: "parse_tx", TxHex: txHex})
+		r := mustRunOk(t, Request{Op: "tx_no_witness_bytes", TxHex: txHex})
+		core, err := hex.DecodeString(r.BytesHex)
+		if err != nil {
+			t.Fatalf("decode bytes_hex: %v", err)
+		}
+		if !strings.HasPrefix(txHex, r.BytesHex) || len(r.BytesHex) >= len(txHex) {
+			t.Fatalf("no-witness bytes must be a strict prefix of the tx bytes")
+		}
+		sum := sha3.Sum256(core)
+		if got := hex.EncodeToString(sum[:]); got != parsed.TxidHex || r.TxidHex != parsed.TxidHex {
+			t.Fatalf("sha3(no-witness)=%s resp txid=%s, want txid %s", got, r.TxidHex, parsed.TxidHex)
+		}
+	}
+	mustRunErr(t, Request{Op: "tx_no_witness_bytes", TxHex: "zz"}, "bad hex")
+	_ = mustRunErrAny(t, Request{Op: "tx_no_witness_bytes", TxHex: "00"})
+}
+
+func testRuntimeKeyOpWitnessBytes(t *testing.T, fixture runtimeKeyOpsFixture) {
+	t.Helper()
+	for _, txHex := range []string{fixture.txHex, witnessedRuntimeTxHex(t)} {
+		core := mustRunOk(t, Request{Op: "tx_no_witness_bytes", TxHex: txHex})
+		wit := mustRunOk(t, Request{Op: "witness_bytes", TxHex: txHex})
+		if wit.WtxidHex == "" {
+			t.Fatalf("missing wtxid: %+v", wit)
+		}
+		// Both runtime txs carry an empty DA payload, encoded as a single
+		// zero CompactSize after the witness section.
+		if got := core.BytesHex + wit.BytesHex + "00"; got != txHex {
+			t.Fatalf("no-witness || witness || da_payload != tx bytes\n got %s\nwant %s", got, txHex)
+		}
+	}
+	mustRunErr(t, Request{Op: "witness_bytes", TxHex: "zz"}, "bad hex")
+	_ = mustRunErrAny(t, Request{Op: "witness_bytes", TxHex: "00"})
+}
+
+func testRuntimeKeyOpCompactSize(t *testing.T) {
+	t.Helper()
+	cases := []struct {
+		value uint64
+		hex   string
+	}{
+		{0xfc, "fc"},
+		{0xfd, "fdfd00"},
+		{0xffff, "fdffff"},
+		{0x10000, "fe00000100"},
+		{0x100000000, "ff0000000001000000"},
+	}
+	for _, tc := range cases {
+		enc := mustRunOk(t, Request{Op: "compactsize_encode", Value: ptrUint64(tc.value)})
+		if enc.BytesHex != tc.hex {
+			t.Fatalf("encode(%#x)=%s, want %s", tc.value, enc.BytesHex, tc.hex)
+		}
+		dec := mustRunOk(t, Request{Op: "compactsize_decode", BytesHex: tc.hex})
+		if dec.Value == nil || *dec.Value != tc.value || dec.Consumed != len(tc.hex)/2 {
+			t.Fatalf("decode(%s)=%+v, want value=%#x consumed=%d", tc.hex, dec, tc.value, len(tc.hex)/2)
+		}
+	}
+	mustRunErr(t, Request{Op: "compactsize_encode"}, "bad value")
+	mustRunErr(t, Request{Op: "compactsize_decode", BytesHex: "zz"}, "bad hex")
+	mustRunErr(t, Request{Op: "compactsize_decode", BytesHex: "fdfc00"}, "TX_ERR_PARSE")
+	mustRunErr(t, Request{Op: "compactsize_decode", BytesHex: "fe00"}, "TX_ERR_PARSE")
+}
+
+func testRuntimeKeyOpBlockSizeCheck(t *testing.T) {
+	t.Helper()
+	r := mustRunOk(t, Request{Op: "block_size_check", BlockHex: "00"})
+	if r.Value == nil || *r.Value != 1 {
+		t.Fatalf("unexpected resp: %+v", r)
+	}
+	// All-zero bytes would fail header parsing; the cap must reject first.
+	r = mustRunErr(t, Request{Op: "block_size_check", BlockHex: strings.Repeat("00", consensus.MAX_BLOCK_BYTES+1)}, "BLOCK_ERR_PARSE")
+	if r.ErrorDetail == nil || !strings.Contains(r.ErrorDetail.Message, "MAX_BLOCK_BYTES") {
+		t.Fatalf("unexpected error detail: %+v", r.ErrorDetail)
+	}
+	mustRunErr(t, Request{Op: "block_size_check", BlockHex: "zz"}, "bad block")
+}
+
+func testRuntimeKeyOpCoinbaseHeight(t *testing.T) {
+	t.Helper()
+	txHex := mustHexBytes(buildAnchorOnlyCoinbaseLikeTxBytes(t, 9, [32]byte{}))
+	r := mustRunOk(t, Request{Op: "coinbase_height", TxHex: txHex, Height: 9})
+	if r.Value == nil || *r.Value != 9 {
+		t.Fatalf("unexpected resp: %+v", r)
+	}
+	mustRunErr(t, Request{Op: "coinbase_height", TxHex: txHex, Height: 10}, "BLOCK_ERR_COINBASE_INVALID")
+	mustRunErr(t, Request{Op: "coinbase_height", TxHex: "zz"}, "bad hex")
+}
+
+func testRuntimeKeyOpCoinbaseLocktimeCheck(t *testing.T) {
+	t.Helper()
+	txHex := mustHexBytes(buildAnchorOnlyCoinbaseLikeTxBytes(t, 7, [32]byte{}))
+	r := mustRunOk(t, Request{Op: "coinbase_locktime_check", TxHex: txHex, Height: 7})
+	if r.LocktimeMatches == nil || !*r.LocktimeMatches || r.Locktime == nil || *r.Locktime != 7 {
+		t.Fatalf("unexpected matching resp: %+v", r)
+	}
+	r = mustRunOk(t, Request{Op: "coinbase_locktime_check", TxHex: txHex, Height: 8})
+	if r.LocktimeMatches == nil || *r.LocktimeMatches || r.Locktime == nil || *r.Locktime != 7 {
+		t.Fatalf("mismatch not flagged: %+v", r)
+	}
+	r = mustRunOk(t, Request{Op: "coinbase_locktime_check", TxHex: txHex, Height: 1<<32 + 7})
+	if r.LocktimeMatches == nil || *r.LocktimeMatches {
+		t.Fatalf("height above u32 range must not match: %+v", r)
+	}
+	mustRunErr(t, Request{Op: "coinbase_locktime_check", TxHex: "zz"}, "bad hex")
+}
+
+func testRuntimeKeyOpCoinbaseMaxValue(t *testing.T) {
+	t.Helper()
+	const fees = 12_345
+	subsidy := consensus.BlockSubsidy(1, 0)
+	r := mustRunOk(t, Request{Op: "coinbase_max_value", Height: 1, SumFees: fees})
+	if r.Value == nil || *r.Value != subsidy+fees || r.Subsidy == nil || *r.Subsidy != subsidy || r.Epoch != "emission" {
+		t.Fatalf("unexpected emission resp: %+v", r)
+	}
+
+	// Genesis carries no subsidy, so the bound is the fees alone.
+	r = mustRunOk(t, Request{Op: "coinbase_max_value", Height: 0, SumFees: fees})
+	if r.Value == nil || *r.Value != fees || r.Subsidy == nil || *r.Subsidy != 0 || r.Epoch != "genesis" {
+		t.Fatalf("unexpected genesis resp: %+v", r)
+	}
+
+	r = mustRunOk(t, Request{Op: "coinbase_max_value", Height: 1_000_000, AlreadyGenerated: consensus.MINEABLE_CAP, SumFees: fees})
+	if r.Value == nil || *r.Value != consensus.TAIL_EMISSION_PER_BLOCK+fees || r.Epoch != "tail" {
+		t.Fatalf("unexpected tail resp: %+v", r)
+	}
+
+	mustRunErr(t, Request{Op: "coinbase_max_value", Height: 1, SumFees: math.MaxUint64}, "coinbase value overflow")
+}
+
+func testRuntimeKeyOpBlockRewardAudit(t *testing.T) {
+	t.Helper()
+	const height = 5
+	const fee = 700
+	subsidy := consensus.BlockSubsidy(height, 0)
+	p2pk := make([]byte, consensus.MAX_P2PK_COVENANT_DATA)
+	spend, err := consensus.MarshalTx(&consensus.Tx{
+		Version: consensus.TX_WIRE_VERSION,
+		Inputs:  []consensus.TxInput{{PrevTxid: [32]byte{0xaa}}},
+		Outputs: []consensus.TxOutput{{Value: 10_000 - fee, CovenantType: consensus.COV_TYPE_P2PK, CovenantData: p2pk}},
+	})
+	if err != nil {
+		t.Fatalf("MarshalTx(spend): %v", err)
+	}
+	blockHex := func(coinbaseValue uint64) string {
+		t.Helper()
+		coinbase, err := consensus.MarshalTx(&consensus.Tx{
+			Version:  consensus.TX_WIRE_VERSION,
+			Inputs:   []consensus.TxInput{{PrevVout: ^uint32(0), Sequence: ^uint32(0)}},
+			Outputs:  []consensus.TxOutput{{Value: coinbaseValue, CovenantType: consensus.COV_TYPE_P2PK, CovenantData: p2pk}},
+			Locktime: height,
+		})
+		if err != nil {
+			t.Fatalf("MarshalTx(coinbase): %v", err)
+		}
+		block := make([]byte, consensus.BLOCK_HEADER_BYTES)
+		block = consensus.AppendCompactSize(block, 2)
+		block = append(block, coinbase...)
+		return mustHexBytes(append(block, spend...))
+	}
+	utxos := []UtxoJSON{{Txid: mustHex32([32]byte{0xaa}), Value: 10_000, CovenantType: consensus.COV_TYPE_P2PK, CovenantDataHex: mustHexBytes(p2pk)}}
+
+	r := mustRunOk(t, Request{Op: "block_reward_audit", BlockHex: blockHex(subsidy + fee), Utxos: utxos, Height: height})
+	if *r.Subsidy != subsidy || *r.TotalFees != fee || *r.CoinbaseValue != subsidy+fee || !*r.WithinBounds {
+		t.Fatalf("at cap: unexpected resp: %+v", r)
+	}
+
+	r = runRequest(t, Request{Op: "block_reward_audit", BlockHex: blockHex(subsidy + fee + 1), Utxos: utxos, Height: height})
+	if r.Ok || r.Err != "BLOCK_ERR_SUBSIDY_EXCEEDED" || r.WithinBounds == nil || *r.WithinBounds || *r.CoinbaseValue != subsidy+fee+1 {
+		t.Fatalf("over cap: unexpected resp: %+v", r)
+	}
+
+	mustRunErr(t, Request{Op: "block_reward_audit", BlockHex: blockHex(subsidy), Height: height}, "missing utxo")
+	mustRunErr(t, Request{Op: "block_reward_audit", BlockHex: "zz"}, "bad block")
+}
+
+func testRuntimeKeyOpUtxoSetDiff(t *testing.T) {
+	t.Helper()
+	p2pk := mustHexBytes(make([]byte, consensus.MAX_P2PK_COVENANT_DATA))
+	spent := UtxoJSON{Txid: mustHex32([32]byte{0xaa}), Value: 10_000, CovenantType: consensus.COV_TYPE_P2PK, CovenantDataHex: p2pk, CreationHeight: 3}
+	untouched := UtxoJSON{Txid: mustHex32([32]byte{0xbb}), Vout: 1, Value: 50, CovenantType: consensus.COV_TYPE_P2PK, CovenantDataHex: p2pk, CreationHeight: 2}
+
+	// A block at height 5 whose coinbase creates one output and whose only
+	// other tx spends `spent` into a single output.
+	coinbaseOut := UtxoJSON{Txid: mustHex32([32]byte{0xc0}), Value: 1_000, CovenantType: consensus.COV_TYPE_P2PK, CovenantDataHex: p2pk, CreationHeight: 5, CreatedByCoinbase: true}
+	spendOut := UtxoJSON{Txid: mustHex32([32]byte{0xd0}), Value: 9_300, CovenantType: consensus.COV_TYPE_P2PK, CovenantDataHex: p2pk, CreationHeight: 5}
+	before := []UtxoJSON{untouched, spent}
+	after := []UtxoJSON{spendOut, untouched, coinbaseOut}
+
+	r := mustRunOk(t, Request{Op: "utxo_set_diff", Utxos: before, UtxosAfter: after})
+	d := r.UtxoSetDiff
+	if d == nil {
+		t.Fatalf("missing utxo_set_diff: %+v", r)
+	}
+	if !slices.Equal(d.Removed, []UtxoJSON{spent}) {
+		t.Fatalf("removed=%+v, want the spent input", d.Removed)
+	}
+	if !slices.Equal(d.Added, []UtxoJSON{coinbaseOut, spendOut}) {
+		t.Fatalf("added=%+v, want coinbase then spend output in outpoint order", d.Added)
+	}
+	if len(d.Changed) != 0 {
+		t.Fatalf("changed=%+v, want none", d.Changed)
+	}
+	if d.BeforeHash == d.AfterHash {
+		t.Fatalf("before/after hashes must differ")
+	}
+
+	// The reverse diff is what disconnecting the block must undo, and
+	// hashes are independent of input order.
+	back := mustRunOk(t, Request{Op: "utxo_set_diff", Utxos: after, UtxosAfter: []UtxoJSON{spent, untouched}}).UtxoSetDiff
+	if !slices.Equal(back.Added, d.Removed) || !slices.Equal(back.Removed, d.Added) || back.BeforeHash != d.AfterHash || back.AfterHash != d.BeforeHash {
+		t.Fatalf("reverse diff mismatch: %+v", back)
+	}
+
+	bumped := untouched
+	bumped.Value++
+	r = mustRunOk(t, Request{Op: "utxo_set_diff", Utxos: before, UtxosAfter: []UtxoJSON{bumped, spent}})
+	if !slices.Equal(r.UtxoSetDiff.Changed, []UtxoJSON{bumped}) || len(r.UtxoSetDiff.Added) != 0 || len(r.UtxoSetDiff.Removed) != 0 {
+		t.Fatalf("changed entry: unexpected diff: %+v", r.UtxoSetDiff)
+	}
+
+	mustRunErr(t, Request{Op: "utxo_set_diff", Utxos: before, UtxosAfter: []UtxoJSON{{Txid: "zz"}}}, "bad utxos_after")
+}
+
+func testRuntimeKeyOpVersionBitsCheck(t *testing.T) {
+	t.Helper()
+	headerHex := func(version uint32) string {
+		header := make([]byte, consensus.BLOCK_HEADER_BYTES)
+		binary.LittleEndian.PutUint32(header[:4], version)
+		return mustHexBytes(header)
+	}
+
+	r := mustRunOk(t, Request{Op: "version_bits_check", HeaderHex: headerHex(consensus.VERSIONBITS_TOP_BITS | 1 | 1<<3)})
+	if !*r.VersionConforming || !slices.Equal(r.SignaledBits, []int{0, 3}) {
+		t.Fatalf("conforming header: unexpected resp: %+v", r)
+	}
+
+	// The deployment bit is set but the top bits are 010, so nothing signals.
+	r = mustRunOk(t, Request{Op: "version_bits_check", HeaderHex: headerHex(0x40000000 | 1<<3)})
+	if *r.VersionConforming || len(r.SignaledBits) != 0 || *r.HeaderVersion != 0x40000000|1<<3 {
+		t.Fatalf("wrong top bits: unexpected resp: %+v", r)
+	}
+
+	mustRunErr(t, Request{Op: "version_bits_check", HeaderHex: "zz"}, "bad header_hex")
+	_ = mustRunErrAny(t, Request{Op: "version_bits_check", HeaderHex: "00"})
+}
+
+func testRuntimeKeyOpSLHActivationStatus(t *testing.T) {
+	t.Helper()
+	// Locked in by window 0, so the state machine activates at 2W and a
+	// matching activation_height must agree on both sides of the boundary.
+	activation := uint64(2 * consensus.SIGNAL_WINDOW)
+	status := func(height uint64, activationHeight uint64) Response {
+		return mustRunOk(t, Request{
+			Op:                 "slh_activation_status",
+			Bit:                2,
+			StartHeight:        0,
+			TimeoutHeight:      consensus.SIGNAL_WINDOW * 10,
+			ActivationHeight:   &activationHeight,
+			Height:             height,
+			WindowSignalCounts: []uint32{consensus.SIGNAL_THRESHOLD, 0},
+		})
+	}
+
+	r := status(activation-1, activation)
+	if r.State != "LOCKED_IN" || *r.SlhActive || *r.ConsensusActive || !*r.ActivationAgrees {
+		t.Fatalf("before boundary: unexpected resp: %+v", r)
+	}
+	r = status(activation, activation)
+	if r.State != "ACTIVE" || !*r.SlhActive || !*r.ConsensusActive || !*r.ActivationAgrees {
+		t.Fatalf("at boundary: unexpected resp: %+v", r)
+	}
+
+	// An activation_height one window early disagrees while still LOCKED_IN.
+	r = status(consensus.SIGNAL_WINDOW, consensus.SIGNAL_WINDOW)
+	if *r.SlhActive || !*r.ConsensusActive || *r.ActivationAgrees {
+		t.Fatalf("early threshold: unexpected resp: %+v", r)
+	}
+
+	r = mustRunOk(t, Request{Op: "slh_activation_status", TimeoutHeight: consensus.SIGNAL_WINDOW, Height: 1})
+	if r.State != "STARTED" || *r.SlhActive || r.ActivationAgrees != nil {
+		t.Fatalf("no activation_height: unexpected resp: %+v", r)
+	}
+	_ = mustRunErrAny(t, Request{Op: "slh_activation_status", Height: activation, TimeoutHeight: 1})
+}
+
+func testRuntimeKeyOpCovenantWitnessCompat(t *testing.T) {
+	t.Helper()
+	compat := func(covType uint16, suiteID uint8) Request {
+		return Request{Op: "covenant_witness_compat", CovenantType: covType, SuiteID: &suiteID}
+	}
+	mustRunOk(t, compat(consensus.COV_TYPE_P2PK, consensus.SUITE_ID_ML_DSA_87))
+	mustRunOk(t, compat(consensus.COV_TYPE_MULTISIG, consensus.SUITE_ID_SENTINEL))
+	mustRunOk(t, compat(consensus.COV_TYPE_CORE_SIMPLICITY, consensus.SUITE_ID_SIMPLICITY_ENVELOPE))
+	mustRunErr(t, compat(consensus.COV_TYPE_P2PK, consensus.SUITE_ID_SENTINEL), "TX_ERR_SIG_ALG_INVALID")
+	mustRunErr(t, compat(consensus.COV_TYPE_VAULT, predictSLHDSASuiteID), "TX_ERR_SIG_ALG_INVALID")
+	mustRunErr(t, compat(consensus.COV_TYPE_ANCHOR, consensus.SUITE_ID_ML_DSA_87), "TX_ERR_COVENANT_TYPE_INVALID")
+	mustRunErr(t, Request{Op: "covenant_witness_compat", CovenantType: consensus.COV_TYPE_P2PK}, "bad suite_id")
+}
+
+func testRuntimeKeyOpTxGateMatrix(t *testing.T) {
+	t.Helper()
+	prev := consensus.Outpoint{Txid: [32]byte{0xd2}, Vout: 0}
+	// gateRequest builds a P2PK spend whose covenant binds suiteID and the
+	// witness key, so only the suite gate and verification can reject it.
+	gateRequest := func(suiteID uint8, pubLen, sigLen int) Request {
+		t.Helper()
+		pub := bytes.Repeat([]byte{0x5a}, pubLen)
+		covData := consensus.P2PKCovenantDataForPubkey(pub)
+		covData[0] = suiteID
+		raw, err := consensus.MarshalTx(&consensus.Tx{
+			Version: consensus.TX_WIRE_VERSION,
+			TxNonce: 1,
+			Inputs:  []consensus.TxInput{{PrevTxid: prev.Txid, PrevVout: prev.Vout}},
+			Outputs: []consensus.TxOutput{{Value: 900, CovenantType: consensus.COV_TYPE_P2PK, CovenantData: consensus.P2PKCovenantDataForPubkey(make([]byte, consensus.ML_DSA_87_PUBKEY_BYTES))}},
+			Witness: []consensus.WitnessItem{{
+				SuiteID:   suiteID,
+				Pubkey:    pub,
+				Signature: append(make([]byte, sigLen), consensus.SIGHASH_ALL),
+			}},
+		})
+		if err != nil {
+			t.Fatalf("MarshalTx: %v", err)
+		}
+		return Request{
+			Op:     "tx_gate_matrix",
+			TxHex:  hex.EncodeToString(raw),
+			Height: 10,
+			Utxos: []UtxoJSON{{
+				Txid:            hex.EncodeToString(prev.Txid[:]),
+				Vout:            prev.Vout,
+				Value:           1_000,
+				CovenantType:    consensus.COV_TYPE_P2PK,
+				CovenantDataHex: hex.EncodeToString(covData),
+				CreationHeight:  1,
+			}},
+		}
+	}
+
+	// SLH off rejects at the native-suite gate. SLH on lifts the gate, but with
+	// no live SLH-DSA verifier binding the spend still fails closed.
+	r := mustRunOk(t, gateRequest(predictSLHDSASuiteID, consensus.SLH_DSA_SHAKE_256F_PUBKEY_BYTES, consensus.SLH_DSA_SHAKE_256F_SIG_BYTES))
+	if len(r.GateMatrix) != 2 || r.GateMatrix[0].SlhActive || !r.GateMatrix[1].SlhActive {
+		t.Fatalf("slh spend: unexpected matrix: %+v", r.GateMatrix)
+	}
+	off, on := r.GateMatrix[0], r.GateMatrix[1]
+	if off.Ok || off.Err != string(consensus.TX_ERR_SIG_ALG_INVALID) || !strings.Contains(off.Reason, "not in native spend set") {
+		t.Fatalf("slh off: unexpected outcome: %+v", off)
+	}
+	if on.Ok || strings.Contains(on.Reason, "native spend set") {
+		t.Fatalf("slh on: unexpected outcome: %+v", on)
+	}
+
+	// The SLH gate does not change the outcome of an ML-DSA-87 spend.
+	r = mustRunOk(t, gateRequest(consensus.SUITE_ID_ML_DSA_87, consensus.ML_DSA_87_PUBKEY_BYTES, consensus.ML_DSA_87_SIG_BYTES))
+	if len(r.GateMatrix) != 2 || r.GateMatrix[0].Ok || r.GateMatrix[0].Err != r.GateMatrix[1].Err {
+		t.Fatalf("ml-dsa spend: unexpected matrix: %+v", r.GateMatrix)
+	}
+
+	mustRunErr(t, Request{Op: "tx_gate_matrix", TxHex: "zz"}, "bad tx_hex")
+	bad := gateRequest(consensus.SUITE_ID_ML_DSA_87, consensus.ML_DSA_87_PUBKEY_BYTES, consensus.ML_DSA_87_SIG_BYTES)
+	bad.Utxos[0].Txid = "zz"
+	_ = mustRunErrAny(t, bad)
+}
+
+func testRuntimeKeyOpGenesisPreimage(t *testing.T) {
+	t.Helper()
+	block := node.DevnetGenesisBlockBytes()
+	header := block[:consensus.BLOCK_HEADER_BYTES]
+	if block[consensus.BLOCK_HEADER_BYTES] != 0x01 {
+		t.Fatalf("devnet genesis tx_count prefix=%#x, want 0x01", block[consensus.BLOCK_HEADER_BYTES])
+	}
+	tx := block[consensus.BLOCK_HEADER_BYTES+1:]
+	r := mustRunOk(t, Request{Op: "genesis_preimage", HeaderHex: mustHexBytes(header), TxHex: mustHexBytes(tx)})
+	preimage, err := hex.DecodeString(r.BytesHex)
+	if err != nil {
+		t.Fatalf("decode preimage: %v", err)
+	}
+	if len(preimage) != 16+116+1+len(tx) {
+		t.Fatalf("preimage len=%d, want %d", len(preimage), 16+116+1+len(tx))
+	}
+	if string(preimage[:16]) != "RUBIN-GENESIS-v1" || !bytes.Equal(preimage[16:132], header) || preimage[132] != 0x01 || !bytes.Equal(preimage[133:], tx) {
+		t.Fatalf("preimage layout mismatch: %x", preimage)
+	}
+	if want := node.DevnetGenesisChainID(); r.DigestHex != mustHex32(want) {
+		t.Fatalf("chain id=%s, want %x", r.DigestHex, want)
+	}
+	mustRunErr(t, Request{Op: "genesis_preimage", HeaderHex: "00", TxHex: mustHexBytes(tx)}, "bad header_hex")
+	mustRunErr(t, Request{Op: "genesis_preimage", HeaderHex: mustHexBytes(header)}, "bad tx_hex")
+}
+
+func testRuntimeKeyOpHeaderLayout(t *testing.T) {
+	t.Helper()
+	r := mustRunOk(t, Request{Op: "header_layout"})
+	if r.NonceField != "nonce" || len(r.HeaderLayout) != 6 {
+		t.Fatalf("unexpected resp: %+v", r)
+	}
+	fields := make(map[string]headerField, len(r.HeaderLayout))
+	next := 0
+	for _, f := range r.HeaderLayout {
+		if f.Offset != next {
+			t.Fatalf("field %s offset=%d, want %d", f.Name, f.Offset, next)
+		}
+		next += f.Length
+		fields[f.Name] = f
+	}
+	if next != consensus.BLOCK_HEADER_BYTES {
+		t.Fatalf("layout covers %d bytes, want %d", next, consensus.BLOCK_HEADER_BYTES)
+	}
+
+	// Fill every byte with its index so each field parses to a distinct value
+	// that can be re-read from the advertised slice.
+	header := make([]byte, consensus.BLOCK_HEADER_BYTES)
+	for i := range header {
+		header[i] = byte(i)
+	}
+	parsed, err := consensus.ParseBlockHeaderBytes(header)
+	if err != nil {
+		t.Fatalf("ParseBlockHeaderBytes: %v", err)
+	}
+	slice := func(name string) []byte {
+		f, ok := fields[name]
+		if !ok {
+			t.Fatalf("missing field %s", name)
+		}
+		return header[f.Offset : f.Offset+f.Length]
+	}
+	if got := binary.LittleEndian.Uint32(slice("version")); got != parsed.Version {
+		t.Fatalf("version=%d, want %d", got, parsed.Version)
+	}
+	if !bytes.Equal(slice("prev_block_hash"), parsed.PrevBlockHash[:]) {
+		t.Fatalf("prev_block_hash mismatch")
+	}
+	if !bytes.Equal(slice("merkle_root"), parsed.MerkleRoot[:]) {
+		t.Fatalf("merkle_root mismatch")
+	}
+	if got := binary.LittleEndian.Uint64(slice("timestamp")); got != parsed.Timestamp {
+		t.Fatalf("timestamp=%d, want %d", got, parsed.Timestamp)
+	}
+	if !bytes.Equal(slice("target"), parsed.Target[:]) {
+		t.Fatalf("target mismatch")
+	}
+	if got := binary.LittleEndian.Uint64(slice(r.NonceField)); got != parsed.Nonce {
+		t.Fatalf("nonce=%d, want %d", got, parsed.Nonce)
+	}
+}
+
+func testRuntimeKeyOpDifficultySim(t *testing.T) {
+	t.Helper()
+	powLimit := new(big.Int).SetBytes(consensus.POW_LIMIT[:])
+	start := new(big.Int).Rsh(powLimit, 4)
+	window := int(consensus.WINDOW_SIZE)
+
+	// One window at half the target interval, then one at 1s per block.
+	intervals := make([]uint64, 0, 2*window)
+	for i := 0; i < window; i++ {
+		intervals = append(intervals, consensus.TARGET_BLOCK_INTERVAL/2)
+	}
+	for i := 0; i < window; i++ {
+		intervals = append(intervals, 1)
+	}
+	r := mustRunOk(t, Request{Op: "difficulty_sim", StartTarget: "0x" + start.Text(16), BlockIntervals: intervals})
+	if len(r.Retargets) != 2 || r.Retargets[0].Height != uint64(window) || r.Retargets[1].Height != uint64(2*window) {
+		t.Fatalf("unexpected retargets: %+v", r.Retargets)
+	}
+
+	first, ok := new(big.Int).SetString(r.Retargets[0].Target, 16)
+	if !ok {
+		t.Fatalf("bad target %q", r.Retargets[0].Target)
+	}
+	// The window spans WINDOW_SIZE-1 intervals, so the target lands just
+	// under half of the starting target.
+	half := new(big.Int).Rsh(start, 1)
+	floor := new(big.Int).Sub(half, new(big.Int).Rsh(half, 6))
+	if first.Cmp(half) > 0 || first.Cmp(floor) < 0 {
+		t.Fatalf("target after fast window=%x, want ~%x", first, half)
+	}
+
+	second, ok := new(big.Int).SetString(r.Retargets[1].Target, 16)
+	if !ok || second.Cmp(new(big.Int).Rsh(first, 2)) != 0 {
+		t.Fatalf("target after 1s window=%s, want clamp to %x", r.Retargets[1].Target, new(big.Int).Rsh(first, 2))
+	}
+
+	// Starting mid-window backfills the earlier blocks on schedule, so no
+	// retarget happens until the next boundary.
+	r = mustRunOk(t, Request{Op: "difficulty_sim", StartTarget: "0x" + start.Text(16), StartHeight: uint64(window) - 2, BlockIntervals: []uint64{120, 120}})
+	if len(r.Retargets) != 1 || r.Retargets[0].Height != uint64(window) {
+		t.Fatalf("unexpected mid-window retargets: %+v", r.Retargets)
+	}
+
+	mustRunErr(t, Request{Op: "difficulty_sim", StartTarget: "zz"}, "bad start_target")
+}
+
+func testRuntimeKeyOpEstimateFee(t *testing.T) {
+	t.Helper()
+	histograms := [][]FeeBucket{
+		{{FeeRate: 1, Count: 40}, {FeeRate: 5, Count: 30}, {FeeRate: 20, Count: 20}, {FeeRate: 100, Count: 10}},
+		{{FeeRate: 2, Count: 25}, {FeeRate: 10, Count: 25}, {FeeRate: 50, Count: 25}, {FeeRate: 200, Count: 25}},
+		{{FeeRate: 3, Count: 60}, {FeeRate: 30, Count: 40}},
+	}
+	prev := uint64(math.MaxUint64)
+	for _, target := range []uint64{1, 2, 3, 6, 12, 25, 100, 1000} {
+		r := mustRunOk(t, Request{Op: "estimate_fee", FeeHistograms: histograms, ConfTarget: target})
+		if r.FeeRate == nil {
+			t.Fatalf("conf_target=%d: missing fee_rate: %+v", target, r)
+		}
+		if *r.FeeRate > prev {
+			t.Fatalf("conf_target=%d: fee_rate=%d exceeds shorter-target estimate %d", target, *r.FeeRate, prev)
+		}
+		prev = *r.FeeRate
+	}
+	// 300 pooled transactions: the next-block target is the 150th-cheapest.
+	r := mustRunOk(t, Request{Op: "estimate_fee", FeeHistograms: histograms, ConfTarget: 1})
+	if *r.FeeRate != 5 {
+		t.Fatalf("conf_target=1 fee_rate=%d, want 5", *r.FeeRate)
+	}
+	if prev != 1 {
+		t.Fatalf("long-target fee_rate=%d, want the cheapest bucket", prev)
+	}
+
+	mustRunErr(t, Request{Op: "estimate_fee", FeeHistograms: histograms}, "bad conf_target")
+	mustRunErr(t, Request{Op: "estimate_fee", ConfTarget: 1}, "empty fee histograms")
+}
+
+func testRuntimeKeyOpPredictSignedSize(t *testing.T) {
+	t.Helper()
+	inputs := []SizeInputJSON{
+		{CovenantType: consensus.COV_TYPE_P2PK},
+		{CovenantType: consensus.COV_TYPE_MULTISIG, KeyCount: 3, Signers: 2},
+	}
+	outputs := []SizeOutputJSON{
+		{CovenantType: consensus.COV_TYPE_P2PK, CovenantDataLen: consensus.MAX_P2PK_COVENANT_DATA},
+		{CovenantType: consensus.COV_TYPE_P2PK, CovenantDataLen: consensus.MAX_P2PK_COVENANT_DATA},
+	}
+	r := mustRunOk(t, Request{Op: "predict_signed_size", SizeInputs: inputs, SizeOutputs: outputs})
+
+	// Signature bytes do not affect weight, so a transaction of the same shape
+	// with canonical-length witness items stands in for the signed one.
+	signedItem := func() consensus.WitnessItem {
+		return consensus.WitnessItem{
+			SuiteID:   consensus.SUITE_ID_ML_DSA_87,
+			Pubkey:    bytes.Repeat([]byte{0x11}, consensus.ML_DSA_87_PUBKEY_BYTES),
+			Signature: append(bytes.Repeat([]byte{0x22}, consensus.ML_DSA_87_SIG_BYTES), 0x01),
+		}
+	}
+	signed := &consensus.Tx{
+		Version: 1,
+		TxNonce: 7,
+		Inputs: []consensus.TxInput{
+			{PrevTxid: [32]byte{0xaa}, PrevVout: 1},
+			{PrevTxid: [32]byte{0xbb}, PrevVout: 0},
+		},
+		Outputs: []consensus.TxOutput{
+			{Value: 1_000, CovenantType: consensus.COV_TYPE_P2PK, CovenantData: bytes.Repeat([]byte{0x01}, consensus.MAX_P2PK_COVENANT_DATA)},
+			{Value: 2_000, CovenantType: consensus.COV_TYPE_P2PK, CovenantData: bytes.Repeat([]byte{0x02}, consensus.MAX_P2PK_COVENANT_DATA)},
+		},
+		Witness: []consensus.WitnessItem{
+			signedItem(),
+			signedItem(),
+			signedItem(),
+			{SuiteID: consensus.SUITE_ID_SENTINEL},
+		},
+	}
+	txBytes, err := consensus.MarshalTx(signed)
+	if err != nil {
+		t.Fatalf("MarshalTx: %v", err)
+	}
+	actual := mustRunOk(t, Request{Op: "tx_weight_and_stats", TxHex: hex.EncodeToString(txBytes)})
+	if r.Weight != actual.Weight {
+		t.Fatalf("predicted weight=%d, signed weight=%d", r.Weight, actual.Weight)
+	}
+	if r.VSize != (actual.Weight+3)/4 {
+		t.Fatalf("vsize=%d, want ceil(%d/4)", r.VSize, actual.Weight)
+	}
+
+	slh := mustRunOk(t, Request{
+		Op:          "predict_signed_size",
+		SizeInputs:  []SizeInputJSON{{CovenantType: consensus.COV_TYPE_P2PK, Suite: "SLH-DSA-SHAKE-256f"}},
+		SizeOutputs: outputs[:1],
+	})
+	mldsa := mustRunOk(t, Request{Op: "predict_signed_size", SizeInputs: inputs[:1], SizeOutputs: outputs[:1]})
+	if slh.Weight <= mldsa.Weight {
+		t.Fatalf("SLH-DSA weight=%d not above ML-DSA weight=%d", slh.Weight, mldsa.Weight)
+	}
+
+	mustRunErr(t, Request{Op: "predict_signed_size", SizeInputs: []SizeInputJSON{{Suite: "ed25519"}}}, "bad suite")
+	mustRunErr(t, Request{Op: "predict_signed_size", SizeInputs: []SizeInputJSON{{CovenantType: consensus.COV_TYPE_MULTISIG, KeyCount: 1, Signers: 2}}}, "bad key_count")
+	mustRunErr(t, Request{Op: "predict_signed_size", SizeInputs: []SizeInputJSON{{CovenantType: consensus.COV_TYPE_HTLC}}}, "unsupported covenant_type")
+}
+
+func testRuntimeKeyOpCoinSelect(t *testing.T) {
+	t.Helper()
+	utxos := []UtxoJSON{
+		{Value: 100_000, CovenantType: consensus.COV_TYPE_P2PK},
+		{Value: 50_000, CovenantType: consensus.COV_TYPE_P2PK},
+		{Value: 30_000, CovenantType: consensus.COV_TYPE_P2PK},
+		{Value: 20_000, CovenantType: consensus.COV_TYPE_P2PK},
+		{Value: 5_000, CovenantType: consensus.COV_TYPE_P2PK},
+	}
+	payment := SizeOutputJSON{CovenantType: consensus.COV_TYPE_P2PK, CovenantDataLen: consensus.MAX_P2PK_COVENANT_DATA}
+	p2pk := SizeInputJSON{CovenantType: consensus.COV_TYPE_P2PK}
+	const feeRate = 1
+	feeFor := func(inputs int, outputs int) uint64 {
+		t.Helper()
+		r := mustRunOk(t, Request{
+			Op:          "predict_signed_size",
+			SizeInputs:  slices.Repeat([]SizeInputJSON{p2pk}, inputs),
+			SizeOutputs: slices.Repeat([]SizeOutputJSON{payment}, outputs),
+		})
+		return r.Weight * feeRate
+	}
+
+	// 50k+30k pays the target and its own fee exactly, so no change is needed.
+	exactFee := feeFor(2, 1)
+	r := mustRunOk(t, Request{Op: "coin_select", Utxos: utxos, TargetValue: 80_000 - exactFee, FeeRate: feeRate})
+	if !slices.Equal(r.SelectedIndices, []int{1, 2}) || r.Fee != exactFee || r.Change == nil || *r.Change != 0 {
+		t.Fatalf("unexpected exact selection: %+v", r)
+	}
+
+	// No subset lands within a change output's cost of 120k, so the
+	// remainder after fees comes back as change.
+	r = mustRunOk(t, Request{Op: "coin_select", Utxos: utxos, TargetValue: 120_000, FeeRate: feeRate})
+	if r.Change == nil {
+		t.Fatalf("missing change: %+v", r)
+	}
+	changeFee := feeFor(2, 2)
+	if !slices.Equal(r.SelectedIndices, []int{0, 1}) || r.Fee != changeFee || *r.Change != 150_000-120_000-changeFee {
+		t.Fatalf("unexpected change selection: %+v (fee %d)", r, changeFee)
+	}
+
+	mustRunErr(t, Request{Op: "coin_select", Utxos: utxos, TargetValue: 205_000, FeeRate: feeRate}, "insufficient funds")
+}
+
+func testRuntimeKeyOpComputeChange(t *testing.T) {
+	t.Helper()
+	r := mustRunOk(t, Request{Op: "compute_change", InputSum: 100_000, TargetValue: 60_000, Fee: 1_000, DustThreshold: 546})
+	if r.CreateChange == nil || !*r.CreateChange || r.Change == nil || *r.Change != 39_000 || r.Fee != 1_000 {
+		t.Fatalf("unexpected above-dust resp: %+v", r)
+	}
+
+	r = mustRunOk(t, Request{Op: "compute_change", InputSum: 61_500, TargetValue: 60_000, Fee: 1_000, DustThreshold: 546})
+	if r.CreateChange == nil || *r.CreateChange || r.Change == nil || *r.Change != 0 || r.Fee != 1_500 {
+		t.Fatalf("unexpected below-dust resp: %+v", r)
+	}
+
+	mustRunErr(t, Request{Op: "compute_change", InputSum: 60_500, TargetValue: 60_000, Fee: 1_000}, "insufficient funds")
+
+	// coin_select applies the same rule to its largest-first change.
+	utxos := []UtxoJSON{
+		{Value: 100_000, CovenantType: consensus.COV_TYPE_P2PK},
+		{Value: 50_000, CovenantType: consensus.COV_TYPE_P2PK},
+	}
+	r = mustRunOk(t, Request{Op: "coin_select", Utxos: utxos, TargetValue: 120_000, FeeRate: 1})
+	if r.Change == nil || *r.Change == 0 {
+		t.Fatalf("expected change without dust threshold: %+v", r)
+	}
+	change := *r.Change
+	r = mustRunOk(t, Request{Op: "coin_select", Utxos: utxos, TargetValue: 120_000, FeeRate: 1, DustThreshold: change + 1})
+	if r.Change == nil || *r.Change != 0 || r.Fee != 150_000-120_000 {
+		t.Fatalf("expected change folded into fee: %+v", r)
+	}
+
+	// A remainder that pays the change-less transaction but not a change
+	// output is still a valid selection: it is priced without the output.
+	inputs := []SizeInputJSON{{CovenantType: consensus.COV_TYPE_P2PK}, {CovenantType: consensus.COV_TYPE_P2PK}}
+	noChangeFee, err := coinSelectFee(inputs, false, 1)
+	if err != nil {
+		t.Fatalf("coinSelectFee: %v", err)
+	}
+	withChangeFee, err := coinSelectFee(inputs, true, 1)
+	if err != nil {
+		t.Fatalf("coinSelectFee: %v", err)
+	}
+	target := 150_000 - noChangeFee - 1
+	if 150_000-target >= withChangeFee {
+		t.Fatalf("remainder %d covers a change output (%d)", 150_000-target, withChangeFee)
+	}
+	sel, err := selectLargestFirst(utxos, inputs, []int{0, 1}, target, 1, 0)
+	if err != nil {
+		t.Fatalf("selectLargestFirst: %v", err)
+	}
+	if !slices.Equal(sel.Indices, []int{0, 1}) || sel.Change != 0 || sel.Fee != noChangeFee+1 {
+		t.Fatalf("unexpected folded selection: %+v (fee without change %d)", sel, noChangeFee)
+	}
+}
+
+func testRuntimeKeyOpTxSigningComplete(t *testing.T) {
+	t.Helper()
+	signed := consensus.WitnessItem{
+		SuiteID:   consensus.SUITE_ID_ML_DSA_87,
+		Pubkey:    bytes.Repeat([]byte{0x11}, consensus.ML_DSA_87_PUBKEY_BYTES),
+		Signature: append(bytes.Repeat([]byte{0x22}, consensus.ML_DSA_87_SIG_BYTES), 0x01),
+	}
+	sentinel := consensus.WitnessItem{SuiteID: consensus.SUITE_ID_SENTINEL}
+	txHex := func(witness ...consensus.WitnessItem) string {
+		t.Helper()
+		b, err := consensus.MarshalTx(&consensus.Tx{
+			Version: 1,
+			Inputs: []consensus.TxInput{
+				{PrevTxid: [32]byte{0xaa}},
+				{PrevTxid: [32]byte{0xbb}},
+			},
+			Outputs: []consensus.TxOutput{{Value: 1, CovenantType: consensus.COV_TYPE_P2PK, CovenantData: make([]byte, consensus.MAX_P2PK_COVENANT_DATA)}},
+			Witness: witness,
+		})
+		if err != nil {
+			t.Fatalf("MarshalTx: %v", err)
+		}
+		return hex.EncodeToString(b)
+	}
+	p2pk := SizeInputJSON{CovenantType: consensus.COV_TYPE_P2PK}
+
+	r := mustRunOk(t, Request{Op: "tx_signing_complete", TxHex: txHex(signed, sentinel), Prevouts: []SizeInputJSON{p2pk, p2pk}})
+	if r.SigningComplete == nil || *r.SigningComplete || !slices.Equal(r.UnsignedInputs, []int{1}) {
+		t.Fatalf("unexpected partially-signed resp: %+v", r)
+	}
+
+	r = mustRunOk(t, Request{Op: "tx_signing_complete", TxHex: txHex(signed, signed), Prevouts: []SizeInputJSON{p2pk, p2pk}})
+	if r.SigningComplete == nil || !*r.SigningComplete || len(r.UnsignedInputs) != 0 {
+		t.Fatalf("unexpected fully-signed resp: %+v", r)
+	}
+
+	// A 2-of-3 multisig input with one signature is still incomplete, and the
+	// P2PK input after it reads its witness from the right slot.
+	multisig := SizeInputJSON{CovenantType: consensus.COV_TYPE_MULTISIG, KeyCount: 3, Signers: 2}
+	r = mustRunOk(t, Request{Op: "tx_signing_complete", TxHex: txHex(sentinel, signed, sentinel, signed), Prevouts: []SizeInputJSON{multisig, p2pk}})
+	if !slices.Equal(r.UnsignedInputs, []int{0}) {
+		t.Fatalf("unexpected multisig resp: %+v", r)
+	}
+
+	mustRunErr(t, Request{Op: "tx_signing_complete", TxHex: txHex(signed, signed), Prevouts: []SizeInputJSON{p2pk}}, "bad prevouts")
+}
+
+func testRuntimeKeyOpAnchorChunk(t *testing.T) {
 	t.Helper()
-	resp := runRawJSON(t, []byte("{"), runFromStdin)
-	if resp.Ok || resp.Err == "" {
-		t.Fatalf("expected error")
+	payload := make([]byte, 2*consensus.MAX_ANCHOR_PAYLOAD_SIZE+100)
+	for i := range payload {
+		payload[i] = byte(i * 7)
+	}
+	r := mustRunOk(t, Request{Op: "anchor_chunk", PayloadHex: hex.EncodeToString(payload)})
+	if r.ChunkCount != 3 || len(r.Chunks) != 3 || r.AnchorBytes != uint64(len(payload)) {
+		t.Fatalf("unexpected resp: count=%d chunks=%d anchor_bytes=%d", r.ChunkCount, len(r.Chunks), r.AnchorBytes)
+	}
+	if r.FitsBlockCap == nil || *r.FitsBlockCap {
+		t.Fatalf("payload over MAX_ANCHOR_BYTES_PER_BLOCK reported as fitting")
+	}
+	var reassembled []byte
+	for i, chunkHex := range r.Chunks {
+		chunk, err := hex.DecodeString(chunkHex)
+		if err != nil {
+			t.Fatalf("chunk %d: %v", i, err)
+		}
+		if len(chunk) == 0 || len(chunk) > consensus.MAX_ANCHOR_PAYLOAD_SIZE {
+			t.Fatalf("chunk %d len=%d outside (0, %d]", i, len(chunk), consensus.MAX_ANCHOR_PAYLOAD_SIZE)
+		}
+		reassembled = append(reassembled, chunk...)
+	}
+	if !bytes.Equal(reassembled, payload) {
+		t.Fatalf("reassembled payload differs")
+	}
+
+	r = mustRunOk(t, Request{Op: "anchor_chunk", PayloadHex: "deadbeef"})
+	if r.ChunkCount != 1 || r.Chunks[0] != "deadbeef" || r.FitsBlockCap == nil || !*r.FitsBlockCap {
+		t.Fatalf("unexpected small payload resp: %+v", r)
 	}
+	mustRunErr(t, Request{Op: "anchor_chunk"}, "bad payload_hex")
 }
 
-func testRuntimeKeyOpParseTx(t *testing.T, fixture runtimeKeyOpsFixture) {
+func testRuntimeKeyOpDaCommit(t *testing.T) {
 	t.Helper()
-	ok := mustRunOk(t, Request{Op: "parse_tx", TxHex: fixture.txHex})
-	if ok.TxidHex == "" || ok.WtxidHex == "" || ok.Consumed == 0 {
-		t.Fatalf("unexpected ok resp: %+v", ok)
+	payload := bytes.Repeat([]byte{0x5a}, consensus.CHUNK_BYTES+10)
+	payloadHex := hex.EncodeToString(payload)
+	r := mustRunOk(t, Request{Op: "da_commit", PayloadHex: payloadHex})
+	commitment := sha3.Sum256(payload)
+	if r.DaID != hex.EncodeToString(commitment[:]) || r.ChunkCount != 2 || len(r.DaChunks) != 2 {
+		t.Fatalf("unexpected resp: da_id=%s chunk_count=%d", r.DaID, r.ChunkCount)
+	}
+	for i, chunk := range r.DaChunks {
+		want := payload[chunk.Offset : chunk.Offset+chunk.Length]
+		wantHash := sha3.Sum256(want)
+		if int(chunk.Index) != i || chunk.ChunkHash != hex.EncodeToString(wantHash[:]) {
+			t.Fatalf("chunk %d mismatch: %+v", i, chunk)
+		}
 	}
-	_ = mustRunErrAny(t, Request{Op: "parse_tx", TxHex: "00"})
+	if r.DaChunks[0].Length != consensus.CHUNK_BYTES || r.DaChunks[1].Offset != consensus.CHUNK_BYTES || r.DaChunks[1].Length != 10 {
+		t.Fatalf("unexpected chunk layout: %+v", r.DaChunks)
+	}
+
+	again := mustRunOk(t, Request{Op: "da_commit", PayloadHex: payloadHex})
+	if again.DaID != r.DaID {
+		t.Fatalf("da_id not stable: %s vs %s", again.DaID, r.DaID)
+	}
+	payload[0] ^= 0x01
+	other := mustRunOk(t, Request{Op: "da_commit", PayloadHex: hex.EncodeToString(payload)})
+	if other.DaID == r.DaID {
+		t.Fatalf("different payloads share da_id %s", r.DaID)
+	}
+
+	mustRunErr(t, Request{Op: "da_commit"}, "bad payload_hex")
+}
+
+func testRuntimeKeyOpSignalsRBF(t *testing.T) {
+	t.Helper()
+	txHex := func(sequences ...uint32) string {
+		t.Helper()
+		tx := &consensus.Tx{
+			Version: 1,
+			Outputs: []consensus.TxOutput{{Value: 1, CovenantType: consensus.COV_TYPE_P2PK, CovenantData: make([]byte, consensus.MAX_P2PK_COVENANT_DATA)}},
+		}
+		for i, seq := range sequences {
+			tx.Inputs = append(tx.Inputs, consensus.TxInput{PrevTxid: [32]byte{byte(i + 1)}, Sequence: seq})
+			tx.Witness = append(tx.Witness, consensus.WitnessItem{SuiteID: consensus.SUITE_ID_SENTINEL})
+		}
+		b, err := consensus.MarshalTx(tx)
+		if err != nil {
+			t.Fatalf("MarshalTx: %v", err)
+		}
+		return hex.EncodeToString(b)
+	}
+
+	r := mustRunOk(t, Request{Op: "signals_rbf", TxHex: txHex(0x7fffffff, 1)})
+	if r.SignalsRBF == nil || !*r.SignalsRBF || !slices.Equal(r.SignalingInputs, []int{1}) {
+		t.Fatalf("unexpected low-sequence resp: %+v", r)
+	}
+
+	r = mustRunOk(t, Request{Op: "signals_rbf", TxHex: txHex(0x7fffffff, 0x7fffffff)})
+	if r.SignalsRBF == nil || *r.SignalsRBF || len(r.SignalingInputs) != 0 {
+		t.Fatalf("unexpected max-sequence resp: %+v", r)
+	}
+
+	mustRunErr(t, Request{Op: "signals_rbf", TxHex: "zz"}, "bad hex")
+}
+
+func testRuntimeKeyOpMinValidTimelock(t *testing.T) {
+	t.Helper()
+	heightMode := uint8(consensus.LOCK_MODE_HEIGHT)
+	timestampMode := uint8(consensus.LOCK_MODE_TIMESTAMP)
+
+	r := mustRunOk(t, Request{Op: "min_valid_timelock", LockMode: &heightMode, Height: 500})
+	if r.LockValue == nil || *r.LockValue != 500 {
+		t.Fatalf("unexpected height-mode resp: %+v", r)
+	}
+
+	// Newest first; the sorted window's middle entry is 1_000_600.
+	prev := []uint64{1_001_000, 1_000_100, 1_000_900, 1_000_200, 1_000_800, 1_000_300, 1_000_700, 1_000_400, 1_000_600, 1_000_500, 1_001_100}
+	sorted := slices.Clone(prev)
+	slices.Sort(sorted)
+	mtp := sorted[len(sorted)/2]
+	r = mustRunOk(t, Request{Op: "min_valid_timelock", LockMode: &timestampMode, Height: 100, PrevTimestamps: prev})
+	if r.MTP == nil || *r.MTP != mtp || r.LockValue == nil || *r.LockValue != mtp {
+		t.Fatalf("unexpected timestamp-mode resp: %+v (mtp %d)", r, mtp)
+	}
+	if r.MinBlockTimestamp == nil || *r.MinBlockTimestamp != mtp+1 {
+		t.Fatalf("min_block_timestamp=%v, want %d", r.MinBlockTimestamp, mtp+1)
+	}
+
+	mustRunErr(t, Request{Op: "min_valid_timelock", Height: 100}, "bad lock_mode")
+	mustRunErr(t, Request{Op: "min_valid_timelock", LockMode: &timestampMode, Height: 100, PrevTimestamps: prev[:3]}, string(consensus.BLOCK_ERR_PARSE))
+	mustRunErr(t, Request{Op: "min_valid_timelock", LockMode: &timestampMode}, "bad prev_timestamps")
+}
+
+func testRuntimeKeyOpPropagationSim(t *testing.T) {
+	t.Helper()
+	peers := []PropagationPeerJSON{
+		{ID: "slow", BandwidthBPS: 125_000, RTTMs: 100},
+		{ID: "fast", BandwidthBPS: 1_250_000_000, RTTMs: 100},
+	}
+	r := mustRunOk(t, Request{Op: "propagation_sim", PropagationPeers: peers, BlockBytes: 2_000_000, TxCount: 4_000, MissRatePct: 5})
+	if len(r.Propagation) != 2 {
+		t.Fatalf("unexpected resp: %+v", r)
+	}
+	slow, fast := r.Propagation[0], r.Propagation[1]
+	if slow.ID != "slow" || slow.Mode != "compact" || slow.CompactMs >= slow.FullMs {
+		t.Fatalf("low-bandwidth peer: %+v, want compact", slow)
+	}
+	// At 10 Gbps the whole block lands faster than the getblocktxn round trip.
+	if fast.ID != "fast" || fast.Mode != "full" || fast.FullMs >= fast.CompactMs {
+		t.Fatalf("high-bandwidth peer: %+v, want full", fast)
+	}
+
+	// With every transaction already known there is no round trip to pay.
+	r = mustRunOk(t, Request{Op: "propagation_sim", PropagationPeers: peers[1:], BlockBytes: 2_000_000, TxCount: 4_000})
+	if r.Propagation[0].Mode != "compact" {
+		t.Fatalf("no-miss high-bandwidth peer: %+v, want compact", r.Propagation[0])
+	}
+
+	mustRunErr(t, Request{Op: "propagation_sim", PropagationPeers: peers, BlockBytes: 2_000_000}, "bad block shape")
+	mustRunErr(t, Request{Op: "propagation_sim", PropagationPeers: []PropagationPeerJSON{{ID: "x"}}, BlockBytes: 2_000_000, TxCount: 1}, "bad bandwidth_bps")
+}
+
+func testRuntimeKeyOpCapabilities(t *testing.T) {
+	resp := mustRunOk(t, Request{Op: "capabilities"})
+	if resp.ProtocolVersion != cliProtocolVersion || cliProtocolVersion < 1 {
+		t.Fatalf("protocol_version=%d, want %d", resp.ProtocolVersion, cliProtocolVersion)
+	}
+
+	// The advertised list must match the dispatcher exactly.
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "runtime.go", nil, parser.SkipObjectResolution)
+	if err != nil {
+		t.Fatalf("parse runtime.go: %v", err)
+	}
+	var dispatched []string
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Name.Name != "runFromStdin" {
+			continue
+		}
+		for _, stmt := range fn.Body.List {
+			sw, ok := stmt.(*ast.SwitchStmt)
+			if !ok {
+				continue
+			}
+			for _, c := range sw.Body.List {
+				for _, e := range c.(*ast.CaseClause).List {
+					if lit, ok := e.(*ast.BasicLit); ok {
+						op, _ := strconv.Unquote(lit.Value)
+						dispatched = append(dispatched, op)
+					}
+				}
+			}
+		}
+	}
+	slices.Sort(dispatched)
+	if !slices.Equal(resp.SupportedOps, dispatched) {
+		t.Fatalf("supported_ops=%v\ndispatched=%v", resp.SupportedOps, dispatched)
+	}
+
+	// Every response shape carries the version: success, consensus
+	// error, input error, unknown op and an undecodable request.
+	for _, r := range []Response{
+		mustRunOk(t, Request{Op: "compactsize_encode", Value: ptrUint64(1)}),
+		mustRunErr(t, Request{Op: "parse_tx", TxHex: "00"}, "TX_ERR_PARSE"),
+		mustRunErr(t, Request{Op: "parse_tx", TxHex: "zz"}, "bad hex"),
+		mustRunErr(t, Request{Op: "no_such_op"}, "unknown op"),
+		runRawJSON(t, []byte("{"), runFromStdin),
+	} {
+		if r.ProtocolVersion != cliProtocolVersion {
+			t.Fatalf("response %+v lacks protocol_version", r)
+		}
+	}
+}
+
+func testRuntimeKeyOpErrorDetail(t *testing.T) {
+	resp := mustRunErr(t, Request{Op: "parse_tx", TxHex: "00"}, "TX_ERR_PARSE")
+	if resp.ErrorDetail == nil || resp.ErrorDetail.Code != "TX_ERR_PARSE" || resp.ErrorDetail.Message == "" {
+		t.Fatalf("parse failure error_detail=%+v", resp.ErrorDetail)
+	}
+	if resp.ErrorDetail.Field != "" {
+		t.Fatalf("consensus error should not name a field: %+v", resp.ErrorDetail)
+	}
+
+	resp = mustRunErr(t, Request{Op: "compactsize_encode"}, "bad value")
+	if want := (ErrorDetail{Code: cliErrRequest, Message: "bad value", Field: "value"}); resp.ErrorDetail == nil || *resp.ErrorDetail != want {
+		t.Fatalf("input error error_detail=%+v, want %+v", resp.ErrorDetail, want)
+	}
+
+	// "hex" is not a request field, so no Field is claimed.
+	resp = mustRunErr(t, Request{Op: "parse_tx", TxHex: "zz"}, "bad hex")
+	if resp.ErrorDetail == nil || resp.ErrorDetail.Code != cliErrRequest || resp.ErrorDetail.Field != "" {
+		t.Fatalf("bad hex error_detail=%+v", resp.ErrorDetail)
+	}
+
+	if resp := mustRunOk(t, Request{Op: "capabilities"}); resp.ErrorDetail != nil {
+		t.Fatalf("ok response carries error_detail=%+v", resp.ErrorDetail)
+	}
+}
+
+func testRuntimeKeyOpConstants(t *testing.T) {
+	resp := mustRunOk(t, Request{Op: "constants"})
+	for name, want := range map[string]float64{
+		"MAX_BLOCK_WEIGHT":       68_000_000,
+		"WINDOW_SIZE":            10_080,
+		"COINBASE_MATURITY":      100,
+		"MINEABLE_CAP":           4_900_000_000_000_000,
+		"ML_DSA_87_PUBKEY_BYTES": 2592,
+		"ML_DSA_87_SIG_BYTES":    4627,
+		"CHUNK_BYTES":            524_288,
+		"MAX_DA_CHUNK_COUNT":     61,
+		"BLOCK_HEADER_BYTES":     116,
+		"COV_TYPE_MULTISIG":      0x0104,
+	} {
+		if got, ok := resp.Constants[name].(float64); !ok || got != want {
+			t.Fatalf("%s=%v, want %v", name, resp.Constants[name], want)
+		}
+	}
+	if got := resp.Constants["POW_LIMIT"]; got != strings.Repeat("ff", 32) {
+		t.Fatalf("POW_LIMIT=%v", got)
+	}
+
+	// The key set must track the consensus source: every untyped
+	// UPPER_CASE package-level constant, plus POW_LIMIT.
+	files, err := filepath.Glob(filepath.Join("..", "..", "consensus", "*.go"))
+	if err != nil {
+		t.Fatalf("glob: %v", err)
+	}
+	want := []string{"POW_LIMIT"}
+	fset := token.NewFileSet()
+	for _, path := range files {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, path, nil, parser.SkipObjectResolution)
+		if err != nil {
+			t.Fatalf("parse %s: %v", path, err)
+		}
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.CONST {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				vs := spec.(*ast.ValueSpec)
+				if vs.Type != nil {
+					continue
+				}
+				for _, name := range vs.Names {
+					if name.IsExported() && strings.ToUpper(name.Name) == name.Name {
+						want = append(want, name.Name)
+					}
+				}
+			}
+		}
+	}
+	slices.Sort(want)
+	got := make([]string, 0, len(resp.Constants))
+	for name := range resp.Constants {
+		got = append(got, name)
+	}
+	slices.Sort(got)
+	if !slices.Equal(got, want) {
+		t.Fatalf("constants keys drifted from consensus source:\ngot  %v\nwant %v", got, want)
+	}
+}
+
+// covenantTypeSample returns well-formed covenant_data of length n for the
+// given type (n must be one of the lengths the registry accepts), plus the
+// tx_kind and output value creation requires.
+func covenantTypeSample(t *testing.T, id uint16, n int) ([]byte, uint8, uint64) {
+	t.Helper()
+	key := func(i int) []byte {
+		k := make([]byte, 32)
+		binary.BigEndian.PutUint16(k, uint16(i+1))
+		return k
+	}
+	keyed := func(prefix []byte, count int) []byte {
+		out := append([]byte(nil), prefix...)
+		for i := 0; i < count; i++ {
+			out = append(out, key(i)...)
+		}
+		return out
+	}
+	switch id {
+	case consensus.COV_TYPE_P2PK:
+		return append([]byte{consensus.SUITE_ID_ML_DSA_87}, key(0)...), 0x00, 1
+	case consensus.COV_TYPE_ANCHOR:
+		return bytes.Repeat([]byte{0xa5}, n), 0x00, 0
+	case consensus.COV_TYPE_HTLC:
+		data := make([]byte, 0, n)
+		data = append(data, make([]byte, 32)...)
+		data = append(data, consensus.LOCK_MODE_HEIGHT)
+		data = binary.LittleEndian.AppendUint64(data, 1)
+		return append(append(data, key(0)...), key(1)...), 0x00, 1
+	case consensus.COV_TYPE_DA_COMMIT:
+		return make([]byte, 32), 0x01, 0
+	case consensus.COV_TYPE_CORE_STEALTH:
+		return make([]byte, n), 0x00, 1
+	case consensus.COV_TYPE_MULTISIG:
+		count := (n - 2) / 32
+		return keyed([]byte{1, byte(count)}, count), 0x00, 1
+	case consensus.COV_TYPE_VAULT:
+		// Keys and whitelist entries share key(i), both strictly sorted;
+		// the all-0xff owner never collides with a whitelist entry.
+		keyCount := consensus.MAX_VAULT_KEYS
+		if n < 32+1+1+32*keyCount {
+			keyCount = 1
+		}
+		whitelistCount := (n - (32 + 1 + 1 + 32*keyCount + 2)) / 32
+		data := keyed(append(bytes.Repeat([]byte{0xff}, 32), 1, byte(keyCount)), keyCount)
+		data = binary.LittleEndian.AppendUint16(data, uint16(whitelistCount))
+		return keyed(data, whitelistCount), 0x00, 1
+	}
+	t.Fatalf("no sample for covenant_type 0x%04x", id)
+	return nil, 0, 0
+}
+
+func testRuntimeKeyOpCovenantDataCanonicalCheck(t *testing.T) {
+	p2pk := append([]byte{consensus.SUITE_ID_ML_DSA_87}, bytes.Repeat([]byte{0x11}, 32)...)
+	r := mustRunOk(t, Request{Op: "covenant_data_canonical_check", CovenantType: consensus.COV_TYPE_P2PK, CovenantDataHex: mustHexBytes(p2pk)})
+	if len(r.CovenantTypes) != 1 || r.CovenantTypes[0].DataLenRule != "exact" {
+		t.Fatalf("unexpected resp: %+v", r)
+	}
+	padded := append(append([]byte(nil), p2pk...), 0x00)
+	mustRunErr(t, Request{Op: "covenant_data_canonical_check", CovenantType: consensus.COV_TYPE_P2PK, CovenantDataHex: mustHexBytes(padded)}, "TX_ERR_COVENANT_TYPE_INVALID")
+
+	// A trailing byte on an anchor is payload, not padding: both encodings
+	// pass and hash to distinct output descriptors.
+	anchor := []byte{0xa1, 0xa2}
+	r1 := mustRunOk(t, Request{Op: "covenant_data_canonical_check", CovenantType: consensus.COV_TYPE_ANCHOR, CovenantDataHex: mustHexBytes(anchor)})
+	r2 := mustRunOk(t, Request{Op: "covenant_data_canonical_check", CovenantType: consensus.COV_TYPE_ANCHOR, CovenantDataHex: mustHexBytes(append(anchor, 0x00))})
+	if r1.CovenantTypes[0].DataLenRule != "range" || r1.DigestHex == "" || r1.DigestHex == r2.DigestHex {
+		t.Fatalf("anchor descriptors r1=%+v r2=%+v", r1, r2)
+	}
+	mustRunErr(t, Request{Op: "covenant_data_canonical_check", CovenantType: consensus.COV_TYPE_ANCHOR}, "TX_ERR_COVENANT_TYPE_INVALID")
+	// Inside the registry bounds but not 2 + 32*key_count: the consensus
+	// length table rejects it.
+	mustRunErr(t, Request{Op: "covenant_data_canonical_check", CovenantType: consensus.COV_TYPE_MULTISIG, CovenantDataHex: mustHexBytes(make([]byte, 2+32+1))}, "TX_ERR_COVENANT_TYPE_INVALID")
+	mustRunErr(t, Request{Op: "covenant_data_canonical_check", CovenantType: consensus.COV_TYPE_CORE_EXT, CovenantDataHex: "00"}, "TX_ERR_COVENANT_TYPE_INVALID")
+	mustRunErr(t, Request{Op: "covenant_data_canonical_check", CovenantType: 0x7777, CovenantDataHex: "00"}, "unknown covenant_type")
+	mustRunErr(t, Request{Op: "covenant_data_canonical_check", CovenantType: consensus.COV_TYPE_P2PK, CovenantDataHex: "zz"}, "bad covenant_data_hex")
+}
+
+// testRuntimeKeyOpCovenantTypes holds the covenant_types registry to what
+// consensus.ValidateTxCovenantsGenesis enforces: every bound it reports is
+// accepted, one byte outside it is rejected, and forbidden types reject
+// everything. CORE_SIMPLICITY creation is deployment-gated (inactive by
+// default), so only its rejection is checked here.
+func testRuntimeKeyOpCovenantTypes(t *testing.T) {
+	resp := mustRunOk(t, Request{Op: "covenant_types"})
+
+	wantIDs := []uint16{
+		consensus.COV_TYPE_P2PK, consensus.COV_TYPE_ANCHOR, consensus.COV_TYPE_RESERVED_FUTURE,
+		consensus.COV_TYPE_HTLC, consensus.COV_TYPE_VAULT, consensus.COV_TYPE_CORE_EXT,
+		consensus.COV_TYPE_DA_COMMIT, consensus.COV_TYPE_MULTISIG, consensus.COV_TYPE_CORE_STEALTH,
+		consensus.COV_TYPE_CORE_SIMPLICITY,
+	}
+	gotIDs := make([]uint16, 0, len(resp.CovenantTypes))
+	for _, ct := range resp.CovenantTypes {
+		gotIDs = append(gotIDs, ct.ID)
+	}
+	if !slices.Equal(gotIDs, wantIDs) {
+		t.Fatalf("ids=%v, want %v", gotIDs, wantIDs)
+	}
+
+	create := func(id uint16, data []byte, txKind uint8, value uint64) error {
+		tx := &consensus.Tx{TxKind: txKind, Outputs: []consensus.TxOutput{{Value: value, CovenantType: id, CovenantData: data}}}
+		return consensus.ValidateTxCovenantsGenesis(tx, [32]byte{}, 0, nil)
+	}
+	for _, ct := range resp.CovenantTypes {
+		switch ct.DataLenRule {
+		case "forbidden":
+			for _, n := range []int{0, 32} {
+				if err := create(ct.ID, make([]byte, n), 0x00, 1); err == nil {
+					t.Fatalf("%s: len %d accepted, want forbidden", ct.Name, n)
+				}
+			}
+			continue
+		case "exact":
+			if ct.MinLen != ct.MaxLen {
+				t.Fatalf("%s: exact rule with min=%d max=%d", ct.Name, ct.MinLen, ct.MaxLen)
+			}
+		case "range", "variable":
+			if ct.MinLen <= 0 || ct.MinLen >= ct.MaxLen {
+				t.Fatalf("%s: %s rule with min=%d max=%d", ct.Name, ct.DataLenRule, ct.MinLen, ct.MaxLen)
+			}
+		default:
+			t.Fatalf("%s: unknown data_len_rule %q", ct.Name, ct.DataLenRule)
+		}
+		for n, want := range map[int]bool{ct.MinLen - 1: false, ct.MinLen: true, ct.MaxLen: true, ct.MaxLen + 1: false} {
+			if got := consensus.CovenantDataLengthValid(ct.ID, n); got != want {
+				t.Fatalf("%s: CovenantDataLengthValid(%d)=%v, want %v", ct.Name, n, got, want)
+			}
+		}
+		if ct.ID == consensus.COV_TYPE_CORE_SIMPLICITY {
+			if err := create(ct.ID, make([]byte, ct.MinLen), 0x00, 1); err == nil {
+				t.Fatalf("%s: created without an active deployment", ct.Name)
+			}
+			continue
+		}
+		for _, n := range []int{ct.MinLen, ct.MaxLen} {
+			data, txKind, value := covenantTypeSample(t, ct.ID, n)
+			if len(data) != n {
+				t.Fatalf("%s: sample len=%d, want %d", ct.Name, len(data), n)
+			}
+			if err := create(ct.ID, data, txKind, value); err != nil {
+				t.Fatalf("%s: len %d rejected: %v", ct.Name, n, err)
+			}
+			if n == ct.MinLen {
+				if err := create(ct.ID, data[:n-1], txKind, value); err == nil {
+					t.Fatalf("%s: len %d accepted below min_len", ct.Name, n-1)
+				}
+			}
+			if n == ct.MaxLen {
+				if err := create(ct.ID, append(data, 0), txKind, value); err == nil {
+					t.Fatalf("%s: len %d accepted above max_len", ct.Name, n+1)
+				}
+			}
+		}
+	}
+}
+
+// testRuntimeKeyOpErrorCodes pins the error_codes registry against every
+// ErrorCode constant declared in consensus/errors.go.
+func testRuntimeKeyOpErrorCodes(t *testing.T) {
+	resp := mustRunOk(t, Request{Op: "error_codes"})
+	if len(resp.ErrorCodes) != 2 {
+		t.Fatalf("categories=%v, want tx and block", resp.ErrorCodes)
+	}
+	for _, code := range []consensus.ErrorCode{consensus.TX_ERR_VALUE_CONSERVATION, consensus.TX_ERR_COVENANT_TYPE_INVALID} {
+		if !slices.Contains(resp.ErrorCodes["tx"], code) {
+			t.Fatalf("tx codes missing %s", code)
+		}
+	}
+	if !slices.Contains(resp.ErrorCodes["block"], consensus.BLOCK_ERR_POW_INVALID) {
+		t.Fatalf("block codes missing %s", consensus.BLOCK_ERR_POW_INVALID)
+	}
+
+	file, err := parser.ParseFile(token.NewFileSet(), filepath.Join("..", "..", "consensus", "errors.go"), nil, parser.SkipObjectResolution)
+	if err != nil {
+		t.Fatalf("parse errors.go: %v", err)
+	}
+	want := errorCodeTable{}
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			vs := spec.(*ast.ValueSpec)
+			if id, ok := vs.Type.(*ast.Ident); !ok || id.Name != "ErrorCode" {
+				continue
+			}
+			for _, name := range vs.Names {
+				category := "tx"
+				if strings.HasPrefix(name.Name, "BLOCK_ERR_") {
+					category = "block"
+				}
+				want[category] = append(want[category], consensus.ErrorCode(name.Name))
+			}
+		}
+	}
+	for category, codes := range want {
+		if !slices.Equal(resp.ErrorCodes[category], codes) {
+			t.Fatalf("%s codes drifted from consensus/errors.go:\ngot  %v\nwant %v", category, resp.ErrorCodes[category], codes)
+		}
+	}
+}
+
+func testRuntimeKeyOpUtxoApplyDelta(t *testing.T) {
+	kp, err := consensus.NewMLDSA87Keypair()
+	if err != nil {
+		if strings.Contains(strings.ToLower(err.Error()), "unsupported") {
+			t.Skipf("ML-DSA backend unavailable: %v", err)
+		}
+		t.Fatalf("NewMLDSA87Keypair: %v", err)
+	}
+	t.Cleanup(func() { kp.Close() })
+
+	p2pk := consensus.P2PKCovenantDataForPubkey(kp.PubkeyBytes())
+	prev := consensus.Outpoint{Txid: [32]byte{0xd1}, Vout: 2}
+	prevEntry := consensus.UtxoEntry{Value: 10_000, CovenantType: consensus.COV_TYPE_P2PK, CovenantData: p2pk, CreationHeight: 1}
+	tx := &consensus.Tx{
+		Version: 1,
+		TxNonce: 1,
+		Inputs:  []consensus.TxInput{{PrevTxid: prev.Txid, PrevVout: prev.Vout}},
+		Outputs: []consensus.TxOutput{
+			{Value: 4_000, CovenantType: consensus.COV_TYPE_P2PK, CovenantData: p2pk},
+			{Value: 0, CovenantType: consensus.COV_TYPE_ANCHOR, CovenantData: []byte{0xa5}},
+			{Value: 5_000, CovenantType: consensus.COV_TYPE_P2PK, CovenantData: p2pk},
+		},
+	}
+	if err := consensus.SignTransaction(tx, map[consensus.Outpoint]consensus.UtxoEntry{prev: prevEntry}, [32]byte{}, kp); err != nil {
+		t.Fatalf("SignTransaction: %v", err)
+	}
+	txBytes, err := consensus.MarshalTx(tx)
+	if err != nil {
+		t.Fatalf("MarshalTx: %v", err)
+	}
+	_, txid, _, _, err := consensus.ParseTx(txBytes)
+	if err != nil {
+		t.Fatalf("ParseTx: %v", err)
+	}
+	txidHex := hex.EncodeToString(txid[:])
+	req := Request{
+		Op:     "utxo_apply_basic",
+		TxHex:  hex.EncodeToString(txBytes),
+		Height: 10,
+		Utxos: []UtxoJSON{{
+			Txid:            hex.EncodeToString(prev.Txid[:]),
+			Vout:            prev.Vout,
+			Value:           prevEntry.Value,
+			CovenantType:    prevEntry.CovenantType,
+			CovenantDataHex: hex.EncodeToString(p2pk),
+			CreationHeight:  prevEntry.CreationHeight,
+		}},
+	}
+
+	if r := mustRunOk(t, req); r.UtxoDelta != nil {
+		t.Fatalf("utxo_delta without return_delta: %+v", r.UtxoDelta)
+	}
+
+	returnDelta := true
+	req.ReturnDelta = &returnDelta
+	r := mustRunOk(t, req)
+	if r.UtxoDelta == nil {
+		t.Fatalf("missing utxo_delta: %+v", r)
+	}
+	if !slices.Equal(r.UtxoDelta.Spent, req.Utxos) {
+		t.Fatalf("spent=%+v, want %+v", r.UtxoDelta.Spent, req.Utxos)
+	}
+	var want []UtxoJSON
+	for vout, out := range tx.Outputs {
+		if out.CovenantType == consensus.COV_TYPE_ANCHOR {
+			continue
+		}
+		want = append(want, UtxoJSON{
+			Txid:            txidHex,
+			Vout:            uint32(vout),
+			Value:           out.Value,
+			CovenantType:    out.CovenantType,
+			CovenantDataHex: hex.EncodeToString(out.CovenantData),
+			CreationHeight:  req.Height,
+		})
+	}
+	if !slices.Equal(r.UtxoDelta.Created, want) {
+		t.Fatalf("created=%+v, want %+v", r.UtxoDelta.Created, want)
+	}
+	if r.UtxoCount != uint64(len(want)) {
+		t.Fatalf("utxo_count=%d, want %d", r.UtxoCount, len(want))
+	}
+}
+
+func testRuntimeKeyOpApplyBlockFull(t *testing.T) {
+	kp, err := consensus.NewMLDSA87Keypair()
+	if err != nil {
+		if strings.Contains(strings.ToLower(err.Error()), "unsupported") {
+			t.Skipf("ML-DSA backend unavailable: %v", err)
+		}
+		t.Fatalf("NewMLDSA87Keypair: %v", err)
+	}
+	t.Cleanup(func() { kp.Close() })
+
+	_, genesisHeader := mineGenesisBlockBytes(t)
+	parent, err := consensus.ParseBlockHeaderBytes(genesisHeader)
+	if err != nil {
+		t.Fatalf("ParseBlockHeaderBytes: %v", err)
+	}
+	parentHash, err := consensus.BlockHash(genesisHeader)
+	if err != nil {
+		t.Fatalf("BlockHash: %v", err)
+	}
+
+	p2pk := consensus.P2PKCovenantDataForPubkey(kp.PubkeyBytes())
+	prev := consensus.Outpoint{Txid: [32]byte{0xe4}, Vout: 0}
+	prevEntry := consensus.UtxoEntry{Value: 10_000, CovenantType: consensus.COV_TYPE_P2PK, CovenantData: p2pk}
+	spend := &consensus.Tx{
+		Version: 1,
+		TxNonce: 1,
+		Inputs:  []consensus.TxInput{{PrevTxid: prev.Txid, PrevVout: prev.Vout}},
+		Outputs: []consensus.TxOutput{{Value: 9_000, CovenantType: consensus.COV_TYPE_P2PK, CovenantData: p2pk}},
+	}
+	if err := consensus.SignTransaction(spend, map[consensus.Outpoint]consensus.UtxoEntry{prev: prevEntry}, [32]byte{}, kp); err != nil {
+		t.Fatalf("SignTransaction: %v", err)
+	}
+	spendBytes, err := consensus.MarshalTx(spend)
+	if err != nil {
+		t.Fatalf("MarshalTx: %v", err)
+	}
+	_, spendTxid, spendWtxid, _, err := consensus.ParseTx(spendBytes)
+	if err != nil {
+		t.Fatalf("ParseTx(spend): %v", err)
+	}
+	wroot, err := consensus.WitnessMerkleRootWtxids([][32]byte{{}, spendWtxid})
+	if err != nil {
+		t.Fatalf("WitnessMerkleRootWtxids: %v", err)
+	}
+	coinbase := buildAnchorOnlyCoinbaseLikeTxBytes(t, 1, consensus.WitnessCommitmentHash(wroot))
+	_, coinbaseTxid, _, _, err := consensus.ParseTx(coinbase)
+	if err != nil {
+		t.Fatalf("ParseTx(coinbase): %v", err)
+	}
+	root, err := consensus.MerkleRootTxids([][32]byte{coinbaseTxid, spendTxid})
+	if err != nil {
+		t.Fatalf("MerkleRootTxids: %v", err)
+	}
+
+	header := consensus.AppendU32le(nil, 1)
+	header = append(header, parentHash[:]...)
+	header = append(header, root[:]...)
+	header = consensus.AppendU64le(header, parent.Timestamp+consensus.TARGET_BLOCK_INTERVAL)
+	header = append(header, parent.Target[:]...)
+	header = consensus.AppendU64le(header, 0)
+	for nonce := uint64(0); consensus.PowCheck(header, parent.Target) != nil; nonce++ {
+		binary.LittleEndian.PutUint64(header[consensus.BLOCK_HEADER_BYTES-8:], nonce)
+	}
+	block := append(append(header, 0x02), coinbase...)
+	block = append(block, spendBytes...)
+
+	req := Request{
+		Op:          "apply_block_full",
+		BlockHex:    mustHexBytes(block),
+		Height:      1,
+		HeaderHexes: []string{mustHexBytes(genesisHeader)},
+		Utxos: []UtxoJSON{{
+			Txid:            mustHex32(prev.Txid),
+			Vout:            prev.Vout,
+			Value:           prevEntry.Value,
+			CovenantType:    prevEntry.CovenantType,
+			CovenantDataHex: mustHexBytes(p2pk),
+		}},
+	}
+	r := mustRunOk(t, req)
+	if r.SumFees != 1_000 || r.UtxoCount != 1 || r.AlreadyGenerated != 0 || r.AlreadyGeneratedN1 != consensus.BlockSubsidy(1, 0) {
+		t.Fatalf("unexpected summary: %+v", r)
+	}
+
+	req.HeaderHexes = []string{mustHexBytes(genesisHeader), mustHexBytes(genesisHeader)}
+	mustRunErr(t, req, "bad header_hexes: not linked")
+}
+
+func testRuntimeKeyOpHeaderChainWork(t *testing.T) {
+	t.Helper()
+	headerHex := func(target [32]byte) string {
+		h := make([]byte, 0, consensus.BLOCK_HEADER_BYTES)
+		h = binary.LittleEndian.AppendUint32(h, 1)
+		h = append(h, make([]byte, 64)...) // prev_block_hash, merkle_root
+		h = binary.LittleEndian.AppendUint64(h, 1)
+		h = append(h, target[:]...)
+		h = binary.LittleEndian.AppendUint64(h, 7)
+		return mustHexBytes(h)
+	}
+	targets := [][32]byte{{}, {}, consensus.POW_LIMIT}
+	targets[0][0] = 0x01
+	targets[1][31] = 0xfe
+	headers := make([]string, 0, len(targets))
+	want := new(big.Int)
+	for _, target := range targets {
+		headers = append(headers, headerHex(target))
+		w, ok := new(big.Int).SetString(mustRunOk(t, Request{Op: "fork_work", Target: mustHex32(target)}).WorkHex, 0)
+		if !ok {
+			t.Fatalf("bad fork_work for target %x", target)
+		}
+		want.Add(want, w)
+	}
+
+	r := mustRunOk(t, Request{Op: "header_chain_work", HeaderHexes: headers})
+	if len(r.HeaderWorks) != len(headers) {
+		t.Fatalf("header_works=%v, want %d entries", r.HeaderWorks, len(headers))
+	}
+	sum := new(big.Int)
+	for _, hw := range r.HeaderWorks {
+		w, ok := new(big.Int).SetString(hw, 0)
+		if !ok {
+			t.Fatalf("bad header work %q", hw)
+		}
+		sum.Add(sum, w)
+	}
+	if r.Chainwork != "0x"+want.Text(16) || sum.Cmp(want) != 0 {
+		t.Fatalf("chainwork=%s sum(header_works)=%#x, want %#x", r.Chainwork, sum, want)
+	}
+
+	mustRunErr(t, Request{Op: "header_chain_work", HeaderHexes: []string{headers[0], headerHex([32]byte{})}}, string(consensus.TX_ERR_PARSE))
+	mustRunErr(t, Request{Op: "header_chain_work", HeaderHexes: []string{"zz"}}, "bad header")
+	mustRunErr(t, Request{Op: "header_chain_work"}, "bad header_hexes")
 }
 
 func testRuntimeKeyOpForkWorkAndChoice(t *testing.T) {
@@ -427,6 +2059,38 @@ func testRuntimeKeyOpForkWorkAndChoice(t *testing.T) {
 	if sel.Winner != "b" || sel.Chainwork == "" {
 		t.Fatalf("unexpected resp: %+v", sel)
 	}
+	earlier, later := uint64(100), uint64(200)
+	seen := mustRunOk(t, Request{
+		Op: "fork_choice_select",
+		Chains: []ForkChoiceChain{
+			{ID: "b", Targets: []string{"0x02"}, TipHash: "0x01", FirstSeen: &later},
+			{ID: "a", Targets: []string{"0x02"}, TipHash: "0x02", FirstSeen: &earlier},
+			{ID: "c", Targets: []string{"0x01"}, TipHash: "0x03", FirstSeen: &later},
+		},
+	})
+	if seen.Winner != "c" {
+		t.Fatalf("more work must beat first-seen: %+v", seen)
+	}
+	seen = mustRunOk(t, Request{
+		Op: "fork_choice_select",
+		Chains: []ForkChoiceChain{
+			{ID: "b", Targets: []string{"0x02"}, TipHash: "0x01", FirstSeen: &later},
+			{ID: "a", Targets: []string{"0x02"}, TipHash: "0x02", FirstSeen: &earlier},
+		},
+	})
+	if seen.Winner != "a" {
+		t.Fatalf("earlier first_seen must win on equal work: %+v", seen)
+	}
+	seen = mustRunOk(t, Request{
+		Op: "fork_choice_select",
+		Chains: []ForkChoiceChain{
+			{ID: "a", Targets: []string{"0x02"}, TipHash: "0x02", FirstSeen: &earlier},
+			{ID: "b", Targets: []string{"0x02"}, TipHash: "0x01", FirstSeen: &earlier},
+		},
+	})
+	if seen.Winner != "b" {
+		t.Fatalf("equal first_seen must fall back to the hash: %+v", seen)
+	}
 }
 
 func testRuntimeKeyOpMerkleRoots(t *testing.T) {
@@ -442,6 +2106,28 @@ func testRuntimeKeyOpMerkleRoots(t *testing.T) {
 	if r2.WitnessMerkleHex == "" {
 		t.Fatalf("unexpected resp: %+v", r2)
 	}
+
+	// Adding a tx to the template changes the commitment; each recompute
+	// must match the coinbase fixture flow over the same wtxid set.
+	c := b
+	c[0] = 0xc3
+	for _, wtxids := range [][][32]byte{{a, b}, {a, b, c}} {
+		hexes := make([]string, 0, len(wtxids))
+		for _, w := range wtxids {
+			hexes = append(hexes, mustHex32(w))
+		}
+		r := mustRunOk(t, Request{Op: "recompute_witness_commitment", Wtxids: hexes})
+		root, err := consensus.WitnessMerkleRootWtxids(wtxids)
+		if err != nil {
+			t.Fatalf("WitnessMerkleRootWtxids: %v", err)
+		}
+		want := consensus.WitnessCommitmentHash(root)
+		if r.CommitmentHex != mustHex32(want) || r.WitnessMerkleHex != mustHex32(root) {
+			t.Fatalf("wtxids=%d: unexpected resp: %+v", len(wtxids), r)
+		}
+	}
+	mustRunErr(t, Request{Op: "recompute_witness_commitment"}, "TX_ERR_PARSE")
+	mustRunErr(t, Request{Op: "recompute_witness_commitment", Wtxids: []string{"00"}}, "bad wtxid")
 }
 
 func testRuntimeKeyOpSighashAndWeight(t *testing.T, fixture runtimeKeyOpsFixture) {
@@ -571,6 +2257,21 @@ func testRuntimeKeyOpRetargetV1BothForms(t *testing.T, fixture runtimeKeyOpsFixt
 	if r2.Ok || r2.Err != string(consensus.TX_ERR_PARSE) {
 		t.Fatalf("expected TX_ERR_PARSE: %+v", r2)
 	}
+
+	prevLast := uint64(10_000_000)
+	backstep := uint64(consensus.MAX_FUTURE_DRIFT)
+	window := make([]uint64, consensus.WINDOW_SIZE)
+	window[0] = prevLast - 5_000_000
+	for i := 1; i < len(window); i++ {
+		window[i] = window[i-1] + consensus.MAX_TIMESTAMP_STEP_PER_BLOCK
+	}
+	warped := Request{Op: "retarget_v1", TargetOldHex: fixture.targetHex, WindowTimestamps: window}
+	_ = mustRunOk(t, warped)
+	warped.PrevWindowLastTs = &prevLast
+	warped.TimeWarpMaxBackstep = &backstep
+	mustRunErr(t, warped, string(consensus.BLOCK_ERR_TIMESTAMP_OLD))
+	warped.PrevWindowLastTs = nil
+	mustRunErr(t, warped, "time_warp_max_backstep requires window_timestamps and prev_window_last_timestamp")
 }
 
 func testRuntimeKeyOpBlockValidationAndConnect(t *testing.T, fixture runtimeKeyOpsFixture) {
@@ -590,6 +2291,68 @@ func testRuntimeKeyOpBlockValidationAndConnect(t *testing.T, fixture runtimeKeyO
 	}
 }
 
+func testRuntimeKeyOpTemplateCheck(t *testing.T, fixture runtimeKeyOpsFixture) {
+	t.Helper()
+	// Swap in the smallest in-range target: the block stays structurally
+	// valid, but its nonce no longer solves the header.
+	const targetOffset = 4 + 32 + 32 + 8
+	block := append([]byte(nil), fixture.blockBytes...)
+	var target [32]byte
+	target[31] = 0x01
+	copy(block[targetOffset:targetOffset+32], target[:])
+
+	r := mustRunOk(t, Request{Op: "template_check", BlockHex: mustHexBytes(block), Height: 0})
+	if len(r.BlockHash) != 64 {
+		t.Fatalf("unexpected resp: %+v", r)
+	}
+	mustRunErr(t, Request{
+		Op:        "pow_check",
+		HeaderHex: mustHexBytes(block[:consensus.BLOCK_HEADER_BYTES]),
+		TargetHex: mustHex32(target),
+	}, string(consensus.BLOCK_ERR_POW_INVALID))
+	mustRunErr(t, Request{Op: "block_basic_check", BlockHex: mustHexBytes(block), Height: 0}, string(consensus.BLOCK_ERR_POW_INVALID))
+
+	clear(block[targetOffset : targetOffset+32])
+	mustRunErr(t, Request{Op: "template_check", BlockHex: mustHexBytes(block), Height: 0}, string(consensus.BLOCK_ERR_TARGET_INVALID))
+	mustRunErr(t, Request{Op: "template_check", BlockHex: "zz"}, "bad block")
+}
+
+func testRuntimeKeyOpTemplateID(t *testing.T, fixture runtimeKeyOpsFixture) {
+	t.Helper()
+	const nonceOffset = consensus.BLOCK_HEADER_BYTES - 8
+	templateID := func(block []byte) string {
+		t.Helper()
+		r := mustRunOk(t, Request{Op: "template_id", BlockHex: mustHexBytes(block)})
+		if len(r.TemplateID) != 64 {
+			t.Fatalf("unexpected resp: %+v", r)
+		}
+		return r.TemplateID
+	}
+	base := templateID(fixture.blockBytes)
+
+	renonced := append([]byte(nil), fixture.blockBytes...)
+	binary.LittleEndian.PutUint64(renonced[nonceOffset:], binary.LittleEndian.Uint64(renonced[nonceOffset:])+1)
+	if got := templateID(renonced); got != base {
+		t.Fatalf("nonce-only change: template_id=%s, want %s", got, base)
+	}
+
+	if fixture.blockBytes[consensus.BLOCK_HEADER_BYTES] != 0x01 {
+		t.Fatalf("fixture block tx_count byte=%#x, want 0x01", fixture.blockBytes[consensus.BLOCK_HEADER_BYTES])
+	}
+	extraTx, err := consensus.MarshalTx(&consensus.Tx{Version: 1, TxNonce: 1})
+	if err != nil {
+		t.Fatalf("MarshalTx: %v", err)
+	}
+	grown := append(append([]byte(nil), fixture.blockBytes...), extraTx...)
+	grown[consensus.BLOCK_HEADER_BYTES] = 0x02
+	if got := templateID(grown); got == base {
+		t.Fatalf("different tx set shares template_id %s", got)
+	}
+
+	mustRunErr(t, Request{Op: "template_id", BlockHex: "zz"}, "bad block")
+	mustRunErr(t, Request{Op: "template_id", BlockHex: "00"}, string(consensus.BLOCK_ERR_PARSE))
+}
+
 func testRuntimeKeyOpCompactAndPolicyOps(t *testing.T) {
 	t.Helper()
 	var wtxid [32]byte
@@ -947,6 +2710,7 @@ func TestRubinConsensusCLI_RuntimeHelpers(t *testing.T) {
 	t.Run("parseExactHex32_and_optionals", testRuntimeHelperParseExactHex32AndOptionals)
 	t.Run("parseBlockValidationInputs", testRuntimeHelperParseBlockValidationInputs)
 	t.Run("buildUtxoMap_errors", testRuntimeHelperBuildUtxoMapErrors)
+	t.Run("utxoApplyDelta", testRuntimeHelperUtxoApplyDelta)
 	t.Run("parseKeyBytes", testRuntimeHelperParseKeyBytes)
 	t.Run("default_and_cast_helpers", testRuntimeHelperDefaultAndCastHelpers)
 	t.Run("writeConsensusErr_non_txerror", testRuntimeHelperWriteConsensusErrNonTxError)
@@ -1047,6 +2811,32 @@ func testRuntimeHelperBuildUtxoMapErrors(t *testing.T) {
 	}
 }
 
+func testRuntimeHelperUtxoApplyDelta(t *testing.T) {
+	t.Helper()
+	prev := consensus.Outpoint{Txid: [32]byte{0xd2}, Vout: 1}
+	kept := consensus.Outpoint{Txid: [32]byte{0xd3}}
+	txid := [32]byte{0xd4}
+	tx := &consensus.Tx{
+		Inputs:  []consensus.TxInput{{PrevTxid: prev.Txid, PrevVout: prev.Vout}},
+		Outputs: []consensus.TxOutput{{Value: 0, CovenantType: consensus.COV_TYPE_ANCHOR}, {Value: 7}},
+	}
+	before := map[consensus.Outpoint]consensus.UtxoEntry{
+		prev: {Value: 9, CovenantData: []byte{0x01}, CreationHeight: 3, CreatedByCoinbase: true},
+		kept: {Value: 1},
+	}
+	after := map[consensus.Outpoint]consensus.UtxoEntry{
+		kept:                  {Value: 1},
+		{Txid: txid, Vout: 1}: {Value: 7, CreationHeight: 5},
+	}
+
+	d := utxoApplyDelta(tx, txid, before, after)
+	wantSpent := []UtxoJSON{{Txid: hex.EncodeToString(prev.Txid[:]), Vout: 1, Value: 9, CovenantDataHex: "01", CreationHeight: 3, CreatedByCoinbase: true}}
+	wantCreated := []UtxoJSON{{Txid: hex.EncodeToString(txid[:]), Vout: 1, Value: 7, CovenantDataHex: "", CreationHeight: 5}}
+	if !slices.Equal(d.Spent, wantSpent) || !slices.Equal(d.Created, wantCreated) {
+		t.Fatalf("delta=%+v, want spent=%+v created=%+v", d, wantSpent, wantCreated)
+	}
+}
+
 func testRuntimeHelperParseKeyBytes(t *testing.T) {
 	t.Helper()
 	if b, err := parseKeyBytes("0x1"); err != nil || len(b) != 1 || b[0] != 0x01 {
@@ -0,0 +1,303 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/2tbmz9y2xt-lang/rubin-protocol/clients/go/consensus"
+	"github.com/2tbmz9y2xt-lang/rubin-protocol/clients/go/node"
+)
+
+const benchValidateCommand = "bench-validate"
+
+// benchValidateTotals accumulates the work done by the timed replay.
+type benchValidateTotals struct {
+	blocks   uint64
+	sigs     uint64
+	utxoOps  uint64
+	duration time.Duration
+}
+
+// runBenchValidate replays the last --count canonical blocks of a datadir
+// through ApplyBlock on a scratch chain and reports validation throughput.
+// The scratch chain is a copy of the datadir's chainstate and blockstore
+// rewound by --count blocks with their undo data, so nothing below the
+// measured window is revalidated; the datadir itself is only read.
+func runBenchValidate(args []string, output outputFormat, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("rubin-node "+benchValidateCommand, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	flags := registerMaintenanceFlags(fs)
+	count := fs.Uint64("count", 100, "number of most recent canonical blocks to time")
+	traceOut := fs.String("trace-out", "", "write a JSONL trace line per replayed block to this path")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if *count == 0 {
+		_, _ = fmt.Fprintf(stderr, "%s: --count must be > 0\n", benchValidateCommand)
+		return 2
+	}
+	chainState, _, blockStore, syncCfg, code := openMaintenanceSyncEngineConfig(benchValidateCommand, flags, stderr)
+	if code != 0 {
+		return code
+	}
+	tipHeight, _, ok, err := blockStore.Tip()
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "%s: blockstore tip read failed: %v\n", benchValidateCommand, err)
+		return 1
+	}
+	if !ok || tipHeight == 0 {
+		_, _ = fmt.Fprintf(stderr, "%s: datadir has no blocks above genesis\n", benchValidateCommand)
+		return 1
+	}
+	if *count > tipHeight {
+		_, _ = fmt.Fprintf(stderr, "%s: --count %d exceeds tip height %d\n", benchValidateCommand, *count, tipHeight)
+		return 2
+	}
+
+	scratchDir, err := os.MkdirTemp("", "rubin-bench-validate-")
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "%s: scratch dir failed: %v\n", benchValidateCommand, err)
+		return 1
+	}
+	defer os.RemoveAll(scratchDir)
+	var trace io.Writer
+	if path := strings.TrimSpace(*traceOut); path != "" {
+		traceFile, err := os.Create(path)
+		if err != nil {
+			_, _ = fmt.Fprintf(stderr, "%s: invalid --trace-out: %v\n", benchValidateCommand, err)
+			return 2
+		}
+		defer traceFile.Close()
+		trace = traceFile
+	}
+	sourceBlockStore := node.BlockStorePath(node.NormalizeDataDir(*flags.dataDir))
+	totals, err := benchValidateReplay(blockStore, sourceBlockStore, chainState, syncCfg, scratchDir, tipHeight, *count, trace)
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "%s: %v\n", benchValidateCommand, err)
+		return 1
+	}
+	seconds := totals.duration.Seconds()
+	if seconds <= 0 {
+		seconds = time.Nanosecond.Seconds()
+	}
+	result := benchValidateResult{
+		Blocks:        totals.blocks,
+		Sigs:          totals.sigs,
+		UtxoOps:       totals.utxoOps,
+		ElapsedMs:     float64(totals.duration.Microseconds()) / 1000,
+		BlocksPerSec:  float64(totals.blocks) / seconds,
+		SigsPerSec:    float64(totals.sigs) / seconds,
+		UtxoOpsPerSec: float64(totals.utxoOps) / seconds,
+	}
+	writeResult(stdout, output,
+		fmt.Sprintf(
+			"%s ok: blocks=%d sigs=%d utxo_ops=%d elapsed_ms=%.3f blocks_per_sec=%.2f sigs_per_sec=%.2f utxo_ops_per_sec=%.2f\n",
+			benchValidateCommand,
+			result.Blocks,
+			result.Sigs,
+			result.UtxoOps,
+			result.ElapsedMs,
+			result.BlocksPerSec,
+			result.SigsPerSec,
+			result.UtxoOpsPerSec,
+		),
+		result)
+	return 0
+}
+
+// benchValidateResult is the --output json form of the throughput report.
+type benchValidateResult struct {
+	Blocks        uint64  `json:"blocks"`
+	Sigs          uint64  `json:"sigs"`
+	UtxoOps       uint64  `json:"utxo_ops"`
+	ElapsedMs     float64 `json:"elapsed_ms"`
+	BlocksPerSec  float64 `json:"blocks_per_sec"`
+	SigsPerSec    float64 `json:"sigs_per_sec"`
+	UtxoOpsPerSec float64 `json:"utxo_ops_per_sec"`
+}
+
+// benchValidateTraceEntry is one --trace-out JSONL line per replayed block,
+// letting an operator pinpoint the block at which a replay diverges.
+type benchValidateTraceEntry struct {
+	Hash      string `json:"hash"`
+	Err       string `json:"err,omitempty"`
+	Height    uint64 `json:"height"`
+	TxCount   uint64 `json:"tx_count"`
+	Fees      uint64 `json:"fees"`
+	Weight    uint64 `json:"weight"`
+	UtxoCount uint64 `json:"utxo_count"`
+	Ok        bool   `json:"ok"`
+}
+
+// benchValidateBlockStats is the per-block work connecting a block performs.
+type benchValidateBlockStats struct {
+	txCount uint64
+	weight  uint64
+	sigs    uint64
+	utxoOps uint64
+}
+
+// benchValidateReplay copies the source chain into scratchDir, rewinds the
+// copy to tipHeight-count and times re-applying the last count canonical
+// blocks. When trace is non-nil every replayed block, including a failing
+// one, is written to it.
+func benchValidateReplay(
+	source *node.BlockStore,
+	sourceBlockStorePath string,
+	sourceState *node.ChainState,
+	syncCfg node.SyncConfig,
+	scratchDir string,
+	tipHeight uint64,
+	count uint64,
+	trace io.Writer,
+) (benchValidateTotals, error) {
+	var totals benchValidateTotals
+	scratchStatePath := node.ChainStatePath(scratchDir)
+	if err := sourceState.Save(scratchStatePath); err != nil {
+		return totals, fmt.Errorf("scratch chainstate save failed: %w", err)
+	}
+	scratchState, err := node.LoadChainState(scratchStatePath)
+	if err != nil {
+		return totals, fmt.Errorf("scratch chainstate load failed: %w", err)
+	}
+	scratchState.Rotation = sourceState.Rotation
+	scratchState.Registry = sourceState.Registry
+	scratchStorePath := node.BlockStorePath(scratchDir)
+	if err := copyBlockStoreFiles(sourceBlockStorePath, scratchStorePath); err != nil {
+		return totals, fmt.Errorf("scratch blockstore copy failed: %w", err)
+	}
+	scratchStore, err := node.OpenBlockStore(scratchStorePath)
+	if err != nil {
+		return totals, fmt.Errorf("scratch blockstore open failed: %w", err)
+	}
+	scratchCfg := syncCfg
+	scratchCfg.ChainStatePath = scratchStatePath
+	engine, err := node.NewSyncEngine(scratchState, scratchStore, scratchCfg)
+	if err != nil {
+		return totals, fmt.Errorf("scratch sync engine init failed: %w", err)
+	}
+	firstTimed := tipHeight - count + 1
+	if err := engine.RewindToHeight(firstTimed - 1); err != nil {
+		return totals, fmt.Errorf("scratch rewind failed: %w", err)
+	}
+	var traceEnc *json.Encoder
+	if trace != nil {
+		traceEnc = json.NewEncoder(trace)
+		traceEnc.SetEscapeHTML(false)
+	}
+	for height := firstTimed; height <= tipHeight; height++ {
+		blockHash, ok, err := source.CanonicalHash(height)
+		if err != nil {
+			return totals, fmt.Errorf("canonical hash %d: %w", height, err)
+		}
+		if !ok {
+			return totals, fmt.Errorf("canonical hash %d: missing", height)
+		}
+		blockBytes, err := source.GetBlockByHash(blockHash)
+		if err != nil {
+			return totals, fmt.Errorf("block %d: %w", height, err)
+		}
+		stats, err := benchValidateBlockWork(blockBytes)
+		if err != nil {
+			return totals, fmt.Errorf("block %d: %w", height, err)
+		}
+		started := time.Now()
+		summary, applyErr := engine.ApplyBlock(blockBytes, nil)
+		elapsed := time.Since(started)
+		if traceEnc != nil {
+			entry := benchValidateTraceEntry{
+				Hash:    hex.EncodeToString(blockHash[:]),
+				Height:  height,
+				TxCount: stats.txCount,
+				Weight:  stats.weight,
+				Ok:      applyErr == nil,
+			}
+			if applyErr != nil {
+				entry.Err = applyErr.Error()
+			} else {
+				entry.Fees = summary.SumFees
+				entry.UtxoCount = summary.UtxoCount
+			}
+			if err := traceEnc.Encode(entry); err != nil {
+				return totals, fmt.Errorf("write trace: %w", err)
+			}
+		}
+		if applyErr != nil {
+			return totals, fmt.Errorf("replay block %d: %w", height, applyErr)
+		}
+		totals.duration += elapsed
+		totals.blocks++
+		totals.sigs += stats.sigs
+		totals.utxoOps += stats.utxoOps
+	}
+	return totals, nil
+}
+
+// copyBlockStoreFiles copies a blockstore directory tree. Block, header and
+// undo blobs are write-once, so they are hard-linked where the filesystem
+// allows; the index is always copied because the scratch chain rewrites it.
+func copyBlockStoreFiles(src, dst string) error {
+	return filepath.WalkDir(src, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if entry.IsDir() {
+			return os.MkdirAll(target, 0o700)
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+		if filepath.Dir(rel) != "." && os.Link(path, target) == nil {
+			return nil
+		}
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(target, raw, 0o600)
+	})
+}
+
+// benchValidateBlockWork measures a block's tx count and weight, and counts
+// the signatures verified (non-sentinel witness items outside the coinbase)
+// and UTXO operations (inputs spent plus outputs created) that connecting it
+// performs.
+func benchValidateBlockWork(blockBytes []byte) (benchValidateBlockStats, error) {
+	var stats benchValidateBlockStats
+	pb, err := consensus.ParseBlockBytes(blockBytes)
+	if err != nil {
+		return stats, err
+	}
+	stats.txCount = uint64(len(pb.Txs))
+	for i, tx := range pb.Txs {
+		weight, _, _, err := consensus.TxWeightAndStats(tx)
+		if err != nil {
+			return stats, err
+		}
+		stats.weight += weight
+		stats.utxoOps += uint64(len(tx.Outputs))
+		if i == 0 {
+			continue
+		}
+		stats.utxoOps += uint64(len(tx.Inputs))
+		for _, item := range tx.Witness {
+			if item.SuiteID != consensus.SUITE_ID_SENTINEL {
+				stats.sigs++
+			}
+		}
+	}
+	return stats, nil
+}
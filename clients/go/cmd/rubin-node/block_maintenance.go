@@ -0,0 +1,187 @@
+package main
+
+import (
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/2tbmz9y2xt-lang/rubin-protocol/clients/go/node"
+)
+
+const (
+	invalidateBlockCommand = "invalidate-block"
+	reconsiderBlockCommand = "reconsider-block"
+	rewindCommand          = "rewind"
+)
+
+// maintenanceResult is the --output json form of invalidate-block,
+// reconsider-block and rewind; only rewind reports the UTXO set hash.
+type maintenanceResult struct {
+	TipHeight      uint64 `json:"tip_height"`
+	TipHashHex     string `json:"tip_hash_hex"`
+	UtxoSetHashHex string `json:"utxo_set_hash_hex,omitempty"`
+}
+
+// maintenanceFlags are the datadir/chain selectors shared by the offline
+// chain-maintenance subcommands, which operate on a stopped node's datadir.
+type maintenanceFlags struct {
+	dataDir     *string
+	network     *string
+	genesisFile *string
+}
+
+func registerMaintenanceFlags(fs *flag.FlagSet) maintenanceFlags {
+	defaults := node.DefaultConfig()
+	return maintenanceFlags{
+		dataDir:     fs.String("datadir", defaults.DataDir, "node data directory"),
+		network:     fs.String("network", defaults.Network, "network name (devnet/testnet/mainnet)"),
+		genesisFile: fs.String("genesis-file", "", "path to genesis pack JSON with chain_id_hex and genesis hash"),
+	}
+}
+
+// openMaintenanceSyncEngine loads an existing datadir the same way startup
+// does (reconcile included) without creating anything, returning an exit
+// code of 0 on success.
+func openMaintenanceSyncEngine(command string, flags maintenanceFlags, stderr io.Writer) (*node.ChainState, *node.SyncEngine, *node.BlockStore, int) {
+	chainState, syncEngine, blockStore, _, code := openMaintenanceSyncEngineConfig(command, flags, stderr)
+	return chainState, syncEngine, blockStore, code
+}
+
+// openMaintenanceSyncEngineConfig is openMaintenanceSyncEngine that also
+// returns the sync config, for commands that build a scratch engine for the
+// same chain.
+func openMaintenanceSyncEngineConfig(command string, flags maintenanceFlags, stderr io.Writer) (*node.ChainState, *node.SyncEngine, *node.BlockStore, node.SyncConfig, int) {
+	cfg := node.DefaultConfig()
+	cfg.Network = strings.TrimSpace(*flags.network)
+	if canonicalNetwork, ok := node.CanonicalNetworkName(cfg.Network); ok {
+		cfg.Network = canonicalNetwork
+	}
+	cfg.DataDir = node.NormalizeDataDir(*flags.dataDir)
+	if info, err := os.Stat(cfg.DataDir); err != nil || !info.IsDir() {
+		_, _ = fmt.Fprintf(stderr, "%s: datadir %q is not an existing directory\n", command, cfg.DataDir)
+		return nil, nil, nil, node.SyncConfig{}, 2
+	}
+	if cfg.Network != "devnet" && strings.TrimSpace(*flags.genesisFile) == "" {
+		_, _ = fmt.Fprintf(stderr, "%s: --network %s requires --genesis-file\n", command, cfg.Network)
+		return nil, nil, nil, node.SyncConfig{}, 2
+	}
+	genesisCfg, err := parseGenesisConfigFull(*flags.genesisFile)
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "%s: invalid genesis file: %v\n", command, err)
+		return nil, nil, nil, node.SyncConfig{}, 2
+	}
+	chainStatePath := node.ChainStatePath(cfg.DataDir)
+	chainState, err := node.LoadChainState(chainStatePath)
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "%s: chainstate load failed: %v\n", command, err)
+		return nil, nil, nil, node.SyncConfig{}, 2
+	}
+	rotation, registry, err := cfg.BuildRotationProvider()
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "%s: rotation config failed: %v\n", command, err)
+		return nil, nil, nil, node.SyncConfig{}, 2
+	}
+	chainState.Rotation = rotation
+	chainState.Registry = registry
+	blockStore, err := node.OpenBlockStore(node.BlockStorePath(cfg.DataDir))
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "%s: blockstore open failed: %v\n", command, err)
+		return nil, nil, nil, node.SyncConfig{}, 2
+	}
+	syncCfg := node.DefaultSyncConfig(nil, genesisCfg.ChainID, chainStatePath)
+	syncCfg.Network = cfg.Network
+	applySuiteContextToSyncConfig(&syncCfg, rotation, registry)
+	if _, err := node.ReconcileChainStateWithBlockStore(chainState, blockStore, syncCfg); err != nil {
+		_, _ = fmt.Fprintf(stderr, "%s: chainstate reconcile failed: %v\n", command, err)
+		return nil, nil, nil, node.SyncConfig{}, 1
+	}
+	if err := chainState.Save(chainStatePath); err != nil {
+		_, _ = fmt.Fprintf(stderr, "%s: chainstate save failed: %v\n", command, err)
+		return nil, nil, nil, node.SyncConfig{}, 1
+	}
+	syncEngine, err := node.NewSyncEngine(chainState, blockStore, syncCfg)
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "%s: sync engine init failed: %v\n", command, err)
+		return nil, nil, nil, node.SyncConfig{}, 1
+	}
+	return chainState, syncEngine, blockStore, syncCfg, 0
+}
+
+// runBlockValidityCommand implements invalidate-block and reconsider-block:
+// mark (or unmark) a block and its descendants invalid and let fork choice
+// settle on the best remaining valid chain.
+func runBlockValidityCommand(command string, args []string, output outputFormat, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("rubin-node "+command, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	flags := registerMaintenanceFlags(fs)
+	hashHex := fs.String("hash", "", "block hash (hex)")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	blockHash, err := parseHex32Value(*hashHex)
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "%s: invalid --hash: %v\n", command, err)
+		return 2
+	}
+	_, syncEngine, blockStore, code := openMaintenanceSyncEngine(command, flags, stderr)
+	if code != 0 {
+		return code
+	}
+	if command == invalidateBlockCommand {
+		err = syncEngine.InvalidateBlock(blockHash)
+	} else {
+		err = syncEngine.ReconsiderBlock(blockHash)
+	}
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "%s: %v\n", command, err)
+		return 1
+	}
+	tipHeight, tipHash, _, err := blockStore.Tip()
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "%s: blockstore tip read failed: %v\n", command, err)
+		return 1
+	}
+	writeResult(stdout, output,
+		fmt.Sprintf("%s ok: tip_height=%d tip_hash=%x\n", command, tipHeight, tipHash),
+		maintenanceResult{TipHeight: tipHeight, TipHashHex: hex.EncodeToString(tipHash[:])})
+	return 0
+}
+
+// runRewind disconnects canonical blocks above --to-height using their undo
+// records, leaving chainstate and the blockstore index at that height.
+func runRewind(args []string, output outputFormat, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("rubin-node "+rewindCommand, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	flags := registerMaintenanceFlags(fs)
+	toHeight := fs.String("to-height", "", "target canonical height")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	height, err := strconv.ParseUint(strings.TrimSpace(*toHeight), 10, 64)
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "%s: invalid --to-height: %q\n", rewindCommand, *toHeight)
+		return 2
+	}
+	chainState, syncEngine, blockStore, code := openMaintenanceSyncEngine(rewindCommand, flags, stderr)
+	if code != 0 {
+		return code
+	}
+	if err := syncEngine.RewindToHeight(height); err != nil {
+		_, _ = fmt.Fprintf(stderr, "%s: %v\n", rewindCommand, err)
+		return 1
+	}
+	tipHeight, tipHash, _, err := blockStore.Tip()
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "%s: blockstore tip read failed: %v\n", rewindCommand, err)
+		return 1
+	}
+	utxoSetHash := chainState.UtxoSetHash()
+	writeResult(stdout, output,
+		fmt.Sprintf("%s ok: tip_height=%d tip_hash=%x utxo_set_hash=%x\n", rewindCommand, tipHeight, tipHash, utxoSetHash),
+		maintenanceResult{TipHeight: tipHeight, TipHashHex: hex.EncodeToString(tipHash[:]), UtxoSetHashHex: hex.EncodeToString(utxoSetHash[:])})
+	return 0
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/2tbmz9y2xt-lang/rubin-protocol/clients/go/consensus"
+	"github.com/2tbmz9y2xt-lang/rubin-protocol/clients/go/node"
+)
+
+func TestRunDecodeBlockPrintsGenesis(t *testing.T) {
+	block := node.DevnetGenesisBlockBytes()
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{decodeBlockCommand, "--block-hex", hex.EncodeToString(block)}, &stdout, &stderr); code != 0 {
+		t.Fatalf("decode-block exit=%d stderr=%s", code, stderr.String())
+	}
+	var got decodedBlock
+	if err := json.Unmarshal(stdout.Bytes(), &got); err != nil {
+		t.Fatalf("decode output: %v\n%s", err, stdout.String())
+	}
+	wantHash := node.DevnetGenesisBlockHash()
+	if got.BlockHashHex != hex.EncodeToString(wantHash[:]) {
+		t.Fatalf("block_hash_hex=%s, want %x", got.BlockHashHex, wantHash)
+	}
+	pb, err := consensus.ParseBlockBytes(block)
+	if err != nil {
+		t.Fatalf("ParseBlockBytes: %v", err)
+	}
+	if got.TxCount != 1 || len(got.Txids) != 1 || got.Txids[0] != hex.EncodeToString(pb.Txids[0][:]) {
+		t.Fatalf("tx_count=%d txids=%v", got.TxCount, got.Txids)
+	}
+	if got.Header.MerkleRoot != hex.EncodeToString(pb.Header.MerkleRoot[:]) || got.Header.Timestamp != pb.Header.Timestamp {
+		t.Fatalf("header=%+v", got.Header)
+	}
+}
+
+func TestRunDecodeBlockParseFailureReportsBlockErrParse(t *testing.T) {
+	block := hex.EncodeToString(node.DevnetGenesisBlockBytes())
+	for name, in := range map[string]string{
+		"short":          block[:20],
+		"truncated_tx":   block[:len(block)-4],
+		"trailing_bytes": block + "00",
+	} {
+		var stdout, stderr bytes.Buffer
+		if code := run([]string{decodeBlockCommand, "--block-hex", in}, &stdout, &stderr); code != 1 {
+			t.Fatalf("%s: exit=%d, want 1", name, code)
+		}
+		if !strings.HasPrefix(stderr.String(), string(consensus.BLOCK_ERR_PARSE)) {
+			t.Fatalf("%s: stderr=%q", name, stderr.String())
+		}
+	}
+}
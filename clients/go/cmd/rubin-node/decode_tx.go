@@ -0,0 +1,166 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/2tbmz9y2xt-lang/rubin-protocol/clients/go/consensus"
+)
+
+const decodeTxCommand = "decode-tx"
+
+// decodedTx is the decode-tx view of a parsed transaction. Fields are
+// declared in wire order so the JSON is stable and diffable.
+type decodedTx struct {
+	TxidHex  string               `json:"txid_hex"`
+	WtxidHex string               `json:"wtxid_hex"`
+	Version  uint32               `json:"version"`
+	TxKind   uint8                `json:"tx_kind"`
+	TxNonce  uint64               `json:"tx_nonce"`
+	Inputs   []decodedTxInput     `json:"inputs"`
+	Outputs  []decodedTxOutput    `json:"outputs"`
+	Locktime uint32               `json:"locktime"`
+	Witness  []decodedWitnessItem `json:"witness"`
+}
+
+type decodedTxInput struct {
+	PrevTxid     string `json:"prev_txid"`
+	PrevVout     uint32 `json:"prev_vout"`
+	ScriptSigHex string `json:"script_sig"`
+	Sequence     uint32 `json:"sequence"`
+}
+
+type decodedTxOutput struct {
+	Value           uint64 `json:"value"`
+	CovenantType    uint16 `json:"covenant_type"`
+	CovenantDataHex string `json:"covenant_data"`
+}
+
+type decodedWitnessItem struct {
+	SuiteID      uint8  `json:"suite_id"`
+	PubkeyLen    int    `json:"pubkey_len"`
+	PubkeyHex    string `json:"pubkey"`
+	SignatureLen int    `json:"sig_len"`
+	SignatureHex string `json:"sig"`
+}
+
+// runDecodeTx prints a parsed transaction as JSON. A transaction that does
+// not parse, or leaves trailing bytes, is reported on stderr starting with
+// the canonical TX_ERR_PARSE token and exits 1.
+func runDecodeTx(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("rubin-node "+decodeTxCommand, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	txHex := fs.String("tx-hex", "", "transaction bytes as hex")
+	txHexFile := fs.String("tx-hex-file", "", "path to a file holding the transaction hex")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	raw, err := readHexInput("tx-hex", *txHex, *txHexFile)
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "%s: %v\n", decodeTxCommand, err)
+		return 2
+	}
+	tx, txid, wtxid, n, err := consensus.ParseTx(raw)
+	if err == nil && n != len(raw) {
+		// A decoded view of a prefix would hide the trailing bytes.
+		err = fmt.Errorf("trailing bytes after tx: parsed %d of %d", n, len(raw))
+	}
+	if err != nil {
+		_, _ = fmt.Fprintln(stderr, decodeErrorLine(consensus.TX_ERR_PARSE, err))
+		return 1
+	}
+	out := decodeTxJSON(tx)
+	out.TxidHex = hex.EncodeToString(txid[:])
+	out.WtxidHex = hex.EncodeToString(wtxid[:])
+	return writeDecodedJSON(decodeTxCommand, out, stdout, stderr)
+}
+
+func decodeTxJSON(tx *consensus.Tx) *decodedTx {
+	out := &decodedTx{
+		Version:  tx.Version,
+		TxKind:   tx.TxKind,
+		TxNonce:  tx.TxNonce,
+		Inputs:   make([]decodedTxInput, 0, len(tx.Inputs)),
+		Outputs:  make([]decodedTxOutput, 0, len(tx.Outputs)),
+		Locktime: tx.Locktime,
+		Witness:  make([]decodedWitnessItem, 0, len(tx.Witness)),
+	}
+	for _, in := range tx.Inputs {
+		out.Inputs = append(out.Inputs, decodedTxInput{
+			PrevTxid:     hex.EncodeToString(in.PrevTxid[:]),
+			PrevVout:     in.PrevVout,
+			ScriptSigHex: hex.EncodeToString(in.ScriptSig),
+			Sequence:     in.Sequence,
+		})
+	}
+	for _, o := range tx.Outputs {
+		out.Outputs = append(out.Outputs, decodedTxOutput{
+			Value:           o.Value,
+			CovenantType:    o.CovenantType,
+			CovenantDataHex: hex.EncodeToString(o.CovenantData),
+		})
+	}
+	for _, w := range tx.Witness {
+		out.Witness = append(out.Witness, decodedWitnessItem{
+			SuiteID:      w.SuiteID,
+			PubkeyLen:    len(w.Pubkey),
+			PubkeyHex:    hex.EncodeToString(w.Pubkey),
+			SignatureLen: len(w.Signature),
+			SignatureHex: hex.EncodeToString(w.Signature),
+		})
+	}
+	return out
+}
+
+// readHexInput returns the bytes given either inline (--<name>) or in a file
+// (--<name>-file); exactly one of the two must be set. Surrounding
+// whitespace in the file is ignored.
+func readHexInput(name, inline, path string) ([]byte, error) {
+	switch {
+	case inline != "" && path != "":
+		return nil, fmt.Errorf("--%s and --%s-file are mutually exclusive", name, name)
+	case inline == "" && path == "":
+		return nil, fmt.Errorf("one of --%s or --%s-file is required", name, name)
+	}
+	text := inline
+	if path != "" {
+		raw, err := os.ReadFile(filepath.Clean(path))
+		if err != nil {
+			return nil, err
+		}
+		text = string(raw)
+	}
+	b, err := hex.DecodeString(strings.TrimSpace(text))
+	if err != nil {
+		return nil, fmt.Errorf("invalid --%s: %v", name, err)
+	}
+	return b, nil
+}
+
+// decodeErrorLine renders a decode failure as its canonical token followed
+// by the underlying error. A consensus error already carrying that token is
+// printed as is.
+func decodeErrorLine(code consensus.ErrorCode, err error) string {
+	var txErr *consensus.TxError
+	if errors.As(err, &txErr) && txErr.Code == code {
+		return err.Error()
+	}
+	return fmt.Sprintf("%s: %v", code, err)
+}
+
+func writeDecodedJSON(command string, v any, stdout, stderr io.Writer) int {
+	enc := json.NewEncoder(stdout)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		_, _ = fmt.Fprintf(stderr, "%s: %v\n", command, err)
+		return 1
+	}
+	return 0
+}
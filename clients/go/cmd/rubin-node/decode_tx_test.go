@@ -0,0 +1,110 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/2tbmz9y2xt-lang/rubin-protocol/clients/go/consensus"
+)
+
+func mustDecodeTestTxHex(t *testing.T) (string, [32]byte) {
+	t.Helper()
+	raw, err := consensus.MarshalTx(&consensus.Tx{
+		Version: 1,
+		TxNonce: 7,
+		Inputs:  []consensus.TxInput{{PrevTxid: [32]byte{0xaa}, PrevVout: 3, Sequence: 9}},
+		Outputs: []consensus.TxOutput{{Value: 5, CovenantType: consensus.COV_TYPE_ANCHOR, CovenantData: []byte{0x01, 0x02}}},
+		Witness: []consensus.WitnessItem{{SuiteID: 0x02, Pubkey: []byte{0xbb}, Signature: []byte{0xcc, 0xdd}}},
+	})
+	if err != nil {
+		t.Fatalf("MarshalTx: %v", err)
+	}
+	_, txid, _, _, err := consensus.ParseTx(raw)
+	if err != nil {
+		t.Fatalf("ParseTx: %v", err)
+	}
+	return hex.EncodeToString(raw), txid
+}
+
+func TestRunDecodeTxPrintsStableJSON(t *testing.T) {
+	txHex, txid := mustDecodeTestTxHex(t)
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{decodeTxCommand, "--tx-hex", txHex}, &stdout, &stderr); code != 0 {
+		t.Fatalf("decode-tx exit=%d stderr=%s", code, stderr.String())
+	}
+	var got decodedTx
+	if err := json.Unmarshal(stdout.Bytes(), &got); err != nil {
+		t.Fatalf("decode output: %v\n%s", err, stdout.String())
+	}
+	if got.TxidHex != hex.EncodeToString(txid[:]) || got.TxNonce != 7 {
+		t.Fatalf("got txid=%s nonce=%d", got.TxidHex, got.TxNonce)
+	}
+	if len(got.Inputs) != 1 || got.Inputs[0].PrevVout != 3 || got.Inputs[0].Sequence != 9 {
+		t.Fatalf("inputs=%+v", got.Inputs)
+	}
+	if len(got.Outputs) != 1 || got.Outputs[0].CovenantDataHex != "0102" {
+		t.Fatalf("outputs=%+v", got.Outputs)
+	}
+	want := decodedWitnessItem{SuiteID: 0x02, PubkeyLen: 1, PubkeyHex: "bb", SignatureLen: 2, SignatureHex: "ccdd"}
+	if len(got.Witness) != 1 || got.Witness[0] != want {
+		t.Fatalf("witness=%+v", got.Witness)
+	}
+	// Key order follows the struct, so a re-run is byte-identical.
+	first := stdout.String()
+	stdout.Reset()
+	if code := run([]string{decodeTxCommand, "--tx-hex", txHex}, &stdout, &stderr); code != 0 || stdout.String() != first {
+		t.Fatalf("second run differs (exit=%d)", code)
+	}
+	if !strings.HasPrefix(first, "{\n  \"txid_hex\"") {
+		t.Fatalf("unexpected key order: %s", first)
+	}
+}
+
+func TestRunDecodeTxReadsHexFile(t *testing.T) {
+	txHex, _ := mustDecodeTestTxHex(t)
+	path := filepath.Join(t.TempDir(), "tx.hex")
+	if err := os.WriteFile(path, []byte(txHex+"\n"), 0o600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{decodeTxCommand, "--tx-hex-file", path}, &stdout, &stderr); code != 0 {
+		t.Fatalf("decode-tx exit=%d stderr=%s", code, stderr.String())
+	}
+}
+
+func TestRunDecodeTxParseFailureReportsTxErrParse(t *testing.T) {
+	txHex, _ := mustDecodeTestTxHex(t)
+	for name, in := range map[string]string{
+		"truncated": txHex[:len(txHex)-4],
+		"trailing":  txHex + "00",
+	} {
+		var stdout, stderr bytes.Buffer
+		if code := run([]string{decodeTxCommand, "--tx-hex", in}, &stdout, &stderr); code != 1 {
+			t.Fatalf("%s: exit=%d, want 1", name, code)
+		}
+		if !strings.HasPrefix(stderr.String(), string(consensus.TX_ERR_PARSE)) {
+			t.Fatalf("%s: stderr=%q", name, stderr.String())
+		}
+		if stdout.Len() != 0 {
+			t.Fatalf("%s: stdout=%q", name, stdout.String())
+		}
+	}
+}
+
+func TestRunDecodeTxRejectsBadUsage(t *testing.T) {
+	for _, args := range [][]string{
+		{decodeTxCommand},
+		{decodeTxCommand, "--tx-hex", "00", "--tx-hex-file", "x"},
+		{decodeTxCommand, "--tx-hex", "zz"},
+	} {
+		var stdout, stderr bytes.Buffer
+		if code := run(args, &stdout, &stderr); code != 2 {
+			t.Fatalf("%v: exit=%d, want 2 (stderr=%s)", args, code, stderr.String())
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/2tbmz9y2xt-lang/rubin-protocol/clients/go/consensus"
+)
+
+// lifecyclePlainVersion is the header version the miner uses when it
+// signals nothing.
+const lifecyclePlainVersion uint32 = 1
+
+// lifecycleHeaderStore is an in-memory canonical header chain built by
+// simulateDeploymentLifecycle. Headers are linked by prev hash and carry
+// only the fields the featurebit counters read; they are not PoW-valid.
+type lifecycleHeaderStore struct {
+	hashes  [][32]byte
+	headers map[[32]byte][]byte
+}
+
+func (s *lifecycleHeaderStore) CanonicalHash(height uint64) ([32]byte, bool, error) {
+	if height >= uint64(len(s.hashes)) {
+		return [32]byte{}, false, nil
+	}
+	return s.hashes[height], true, nil
+}
+
+func (s *lifecycleHeaderStore) GetHeaderByHash(hash [32]byte) ([]byte, error) {
+	header, ok := s.headers[hash]
+	if !ok {
+		return nil, fmt.Errorf("unknown header %x", hash)
+	}
+	return header, nil
+}
+
+func (s *lifecycleHeaderStore) mine(version uint32, timestamp uint64) error {
+	header := make([]byte, consensus.BLOCK_HEADER_BYTES)
+	binary.LittleEndian.PutUint32(header[0:4], version)
+	if n := len(s.hashes); n > 0 {
+		copy(header[4:36], s.hashes[n-1][:])
+	}
+	binary.LittleEndian.PutUint64(header[68:76], timestamp)
+	hash, err := consensus.BlockHash(header)
+	if err != nil {
+		return err
+	}
+	s.hashes = append(s.hashes, hash)
+	s.headers[hash] = header
+	return nil
+}
+
+// simulateDeploymentLifecycle mines len(windowSignals) full signal windows,
+// where the first windowSignals[i] headers of window i signal dj.Bit the way
+// the miner does and the rest use the plain version. It returns dj's state
+// at every window boundary from 0 through the one after the last window,
+// counted through the same path as the startup telemetry.
+func simulateDeploymentLifecycle(dj featureBitDeploymentJSON, windowSignals []uint32) ([]featureBitDeploymentInfo, error) {
+	if dj.Bit > consensus.VERSIONBITS_MAX_BIT {
+		return nil, fmt.Errorf("featurebits: bit %d cannot signal (max %d)", dj.Bit, consensus.VERSIONBITS_MAX_BIT)
+	}
+	signalVersion := consensus.VERSIONBITS_TOP_BITS | 1<<dj.Bit
+	bs := &lifecycleHeaderStore{headers: make(map[[32]byte][]byte)}
+	for win, signals := range windowSignals {
+		if signals > consensus.SIGNAL_WINDOW {
+			return nil, fmt.Errorf("featurebits: window %d signals %d > window size %d", win, signals, consensus.SIGNAL_WINDOW)
+		}
+		for pos := uint32(0); pos < consensus.SIGNAL_WINDOW; pos++ {
+			version := lifecyclePlainVersion
+			if pos < signals {
+				version = signalVersion
+			}
+			if err := bs.mine(version, uint64(len(bs.hashes))); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	states := make([]featureBitDeploymentInfo, 0, len(windowSignals)+1)
+	for boundary := 0; boundary <= len(windowSignals); boundary++ {
+		info, err := featureBitDeploymentStatus(bs, uint64(boundary)*consensus.SIGNAL_WINDOW, dj)
+		if err != nil {
+			return nil, err
+		}
+		states = append(states, info)
+	}
+	return states, nil
+}
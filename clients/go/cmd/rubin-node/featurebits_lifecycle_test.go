@@ -0,0 +1,74 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/2tbmz9y2xt-lang/rubin-protocol/clients/go/consensus"
+)
+
+func lifecycleStates(t *testing.T, dj featureBitDeploymentJSON, windowSignals []uint32) []string {
+	t.Helper()
+	infos, err := simulateDeploymentLifecycle(dj, windowSignals)
+	if err != nil {
+		t.Fatalf("simulateDeploymentLifecycle: %v", err)
+	}
+	states := make([]string, len(infos))
+	for i, info := range infos {
+		if info.BoundaryHeight != uint64(i)*consensus.SIGNAL_WINDOW {
+			t.Fatalf("boundary %d height=%d", i, info.BoundaryHeight)
+		}
+		states[i] = info.State
+	}
+	return states
+}
+
+func requireLifecycleStates(t *testing.T, got []string, want ...string) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("states=%v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("states=%v, want %v", got, want)
+		}
+	}
+}
+
+func TestSimulateDeploymentLifecycleActivates(t *testing.T) {
+	dj := featureBitDeploymentJSON{
+		Name:          "X",
+		Bit:           3,
+		StartHeight:   consensus.SIGNAL_WINDOW,
+		TimeoutHeight: 10 * consensus.SIGNAL_WINDOW,
+	}
+	got := lifecycleStates(t, dj, []uint32{consensus.SIGNAL_WINDOW, 0, consensus.SIGNAL_THRESHOLD, 0})
+	// Window 0 signals fully but precedes start_height, so it cannot lock in.
+	requireLifecycleStates(t, got, "DEFINED", "STARTED", "STARTED", "LOCKED_IN", "ACTIVE")
+}
+
+func TestSimulateDeploymentLifecycleStopsBeforeLockIn(t *testing.T) {
+	dj := featureBitDeploymentJSON{
+		Name:          "X",
+		Bit:           3,
+		StartHeight:   0,
+		TimeoutHeight: 3 * consensus.SIGNAL_WINDOW,
+	}
+	nearMiss := uint32(consensus.SIGNAL_THRESHOLD - 1)
+	got := lifecycleStates(t, dj, []uint32{nearMiss, nearMiss, 0, 0})
+	requireLifecycleStates(t, got, "STARTED", "STARTED", "STARTED", "FAILED", "FAILED")
+
+	// Threshold signaling after the timeout boundary cannot revive it.
+	got = lifecycleStates(t, dj, []uint32{0, 0, 0, consensus.SIGNAL_THRESHOLD})
+	requireLifecycleStates(t, got, "STARTED", "STARTED", "STARTED", "FAILED", "FAILED")
+}
+
+func TestSimulateDeploymentLifecycleRejectsBadInput(t *testing.T) {
+	dj := featureBitDeploymentJSON{Name: "X", Bit: 29, TimeoutHeight: consensus.SIGNAL_WINDOW}
+	if _, err := simulateDeploymentLifecycle(dj, []uint32{0}); err == nil {
+		t.Fatalf("expected error for non-signaling bit")
+	}
+	dj.Bit = 0
+	if _, err := simulateDeploymentLifecycle(dj, []uint32{consensus.SIGNAL_WINDOW + 1}); err == nil {
+		t.Fatalf("expected error for over-full window")
+	}
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"bytes"
+	"context"
 	"encoding/binary"
 	"encoding/json"
 	"os"
@@ -10,6 +11,7 @@ import (
 	"testing"
 
 	"github.com/2tbmz9y2xt-lang/rubin-protocol/clients/go/consensus"
+	"github.com/2tbmz9y2xt-lang/rubin-protocol/clients/go/node"
 )
 
 type fakeHeaderStore struct {
@@ -30,7 +32,7 @@ func (f *fakeHeaderStore) GetHeaderByHash(hash [32]byte) ([]byte, error) {
 
 	var version uint32
 	if uint32(pos) < f.windowCounts[windowIndex] {
-		version = 1 << f.bit
+		version = consensus.VERSIONBITS_TOP_BITS | 1<<f.bit
 	}
 
 	headerBytes := make([]byte, consensus.BLOCK_HEADER_BYTES)
@@ -83,6 +85,43 @@ func TestCountSignalsInWindow(t *testing.T) {
 	})
 }
 
+// versionHeaderStore serves every height with the same header version.
+type versionHeaderStore struct {
+	version uint32
+}
+
+func (v *versionHeaderStore) CanonicalHash(height uint64) ([32]byte, bool, error) {
+	var h [32]byte
+	binary.LittleEndian.PutUint64(h[:8], height)
+	return h, true, nil
+}
+
+func (v *versionHeaderStore) GetHeaderByHash(_ [32]byte) ([]byte, error) {
+	headerBytes := make([]byte, consensus.BLOCK_HEADER_BYTES)
+	binary.LittleEndian.PutUint32(headerBytes[:4], v.version)
+	return headerBytes, nil
+}
+
+func TestCountSignalsInWindowIgnoresNonConformingVersions(t *testing.T) {
+	const bit = 5
+	for _, version := range []uint32{1 << bit, 0x40000000 | 1<<bit, 0xE0000000 | 1<<bit} {
+		got, err := countSignalsInWindow(&versionHeaderStore{version: version}, 0, bit)
+		if err != nil {
+			t.Fatalf("countSignalsInWindow(%#x): %v", version, err)
+		}
+		if got != 0 {
+			t.Fatalf("version %#x counted %d signals for bit %d, want 0", version, got, bit)
+		}
+	}
+	got, err := countSignalsInWindow(&versionHeaderStore{version: consensus.VERSIONBITS_TOP_BITS | 1<<bit}, 0, bit)
+	if err != nil {
+		t.Fatalf("countSignalsInWindow(conforming): %v", err)
+	}
+	if got != uint32(consensus.SIGNAL_WINDOW) {
+		t.Fatalf("conforming window counted %d, want %d", got, consensus.SIGNAL_WINDOW)
+	}
+}
+
 func TestPrintFeatureBitsTelemetry(t *testing.T) {
 	dir := t.TempDir()
 	deploymentsPath := filepath.Join(dir, "deployments.json")
@@ -110,8 +149,12 @@ func TestPrintFeatureBitsTelemetry(t *testing.T) {
 		},
 	}
 
+	ds, err := loadFeatureBitDeployments(deploymentsPath)
+	if err != nil {
+		t.Fatalf("loadFeatureBitDeployments: %v", err)
+	}
 	var out bytes.Buffer
-	if err := printFeatureBitsTelemetry(&out, bs, consensus.SIGNAL_WINDOW, deploymentsPath); err != nil {
+	if err := printFeatureBitsTelemetry(&out, bs, consensus.SIGNAL_WINDOW, ds); err != nil {
 		t.Fatalf("printFeatureBitsTelemetry: %v", err)
 	}
 	s := out.String()
@@ -123,3 +166,120 @@ func TestPrintFeatureBitsTelemetry(t *testing.T) {
 		t.Fatalf("unexpected output: %q", s)
 	}
 }
+
+func TestFeatureBitDeploymentStatusAcrossDeployments(t *testing.T) {
+	bs := &fakeHeaderStore{
+		bit: 0,
+		windowCounts: map[uint64]uint32{
+			0: consensus.SIGNAL_THRESHOLD,
+		},
+	}
+	// Both deployments start at genesis; only bit 0 reaches the threshold in
+	// window 0, and bit 1 times out at the first boundary without signals.
+	lockedIn, err := featureBitDeploymentStatus(bs, consensus.SIGNAL_WINDOW, featureBitDeploymentJSON{
+		Name: "X", Bit: 0, TimeoutHeight: consensus.SIGNAL_WINDOW * 10,
+	})
+	if err != nil {
+		t.Fatalf("featureBitDeploymentStatus(X): %v", err)
+	}
+	if lockedIn.State != string(consensus.FEATUREBIT_LOCKED_IN) || lockedIn.PrevWindowSignalCount != consensus.SIGNAL_THRESHOLD {
+		t.Fatalf("X=%+v, want LOCKED_IN with %d signals", lockedIn, consensus.SIGNAL_THRESHOLD)
+	}
+	failed, err := featureBitDeploymentStatus(bs, consensus.SIGNAL_WINDOW, featureBitDeploymentJSON{
+		Name: "Y", Bit: 1, TimeoutHeight: consensus.SIGNAL_WINDOW,
+	})
+	if err != nil {
+		t.Fatalf("featureBitDeploymentStatus(Y): %v", err)
+	}
+	if failed.State != string(consensus.FEATUREBIT_FAILED) || failed.PrevWindowSignalCount != 0 || failed.BoundaryHeight != consensus.SIGNAL_WINDOW {
+		t.Fatalf("Y=%+v, want FAILED at boundary %d", failed, consensus.SIGNAL_WINDOW)
+	}
+}
+
+// minedPrefixHeaderStore serves real headers for the heights a blockstore
+// holds and non-signaling version-0 headers above them, so a single mined
+// block can be counted within a full signal window.
+type minedPrefixHeaderStore struct {
+	store *node.BlockStore
+}
+
+func (m *minedPrefixHeaderStore) CanonicalHash(height uint64) ([32]byte, bool, error) {
+	hash, ok, err := m.store.CanonicalHash(height)
+	if err != nil || ok {
+		return hash, ok, err
+	}
+	var synthetic [32]byte
+	synthetic[0] = 0xff
+	binary.LittleEndian.PutUint64(synthetic[1:9], height)
+	return synthetic, true, nil
+}
+
+func (m *minedPrefixHeaderStore) GetHeaderByHash(hash [32]byte) ([]byte, error) {
+	if hash[0] == 0xff {
+		return make([]byte, consensus.BLOCK_HEADER_BYTES), nil
+	}
+	return m.store.GetHeaderByHash(hash)
+}
+
+func TestMinerSignalBitsCountedInWindow(t *testing.T) {
+	dir := t.TempDir()
+	chainState := node.NewChainState()
+	blockStore, err := node.OpenBlockStore(node.BlockStorePath(dir))
+	if err != nil {
+		t.Fatalf("OpenBlockStore: %v", err)
+	}
+	syncEngine, err := node.NewSyncEngine(chainState, blockStore, node.DefaultSyncConfig(nil, [32]byte{}, node.ChainStatePath(dir)))
+	if err != nil {
+		t.Fatalf("NewSyncEngine: %v", err)
+	}
+	cfg := node.DefaultMinerConfig()
+	cfg.TimestampSource = func() uint64 { return 1_777_000_000 }
+	cfg.SignalBits = []uint8{3}
+	miner, err := node.NewMiner(chainState, blockStore, syncEngine, cfg)
+	if err != nil {
+		t.Fatalf("NewMiner: %v", err)
+	}
+	if _, err := miner.MineOne(context.Background(), nil); err != nil {
+		t.Fatalf("MineOne: %v", err)
+	}
+
+	bs := &minedPrefixHeaderStore{store: blockStore}
+	if got, err := countSignalsInWindow(bs, 0, 3); err != nil || got != 1 {
+		t.Fatalf("countSignalsInWindow(bit 3)=%d, %v; want 1", got, err)
+	}
+	if got, err := countSignalsInWindow(bs, 0, 4); err != nil || got != 0 {
+		t.Fatalf("countSignalsInWindow(bit 4)=%d, %v; want 0", got, err)
+	}
+}
+
+func TestResolveFeatureBitDeploymentsJSONOverridesBuiltin(t *testing.T) {
+	builtin, err := resolveFeatureBitDeployments("devnet", "")
+	if err != nil {
+		t.Fatalf("resolve built-in: %v", err)
+	}
+	want := node.DeploymentParams("devnet")
+	if len(builtin) != len(want) || builtin[0].Name != want[0].Name || builtin[0].TimeoutHeight != want[0].TimeoutHeight {
+		t.Fatalf("built-in devnet schedule=%+v, want %+v", builtin, want)
+	}
+	if ds, err := resolveFeatureBitDeployments("mainnet", ""); err != nil || ds != nil {
+		t.Fatalf("mainnet built-in schedule=%+v err=%v, want none", ds, err)
+	}
+
+	deploymentsPath := filepath.Join(t.TempDir(), "deployments.json")
+	raw, err := json.Marshal([]featureBitDeploymentJSON{
+		{Name: "override", Bit: 5, StartHeight: 0, TimeoutHeight: 4 * consensus.SIGNAL_WINDOW},
+	})
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	if err := os.WriteFile(deploymentsPath, raw, 0o600); err != nil {
+		t.Fatalf("write deployments: %v", err)
+	}
+	ds, err := resolveFeatureBitDeployments("devnet", deploymentsPath)
+	if err != nil {
+		t.Fatalf("resolve override: %v", err)
+	}
+	if len(ds) != 1 || ds[0].Name != "override" || ds[0].Bit != 5 {
+		t.Fatalf("override schedule=%+v, want only the file's deployment", ds)
+	}
+}
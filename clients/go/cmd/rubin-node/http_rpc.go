@@ -71,6 +71,9 @@ type devnetRPCState struct {
 	// atomic.Load on the service side, so /metrics rendering does not
 	// mutate any counter.
 	peerLifecycleExits func() uint64
+	// featureBitDeployments is the -featurebits-deployments set served
+	// by /get_deployment_info; nil reports an empty list.
+	featureBitDeployments []featureBitDeploymentJSON
 }
 
 // chainIdentity is a snapshot of startup-wired chain identity. Fields
@@ -278,6 +281,16 @@ func (s *devnetRPCState) SetIdentity(network string, chainID, genesisHash [32]by
 	}
 }
 
+// SetFeatureBitDeployments stores the configured featurebit deployments
+// for /get_deployment_info. cmd/rubin-node main.go calls it once during
+// startup wiring, before the RPC server starts. Nil-receiver safe.
+func (s *devnetRPCState) SetFeatureBitDeployments(ds []featureBitDeploymentJSON) {
+	if s == nil {
+		return
+	}
+	s.featureBitDeployments = ds
+}
+
 // SetPeerLifecycleExitsFunc stores a closure that returns the
 // monotonic peer-lifecycle-exit count from the running *p2p.Service.
 // cmd/rubin-node main.go binds it to p2pService.PeerLifecycleExits
@@ -341,6 +354,22 @@ type getTipResponse struct {
 	InIBD           bool    `json:"in_ibd"`
 }
 
+type chainTipEntry struct {
+	Height    uint64 `json:"height"`
+	Hash      string `json:"hash"`
+	BranchLen uint64 `json:"branch_len"`
+	Status    string `json:"status"`
+}
+
+type getChainTipsResponse struct {
+	Tips []chainTipEntry `json:"tips"`
+}
+
+type getDeploymentInfoResponse struct {
+	Height      uint64                     `json:"height"`
+	Deployments []featureBitDeploymentInfo `json:"deployments"`
+}
+
 type getBlockResponse struct {
 	Hash      string `json:"hash"`
 	Height    uint64 `json:"height"`
@@ -689,6 +718,12 @@ func newDevnetRPCHandler(state *devnetRPCState) http.Handler {
 	mux.HandleFunc("/get_tip", func(w http.ResponseWriter, r *http.Request) {
 		handleGetTip(state, w, r)
 	})
+	mux.HandleFunc("/get_chain_tips", func(w http.ResponseWriter, r *http.Request) {
+		handleGetChainTips(state, w, r)
+	})
+	mux.HandleFunc("/get_deployment_info", func(w http.ResponseWriter, r *http.Request) {
+		handleGetDeploymentInfo(state, w, r)
+	})
 	mux.HandleFunc("/get_block", func(w http.ResponseWriter, r *http.Request) {
 		handleGetBlock(state, w, r)
 	})
@@ -768,6 +803,94 @@ func handleGetTip(state *devnetRPCState, w http.ResponseWriter, r *http.Request)
 	})
 }
 
+// handleGetChainTips lists the active tip plus every known side-branch tip
+// (valid-fork or headers-only) for fork monitoring.
+func handleGetChainTips(state *devnetRPCState, w http.ResponseWriter, r *http.Request) {
+	const route = "/get_chain_tips"
+	if r.Method != http.MethodGet {
+		writeJSONResponse(state, route, w, http.StatusBadRequest, submitTxResponse{
+			Accepted: false,
+			Error:    "GET required",
+		})
+		return
+	}
+	if state == nil || state.blockStore == nil {
+		writeJSONResponse(state, route, w, http.StatusServiceUnavailable, submitTxResponse{
+			Accepted: false,
+			Error:    "blockstore unavailable",
+		})
+		return
+	}
+	tips, err := state.blockStore.ChainTips()
+	if err != nil {
+		writeJSONResponse(state, route, w, http.StatusServiceUnavailable, submitTxResponse{
+			Accepted: false,
+			Error:    err.Error(),
+		})
+		return
+	}
+	resp := getChainTipsResponse{Tips: make([]chainTipEntry, 0, len(tips))}
+	for _, tip := range tips {
+		resp.Tips = append(resp.Tips, chainTipEntry{
+			Height:    tip.Height,
+			Hash:      hex.EncodeToString(tip.Hash[:]),
+			BranchLen: tip.BranchLen,
+			Status:    tip.Status,
+		})
+	}
+	writeJSONResponse(state, route, w, http.StatusOK, resp)
+}
+
+// handleGetDeploymentInfo serves GET /get_deployment_info: the featurebit
+// state of every configured deployment for the block after the canonical
+// tip, the same height the startup featurebits telemetry reports.
+func handleGetDeploymentInfo(state *devnetRPCState, w http.ResponseWriter, r *http.Request) {
+	const route = "/get_deployment_info"
+	if r.Method != http.MethodGet {
+		writeJSONResponse(state, route, w, http.StatusBadRequest, submitTxResponse{
+			Accepted: false,
+			Error:    "GET required",
+		})
+		return
+	}
+	if state == nil || state.blockStore == nil {
+		writeJSONResponse(state, route, w, http.StatusServiceUnavailable, submitTxResponse{
+			Accepted: false,
+			Error:    "blockstore unavailable",
+		})
+		return
+	}
+	tipHeight, _, ok, err := tipFromBlockStore(state.blockStore)
+	if err != nil || !ok {
+		msg := "blockstore has no tip"
+		if err != nil {
+			msg = err.Error()
+		}
+		writeJSONResponse(state, route, w, http.StatusServiceUnavailable, submitTxResponse{
+			Accepted: false,
+			Error:    msg,
+		})
+		return
+	}
+	height := tipHeight + 1
+	resp := getDeploymentInfoResponse{
+		Height:      height,
+		Deployments: make([]featureBitDeploymentInfo, 0, len(state.featureBitDeployments)),
+	}
+	for _, dj := range state.featureBitDeployments {
+		info, err := featureBitDeploymentStatus(state.blockStore, height, dj)
+		if err != nil {
+			writeJSONResponse(state, route, w, http.StatusInternalServerError, submitTxResponse{
+				Accepted: false,
+				Error:    err.Error(),
+			})
+			return
+		}
+		resp.Deployments = append(resp.Deployments, info)
+	}
+	writeJSONResponse(state, route, w, http.StatusOK, resp)
+}
+
 func handleGetBlock(state *devnetRPCState, w http.ResponseWriter, r *http.Request) {
 	const route = "/get_block"
 	if r.Method != http.MethodGet {
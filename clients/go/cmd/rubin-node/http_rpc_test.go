@@ -1579,6 +1579,129 @@ func TestRenderPrometheusMetricsExposesReorgCountersReadOnly(t *testing.T) {
 	}
 }
 
+func TestDevnetRPCGetChainTipsListsActiveAndForkTips(t *testing.T) {
+	state := mustRPCState(t, true)
+	target := consensus.POW_LIMIT
+	subsidy1 := consensus.BlockSubsidy(1, 0)
+
+	blockA1 := mustRPCSingleTxBlock(
+		t,
+		node.DevnetGenesisBlockHash(),
+		target,
+		mustRPCReorgTestTimestamp(t, 1),
+		mustRPCCoinbaseWithWitnessCommitmentAndP2PKValueAtHeight(t, 1, subsidy1),
+	)
+	if _, err := state.syncEngine.ApplyBlock(blockA1, nil); err != nil {
+		t.Fatalf("ApplyBlock(A1): %v", err)
+	}
+	blockB1 := mustRPCSingleTxBlock(
+		t,
+		node.DevnetGenesisBlockHash(),
+		target,
+		mustRPCReorgTestTimestamp(t, 2),
+		mustRPCCoinbaseWithWitnessCommitmentAndP2PKValueAtHeight(t, 1, subsidy1),
+	)
+	if _, err := state.syncEngine.ApplyBlockWithReorg(blockB1, nil); err != nil {
+		t.Fatalf("ApplyBlockWithReorg(B1): %v", err)
+	}
+
+	server := httptest.NewServer(newDevnetRPCHandler(state))
+	defer server.Close()
+	resp, err := http.Get(server.URL + "/get_chain_tips")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status=%d, want 200", resp.StatusCode)
+	}
+	var got getChainTipsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+
+	hashA1 := mustRPCBlockHash(t, blockA1)
+	hashB1 := mustRPCBlockHash(t, blockB1)
+	want := map[string]chainTipEntry{
+		hex.EncodeToString(hashA1[:]): {Height: 1, Hash: hex.EncodeToString(hashA1[:]), BranchLen: 0, Status: node.ChainTipStatusActive},
+		hex.EncodeToString(hashB1[:]): {Height: 1, Hash: hex.EncodeToString(hashB1[:]), BranchLen: 1, Status: node.ChainTipStatusValidFork},
+	}
+	if len(got.Tips) != len(want) {
+		t.Fatalf("tips=%+v, want %d entries", got.Tips, len(want))
+	}
+	for _, tip := range got.Tips {
+		if tip != want[tip.Hash] {
+			t.Fatalf("tip=%+v, want %+v", tip, want[tip.Hash])
+		}
+	}
+}
+
+func TestDevnetRPCGetChainTipsRejectsBadMethodAndNilBlockStore(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/get_chain_tips", nil)
+	rec := httptest.NewRecorder()
+	newDevnetRPCHandler(mustRPCState(t, true)).ServeHTTP(rec, req)
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("status=%d, want 400", rec.Code)
+	}
+
+	state := mustRPCState(t, true)
+	state.blockStore = nil
+	rec = httptest.NewRecorder()
+	newDevnetRPCHandler(state).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/get_chain_tips", nil))
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("status=%d, want 503", rec.Code)
+	}
+}
+
+func TestDevnetRPCGetDeploymentInfoReportsEveryDeployment(t *testing.T) {
+	state := mustRPCState(t, true)
+	activation := uint64(1)
+	state.SetFeatureBitDeployments([]featureBitDeploymentJSON{
+		{Name: "started", Bit: 0, StartHeight: 0, TimeoutHeight: consensus.SIGNAL_WINDOW * 10, ActivationHeight: &activation},
+		{Name: "defined", Bit: 1, StartHeight: consensus.SIGNAL_WINDOW * 2, TimeoutHeight: consensus.SIGNAL_WINDOW * 10},
+	})
+
+	server := httptest.NewServer(newDevnetRPCHandler(state))
+	defer server.Close()
+	resp, err := http.Get(server.URL + "/get_deployment_info")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status=%d, want 200", resp.StatusCode)
+	}
+	var got getDeploymentInfoResponse
+	if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	if got.Height != 1 || len(got.Deployments) != 2 {
+		t.Fatalf("resp=%+v, want height 1 with 2 deployments", got)
+	}
+	started, defined := got.Deployments[0], got.Deployments[1]
+	if started.Name != "started" || started.State != string(consensus.FEATUREBIT_STARTED) || started.BoundaryHeight != 0 ||
+		started.ConsensusActive == nil || !*started.ConsensusActive || started.SignalThreshold != consensus.SIGNAL_THRESHOLD {
+		t.Fatalf("started deployment=%+v", started)
+	}
+	if defined.Name != "defined" || defined.State != string(consensus.FEATUREBIT_DEFINED) || defined.Bit != 1 || defined.ConsensusActive != nil {
+		t.Fatalf("defined deployment=%+v", defined)
+	}
+}
+
+func TestDevnetRPCGetDeploymentInfoRejectsBadMethodAndEmptyChain(t *testing.T) {
+	rec := httptest.NewRecorder()
+	newDevnetRPCHandler(mustRPCState(t, true)).ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/get_deployment_info", nil))
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("status=%d, want 400", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	newDevnetRPCHandler(mustRPCState(t, false)).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/get_deployment_info", nil))
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("status=%d, want 503", rec.Code)
+	}
+}
+
 type rpcTestOutput struct {
 	covenantData []byte
 	value        uint64
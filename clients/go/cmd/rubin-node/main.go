@@ -34,6 +34,8 @@ var newMempoolFn = node.NewMempoolWithConfig
 
 var newP2PServiceFn = p2p.NewService
 
+var lookupEnvFn = os.LookupEnv
+
 func applySuiteContextToSyncConfig(cfg *node.SyncConfig, rotation consensus.RotationProvider, registry *consensus.SuiteRegistry) {
 	if cfg == nil {
 		return
@@ -232,16 +234,102 @@ func main() {
 	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
 }
 
+// configPathFromArgs finds the -config value ahead of flag parsing, since
+// the file must be loaded before the flags that override it are defined.
+func configPathFromArgs(args []string) string {
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" {
+			return ""
+		}
+		if !strings.HasPrefix(arg, "-") {
+			continue
+		}
+		name, value, hasValue := strings.Cut(strings.TrimLeft(arg, "-"), "=")
+		if name != "config" {
+			continue
+		}
+		if hasValue {
+			return value
+		}
+		if i+1 < len(args) {
+			return args[i+1]
+		}
+		return ""
+	}
+	return ""
+}
+
 func run(args []string, stdout, stderr io.Writer) int {
+	cpuProfilePath, memProfilePath, args := splitProfileFlags(args)
+	output, args, err := splitOutputFlag(args)
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "%v\n", err)
+		return 2
+	}
+	if len(args) > 0 && isSubcommand(args[0]) {
+		stopPprof, err := startPprof(cpuProfilePath, memProfilePath)
+		if err != nil {
+			_, _ = fmt.Fprintf(stderr, "profiling failed: %v\n", err)
+			return 2
+		}
+		defer func() {
+			if err := stopPprof(); err != nil {
+				_, _ = fmt.Fprintf(stderr, "profiling failed: %v\n", err)
+			}
+		}()
+	}
+	if len(args) > 0 && args[0] == profileValidateCommand {
+		return runProfileValidate(args[1:], output, stdout, stderr)
+	}
+	if len(args) > 0 && (args[0] == invalidateBlockCommand || args[0] == reconsiderBlockCommand) {
+		return runBlockValidityCommand(args[0], args[1:], output, stdout, stderr)
+	}
+	if len(args) > 0 && args[0] == rewindCommand {
+		return runRewind(args[1:], output, stdout, stderr)
+	}
+	if len(args) > 0 && args[0] == benchValidateCommand {
+		return runBenchValidate(args[1:], output, stdout, stderr)
+	}
+	if len(args) > 0 && args[0] == statusCommand {
+		return runStatus(args[1:], output, stdout, stderr)
+	}
+	if len(args) > 0 && args[0] == decodeTxCommand {
+		return runDecodeTx(args[1:], stdout, stderr)
+	}
+	if len(args) > 0 && args[0] == decodeBlockCommand {
+		return runDecodeBlock(args[1:], stdout, stderr)
+	}
+	if len(args) > 0 && args[0] == verifyBatchCommand {
+		return runVerifyBatch(args[1:], stdout, stderr)
+	}
+	// Precedence is defaults < --config file < RUBIN_* environment < flags:
+	// the file and environment together supply the flag defaults, so any
+	// explicit flag overrides both and unset flags keep the merged values.
 	defaults := node.DefaultConfig()
+	configPath := configPathFromArgs(args)
+	if configPath != "" {
+		fileCfg, err := node.LoadConfigFile(configPath)
+		if err != nil {
+			_, _ = fmt.Fprintf(stderr, "invalid config: %v\n", err)
+			return 2
+		}
+		defaults = fileCfg
+	}
+	if err := node.ApplyConfigEnv(&defaults, lookupEnvFn); err != nil {
+		_, _ = fmt.Fprintf(stderr, "invalid config: %v\n", err)
+		return 2
+	}
 	var peers multiStringFlag
 	var legacySuiteIDs multiStringFlag
+	var checkpointSpecs multiStringFlag
 	var watchedSuiteIDs []uint8
 
 	cfg := defaults
 	fs := flag.NewFlagSet("rubin-node", flag.ContinueOnError)
 	fs.SetOutput(stderr)
 
+	fs.String("config", configPath, "path to JSON node config file; explicit flags override its values")
 	peerCSV := fs.String("peers", "", "bootstrap peers, comma-separated host:port")
 	fs.Var(&peers, "peer", "single bootstrap peer host:port (repeatable)")
 	fs.StringVar(&cfg.Network, "network", defaults.Network, "network name (devnet/testnet/mainnet)")
@@ -253,22 +341,43 @@ func run(args []string, stdout, stderr io.Writer) int {
 	fs.IntVar(&cfg.MaxPeers, "max-peers", defaults.MaxPeers, "max connected peers")
 	fs.IntVar(&cfg.MempoolMaxTxs, "mempool-max-txs", defaults.MempoolMaxTxs, "maximum canonical mempool transactions")
 	fs.IntVar(&cfg.MempoolMaxBytes, "mempool-max-bytes", defaults.MempoolMaxBytes, "maximum canonical mempool serialized transaction bytes")
-	fs.StringVar(&cfg.MineAddress, "mine-address", "", "miner pubkey: 64-char hex key_id or 66-char hex suite_id||key_id")
+	fs.StringVar(&cfg.MineAddress, "mine-address", defaults.MineAddress, "miner pubkey: 64-char hex key_id or 66-char hex suite_id||key_id")
 	mineBlocks := fs.Int("mine-blocks", 0, "mine N blocks locally after startup")
 	mineExit := fs.Bool("mine-exit", false, "exit immediately after local mining")
-	featurebitsDeploymentsPath := fs.String("featurebits-deployments", "", "path to JSON file with featurebit deployments (telemetry-only)")
+	featurebitsDeploymentsPath := fs.String("featurebits-deployments", "", "path to JSON file with featurebit deployments, replacing the built-in network schedule (telemetry-only)")
 	pvMode := fs.String("pv-mode", "off", "parallel validation mode: off|shadow|on (truth path is sequential)")
 	pvShadowMax := fs.Uint64("pv-shadow-max", 3, "max pv shadow mismatch samples to record/print (bounded)")
+	maxReorgDepth := fs.Uint64("max-reorg-depth", 0, "refuse reorgs disconnecting more than this many canonical blocks (0 = unlimited)")
+	allowDeepReorg := fs.Bool("allow-deep-reorg", false, "operator override: permit reorgs beyond -max-reorg-depth")
+	fs.Var(&checkpointSpecs, "checkpoint", "finalized checkpoint height:blockhashhex (repeatable)")
+	assumeValid := fs.String("assumevalid", "", "skip signature checks for trusted block height:blockhashhex and its ancestors; never rejects blocks (empty = verify all)")
 	legacyExposureScan := fs.Bool("legacy-exposure-scan", false, "emit legacy suite exposure report and exit")
 	fs.Var(&legacySuiteIDs, "legacy-suite-id", "legacy suite_id to watch (decimal or 0xNN); repeatable")
 	legacyExposureIncludeOutpoints := fs.Bool("legacy-exposure-include-outpoints", false, "include deterministic outpoint lists in legacy exposure report")
 	dryRun := fs.Bool("dry-run", false, "print effective config and exit")
+	repairChainState := fs.Bool("repair-chainstate", false, "rebuild an unreadable chainstate by replaying the blockstore instead of aborting")
+	cpuProfile := fs.String("cpuprofile", "", "write a pprof CPU profile to this path on exit (place before a subcommand to profile it)")
+	memProfile := fs.String("memprofile", "", "write a pprof heap profile to this path on exit (place before a subcommand to profile it)")
 	if err := fs.Parse(args); err != nil {
 		return 2
 	}
+	stopPprof, err := startPprof(*cpuProfile, *memProfile)
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "profiling failed: %v\n", err)
+		return 2
+	}
+	defer func() {
+		if err := stopPprof(); err != nil {
+			_, _ = fmt.Fprintf(stderr, "profiling failed: %v\n", err)
+		}
+	}()
 
 	cfg.LogLevel = strings.ToLower(strings.TrimSpace(cfg.LogLevel))
-	cfg.Peers = node.NormalizePeers(append([]string{*peerCSV}, peers...)...)
+	if flagPeers := node.NormalizePeers(append([]string{*peerCSV}, peers...)...); len(flagPeers) > 0 {
+		cfg.Peers = flagPeers
+	} else {
+		cfg.Peers = node.NormalizePeers(cfg.Peers...)
+	}
 	if err := node.ValidateConfig(cfg); err != nil {
 		_, _ = fmt.Fprintf(stderr, "invalid config: %v\n", err)
 		return 2
@@ -292,6 +401,20 @@ func run(args []string, stdout, stderr io.Writer) int {
 		_, _ = fmt.Fprintf(stderr, "invalid pv-mode: %v\n", err)
 		return 2
 	}
+	checkpoints, err := node.ParseCheckpoints(checkpointSpecs)
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "invalid checkpoint: %v\n", err)
+		return 2
+	}
+	var assumeValidHeight uint64
+	var assumeValidHash [32]byte
+	if strings.TrimSpace(*assumeValid) != "" {
+		assumeValidHeight, assumeValidHash, err = node.ParseCheckpoint(*assumeValid)
+		if err != nil {
+			_, _ = fmt.Fprintf(stderr, "invalid assumevalid: %v\n", err)
+			return 2
+		}
+	}
 	if *legacyExposureScan {
 		var err error
 		watchedSuiteIDs, err = normalizeLegacySuiteIDs([]string(legacySuiteIDs))
@@ -350,8 +473,14 @@ func run(args []string, stdout, stderr io.Writer) int {
 	}
 	chainState, err := node.LoadChainState(chainStatePath)
 	if err != nil {
-		_, _ = fmt.Fprintf(stderr, "chainstate load failed: %v\n", err)
-		return 2
+		if !*repairChainState {
+			_, _ = fmt.Fprintf(stderr, "chainstate load failed: %v\n", err)
+			return 2
+		}
+		// An empty chainstate makes the reconcile below replay every
+		// canonical block from height 0 and save the result.
+		_, _ = fmt.Fprintf(stderr, "chainstate load failed: %v; rebuilding from blockstore\n", err)
+		chainState = node.NewChainState()
 	}
 	chainIDFromGenesis := genesisCfg.ChainID
 	genesisHashFromGenesis := genesisCfg.GenesisHash
@@ -377,6 +506,12 @@ func run(args []string, stdout, stderr io.Writer) int {
 	applySuiteContextToSyncConfig(&syncCfg, rotation, registry)
 	syncCfg.ParallelValidationMode = *pvMode
 	syncCfg.PVShadowMaxSamples = *pvShadowMax
+	syncCfg.MaxReorgDepth = *maxReorgDepth
+	syncCfg.AllowDeepReorg = *allowDeepReorg
+	syncCfg.Checkpoints = checkpoints
+	syncCfg.AssumeValidHeight = assumeValidHeight
+	syncCfg.AssumeValidHash = assumeValidHash
+	syncCfg.GenesisCoinbaseValue = genesisCfg.GenesisCoinbaseValue
 	// Genesis-identity guards (devnet ValidateDevnetGenesisIdentity and
 	// mainnet ValidateMainnetGenesisGuard) ran above before MkdirAll, so
 	// any malformed pack or misconfigured mainnet runtime has already
@@ -444,9 +579,14 @@ func run(args []string, stdout, stderr io.Writer) int {
 		_, _ = fmt.Fprintf(stdout, "chainstate: has_tip=%v height=%d utxos=%d already_generated=%d\n", chainState.HasTip, chainState.Height, len(chainState.Utxos), chainState.AlreadyGenerated)
 		_, _ = fmt.Fprintln(stdout, "blockstore: empty")
 	}
-	if *featurebitsDeploymentsPath != "" && tipOK {
+	featureBitDeployments, err := resolveFeatureBitDeployments(cfg.Network, *featurebitsDeploymentsPath)
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "featurebits telemetry failed: %v\n", err)
+		return 2
+	}
+	if len(featureBitDeployments) > 0 && tipOK {
 		nextHeight := tipHeight + 1
-		if err := printFeatureBitsTelemetry(stdout, blockStore, nextHeight, *featurebitsDeploymentsPath); err != nil {
+		if err := printFeatureBitsTelemetry(stdout, blockStore, nextHeight, featureBitDeployments); err != nil {
 			_, _ = fmt.Fprintf(stderr, "featurebits telemetry failed: %v\n", err)
 			return 2
 		}
@@ -558,6 +698,7 @@ func run(args []string, stdout, stderr io.Writer) int {
 	// RPC state taking a structural dependency on the p2p package —
 	// same indirection pattern as p2pService.AnnounceTx above.
 	rpcState.SetPeerLifecycleExitsFunc(p2pService.PeerLifecycleExits)
+	rpcState.SetFeatureBitDeployments(featureBitDeployments)
 	rpcServer, err := startDevnetRPCServer(cfg.RPCBindAddr, rpcState, stdout, stderr)
 	if err != nil {
 		_, _ = fmt.Fprintf(stderr, "rpc start failed: %v\n", err)
@@ -628,62 +769,132 @@ type headerStore interface {
 	GetHeaderByHash(hash [32]byte) ([]byte, error)
 }
 
-func printFeatureBitsTelemetry(w io.Writer, bs headerStore, height uint64, deploymentsPath string) error {
-	raw, err := os.ReadFile(filepath.Clean(deploymentsPath))
-	if err != nil {
-		return err
-	}
-	var ds []featureBitDeploymentJSON
-	if err := json.Unmarshal(raw, &ds); err != nil {
-		return err
-	}
+func printFeatureBitsTelemetry(w io.Writer, bs headerStore, height uint64, ds []featureBitDeploymentJSON) error {
 	for _, dj := range ds {
-		d := consensus.FeatureBitDeployment{
-			Name:          dj.Name,
-			Bit:           dj.Bit,
-			StartHeight:   dj.StartHeight,
-			TimeoutHeight: dj.TimeoutHeight,
-		}
-		boundaryHeight := height - (height % consensus.SIGNAL_WINDOW)
-		targetBoundaryIndex := boundaryHeight / consensus.SIGNAL_WINDOW
-
-		counts := make([]uint32, targetBoundaryIndex)
-		if targetBoundaryIndex > 0 {
-			firstBoundary := ((d.StartHeight + consensus.SIGNAL_WINDOW - 1) / consensus.SIGNAL_WINDOW) * consensus.SIGNAL_WINDOW
-			startWindowIndex := firstBoundary / consensus.SIGNAL_WINDOW
-			for win := startWindowIndex; win < targetBoundaryIndex; win++ {
-				cnt, err := countSignalsInWindow(bs, win, d.Bit)
-				if err != nil {
-					return err
-				}
-				counts[win] = cnt
-			}
-		}
-
-		ev, err := consensus.FeatureBitStateAtHeightFromWindowCounts(d, height, counts)
+		info, err := featureBitDeploymentStatus(bs, height, dj)
 		if err != nil {
 			return err
 		}
 		consensusActive := ""
-		if dj.ActivationHeight != nil {
-			active := height >= *dj.ActivationHeight
-			consensusActive = fmt.Sprintf(" consensus_active=%t activation_height=%d", active, *dj.ActivationHeight)
+		if info.ConsensusActive != nil {
+			consensusActive = fmt.Sprintf(" consensus_active=%t activation_height=%d", *info.ConsensusActive, *info.ActivationHeight)
 		}
 		_, _ = fmt.Fprintf( // #nosec G705 -- plain-text featurebits telemetry to CLI output, not HTML/template output.
 			w,
 			"featurebits: name=%s bit=%d height=%d boundary=%d state=%s prev_window_signal_count=%d%s\n",
-			d.Name,
-			d.Bit,
+			info.Name,
+			info.Bit,
 			height,
-			ev.BoundaryHeight,
-			ev.State,
-			ev.PrevWindowSignalCnt,
+			info.BoundaryHeight,
+			info.State,
+			info.PrevWindowSignalCount,
 			consensusActive,
 		)
 	}
 	return nil
 }
 
+// resolveFeatureBitDeployments returns the operator deployments file when
+// one is given, otherwise the built-in node.DeploymentParams schedule for
+// network.
+func resolveFeatureBitDeployments(network, deploymentsPath string) ([]featureBitDeploymentJSON, error) {
+	if deploymentsPath != "" {
+		return loadFeatureBitDeployments(deploymentsPath)
+	}
+	builtin := node.DeploymentParams(network)
+	if len(builtin) == 0 {
+		return nil, nil
+	}
+	ds := make([]featureBitDeploymentJSON, 0, len(builtin))
+	for _, d := range builtin {
+		ds = append(ds, featureBitDeploymentJSON{
+			Name:          d.Name,
+			Bit:           d.Bit,
+			StartHeight:   d.StartHeight,
+			TimeoutHeight: d.TimeoutHeight,
+		})
+	}
+	return ds, nil
+}
+
+func loadFeatureBitDeployments(deploymentsPath string) ([]featureBitDeploymentJSON, error) {
+	raw, err := os.ReadFile(filepath.Clean(deploymentsPath))
+	if err != nil {
+		return nil, err
+	}
+	var ds []featureBitDeploymentJSON
+	if err := json.Unmarshal(raw, &ds); err != nil {
+		return nil, err
+	}
+	return ds, nil
+}
+
+// featureBitDeploymentInfo is one deployment's featurebit state at a height,
+// as printed by the startup telemetry and served by /get_deployment_info.
+type featureBitDeploymentInfo struct {
+	Name                  string  `json:"name"`
+	State                 string  `json:"state"`
+	StartHeight           uint64  `json:"start_height"`
+	TimeoutHeight         uint64  `json:"timeout_height"`
+	BoundaryHeight        uint64  `json:"boundary_height"`
+	SignalWindow          uint64  `json:"signal_window"`
+	ActivationHeight      *uint64 `json:"activation_height,omitempty"`
+	ConsensusActive       *bool   `json:"consensus_active,omitempty"`
+	PrevWindowSignalCount uint32  `json:"prev_window_signal_count"`
+	SignalThreshold       uint32  `json:"signal_threshold"`
+	Bit                   uint8   `json:"bit"`
+}
+
+// featureBitDeploymentStatus runs the featurebit state machine for dj up to
+// height, counting version-bit signals in every completed window since the
+// deployment's first boundary.
+func featureBitDeploymentStatus(bs headerStore, height uint64, dj featureBitDeploymentJSON) (featureBitDeploymentInfo, error) {
+	d := consensus.FeatureBitDeployment{
+		Name:          dj.Name,
+		Bit:           dj.Bit,
+		StartHeight:   dj.StartHeight,
+		TimeoutHeight: dj.TimeoutHeight,
+	}
+	boundaryHeight := height - (height % consensus.SIGNAL_WINDOW)
+	targetBoundaryIndex := boundaryHeight / consensus.SIGNAL_WINDOW
+
+	counts := make([]uint32, targetBoundaryIndex)
+	if targetBoundaryIndex > 0 {
+		firstBoundary := ((d.StartHeight + consensus.SIGNAL_WINDOW - 1) / consensus.SIGNAL_WINDOW) * consensus.SIGNAL_WINDOW
+		startWindowIndex := firstBoundary / consensus.SIGNAL_WINDOW
+		for win := startWindowIndex; win < targetBoundaryIndex; win++ {
+			cnt, err := countSignalsInWindow(bs, win, d.Bit)
+			if err != nil {
+				return featureBitDeploymentInfo{}, err
+			}
+			counts[win] = cnt
+		}
+	}
+
+	ev, err := consensus.FeatureBitStateAtHeightFromWindowCounts(d, height, counts)
+	if err != nil {
+		return featureBitDeploymentInfo{}, err
+	}
+	info := featureBitDeploymentInfo{
+		Name:                  d.Name,
+		State:                 string(ev.State),
+		StartHeight:           d.StartHeight,
+		TimeoutHeight:         d.TimeoutHeight,
+		BoundaryHeight:        ev.BoundaryHeight,
+		SignalWindow:          ev.SignalWindow,
+		PrevWindowSignalCount: ev.PrevWindowSignalCnt,
+		SignalThreshold:       ev.SignalThreshold,
+		Bit:                   d.Bit,
+	}
+	if dj.ActivationHeight != nil {
+		activationHeight := *dj.ActivationHeight
+		active := height >= activationHeight
+		info.ActivationHeight = &activationHeight
+		info.ConsensusActive = &active
+	}
+	return info, nil
+}
+
 func countSignalsInWindow(bs headerStore, windowIndex uint64, bit uint8) (uint32, error) {
 	var count uint32
 	start := windowIndex * consensus.SIGNAL_WINDOW
@@ -704,7 +915,7 @@ func countSignalsInWindow(bs headerStore, windowIndex uint64, bit uint8) (uint32
 		if err != nil {
 			return 0, err
 		}
-		if ((header.Version >> bit) & 1) == 1 {
+		if consensus.VersionSignalsBit(header.Version, bit) {
 			count++
 		}
 	}
@@ -731,11 +942,14 @@ type genesisPack struct {
 	GenesisHashHex        string `json:"genesis_hash_hex"`
 	GenesisBlockHashHex   string `json:"genesis_block_hash_hex"`
 	GenesisHeaderBytesHex string `json:"genesis_header_bytes_hex"`
+	// GenesisCoinbaseValue is the premine the genesis coinbase must pay.
+	GenesisCoinbaseValue *uint64 `json:"genesis_coinbase_value"`
 }
 
 type parsedGenesisConfig struct {
-	ChainID     [32]byte
-	GenesisHash [32]byte
+	ChainID              [32]byte
+	GenesisHash          [32]byte
+	GenesisCoinbaseValue *uint64 // nil: genesis coinbase value unchecked
 }
 
 // maybeFlipReadyOnStartup attempts the boot-time readiness gate
@@ -803,6 +1017,7 @@ func parseGenesisConfigFull(path string) (parsedGenesisConfig, error) {
 	if err != nil {
 		return cfg, err
 	}
+	cfg.GenesisCoinbaseValue = payload.GenesisCoinbaseValue
 	return cfg, nil
 }
 
@@ -296,6 +296,47 @@ func TestLegacyExposureExampleFixtureMatchesFrozenContract(t *testing.T) {
 	}
 }
 
+func TestRunRepairChainStateRebuildsCorruptChainState(t *testing.T) {
+	dir := t.TempDir()
+	mustMaintenanceChain(t, dir, 2)
+	path := node.ChainStatePath(dir)
+	clean, err := node.LoadChainState(path)
+	if err != nil {
+		t.Fatalf("LoadChainState(clean): %v", err)
+	}
+	if err := os.WriteFile(path, []byte(`{"version":`), 0o600); err != nil {
+		t.Fatalf("corrupt chainstate: %v", err)
+	}
+
+	var out, errOut bytes.Buffer
+	if code := run([]string{"--dry-run", "--datadir", dir}, &out, &errOut); code != 2 {
+		t.Fatalf("without repair: exit=%d, want 2", code)
+	}
+	if !strings.Contains(errOut.String(), "chainstate load failed") {
+		t.Fatalf("stderr=%q", errOut.String())
+	}
+
+	out.Reset()
+	errOut.Reset()
+	if code := run([]string{"--dry-run", "--datadir", dir, "--repair-chainstate"}, &out, &errOut); code != 0 {
+		t.Fatalf("with repair: exit=%d stderr=%q", code, errOut.String())
+	}
+	if !strings.Contains(errOut.String(), "rebuilding from blockstore") {
+		t.Fatalf("stderr=%q", errOut.String())
+	}
+	repaired, err := node.LoadChainState(path)
+	if err != nil {
+		t.Fatalf("LoadChainState(repaired): %v", err)
+	}
+	if repaired.Height != clean.Height || repaired.TipHash != clean.TipHash || repaired.UtxoSetHash() != clean.UtxoSetHash() {
+		t.Fatalf("repaired chainstate height=%d tip=%x utxo_set_hash=%x, want height=%d tip=%x utxo_set_hash=%x",
+			repaired.Height, repaired.TipHash, repaired.UtxoSetHash(), clean.Height, clean.TipHash, clean.UtxoSetHash())
+	}
+	if repaired.AlreadyGenerated != clean.AlreadyGenerated {
+		t.Fatalf("already_generated=%d, want %d", repaired.AlreadyGenerated, clean.AlreadyGenerated)
+	}
+}
+
 func TestRunDryRunOK(t *testing.T) {
 	dir := t.TempDir()
 	var out bytes.Buffer
@@ -318,6 +359,94 @@ func TestRunDryRunOK(t *testing.T) {
 	}
 }
 
+func TestRunConfigFileMergesUnderFlags(t *testing.T) {
+	dataDir := filepath.Join(t.TempDir(), "from-file")
+	configPath := filepath.Join(t.TempDir(), "node.json")
+	body := fmt.Sprintf(`{"network":"testnet","data_dir":%q,"max_peers":9}`, dataDir)
+	if err := os.WriteFile(configPath, []byte(body), 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+
+	// The file alone selects testnet, which needs a genesis file.
+	var out, errOut bytes.Buffer
+	if code := run([]string{"--config", configPath, "--dry-run"}, &out, &errOut); code != 2 {
+		t.Fatalf("expected exit code 2, got %d (stderr=%q)", code, errOut.String())
+	}
+	if !strings.Contains(errOut.String(), "--network testnet requires a genesis file") {
+		t.Fatalf("file network not applied: stderr=%q", errOut.String())
+	}
+
+	out.Reset()
+	errOut.Reset()
+	if code := run([]string{"--config=" + configPath, "--network", "devnet", "--dry-run"}, &out, &errOut); code != 0 {
+		t.Fatalf("expected exit code 0, got %d (stderr=%q)", code, errOut.String())
+	}
+	var got node.Config
+	if err := json.NewDecoder(&out).Decode(&got); err != nil {
+		t.Fatalf("decode effective config: %v (stdout=%q)", err, out.String())
+	}
+	if got.Network != "devnet" || got.DataDir != dataDir || got.MaxPeers != 9 {
+		t.Fatalf("merged config=%+v", got)
+	}
+	if _, err := os.Stat(node.ChainStatePath(dataDir)); err != nil {
+		t.Fatalf("expected chainstate in file datadir: %v", err)
+	}
+}
+
+func TestRunConfigPrecedenceFileEnvFlags(t *testing.T) {
+	fileDir := filepath.Join(t.TempDir(), "from-file")
+	envDir := filepath.Join(t.TempDir(), "from-env")
+	configPath := filepath.Join(t.TempDir(), "node.json")
+	body := fmt.Sprintf(`{"data_dir":%q,"max_peers":9}`, fileDir)
+	if err := os.WriteFile(configPath, []byte(body), 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	env := map[string]string{"RUBIN_DATADIR": envDir, "RUBIN_MAX_PEERS": "12"}
+	prev := lookupEnvFn
+	lookupEnvFn = func(name string) (string, bool) {
+		v, ok := env[name]
+		return v, ok
+	}
+	t.Cleanup(func() { lookupEnvFn = prev })
+
+	var out, errOut bytes.Buffer
+	if code := run([]string{"--config", configPath, "--max-peers", "3", "--dry-run"}, &out, &errOut); code != 0 {
+		t.Fatalf("expected exit code 0, got %d (stderr=%q)", code, errOut.String())
+	}
+	var got node.Config
+	if err := json.NewDecoder(&out).Decode(&got); err != nil {
+		t.Fatalf("decode effective config: %v (stdout=%q)", err, out.String())
+	}
+	// env beats the file's data_dir; the flag beats env's max peers.
+	if got.DataDir != envDir || got.MaxPeers != 3 {
+		t.Fatalf("merged config=%+v", got)
+	}
+
+	env["RUBIN_MAX_PEERS"] = "many"
+	out.Reset()
+	errOut.Reset()
+	if code := run([]string{"--config", configPath, "--dry-run"}, &out, &errOut); code != 2 {
+		t.Fatalf("expected exit code 2, got %d", code)
+	}
+	if !strings.Contains(errOut.String(), "invalid config: RUBIN_MAX_PEERS") {
+		t.Fatalf("stderr=%q", errOut.String())
+	}
+}
+
+func TestRunRejectsInvalidConfigFile(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "node.json")
+	if err := os.WriteFile(configPath, []byte(`{"max_peer":1}`), 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	var out, errOut bytes.Buffer
+	if code := run([]string{"--config", configPath, "--dry-run", "--datadir", t.TempDir()}, &out, &errOut); code != 2 {
+		t.Fatalf("expected exit code 2, got %d", code)
+	}
+	if !strings.HasPrefix(errOut.String(), "invalid config:") {
+		t.Fatalf("stderr=%q", errOut.String())
+	}
+}
+
 func symlinkTraversalDataDir(t *testing.T) (raw string, cleaned string, escaped string) {
 	t.Helper()
 	root := t.TempDir()
@@ -1389,6 +1518,24 @@ func TestParseGenesisConfigDerivesHashFromHeaderFallback(t *testing.T) {
 	}
 }
 
+func TestParseGenesisConfigReadsGenesisCoinbaseValue(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "genesis.json")
+	if err := os.WriteFile(path, []byte(`{"chain_id_hex":"0x88f8a9acdeeb902e27aa2fdcb8c46ecf818bf68dec5273ec1bcc5084e2333103","genesis_hash_hex":"0x8d48b863805b96e5fcb79ee9652cd6257ae352b2f52088af921212039f9e8aff","genesis_coinbase_value":5000}`), 0o600); err != nil {
+		t.Fatalf("write genesis file: %v", err)
+	}
+	cfg, err := parseGenesisConfigFull(path)
+	if err != nil {
+		t.Fatalf("parseGenesisConfigFull: %v", err)
+	}
+	if cfg.GenesisCoinbaseValue == nil || *cfg.GenesisCoinbaseValue != 5000 {
+		t.Fatalf("genesis_coinbase_value=%v, want 5000", cfg.GenesisCoinbaseValue)
+	}
+	if cfg, err := parseGenesisConfigFull(""); err != nil || cfg.GenesisCoinbaseValue != nil {
+		t.Fatalf("devnet default: value=%v err=%v", cfg.GenesisCoinbaseValue, err)
+	}
+}
+
 func TestParseGenesisConfigRejectsMissingGenesisHash(t *testing.T) {
 	dir := t.TempDir()
 	path := filepath.Join(dir, "genesis.json")
@@ -1744,6 +1891,70 @@ func TestRunMineBlocksExitOK(t *testing.T) {
 	}
 }
 
+func TestRunWritesPprofProfiles(t *testing.T) {
+	dir := t.TempDir()
+	cpuPath := filepath.Join(t.TempDir(), "cpu.pprof")
+	memPath := filepath.Join(t.TempDir(), "mem.pprof")
+	var out bytes.Buffer
+	var errOut bytes.Buffer
+	code := run(
+		[]string{"--datadir", dir, "--mine-blocks", "1", "--mine-exit", "--cpuprofile", cpuPath, "--memprofile", memPath},
+		&out,
+		&errOut,
+	)
+	if code != 0 {
+		t.Fatalf("expected exit code 0, got %d (stderr=%q)", code, errOut.String())
+	}
+	for _, path := range []string{cpuPath, memPath} {
+		info, err := os.Stat(path)
+		if err != nil {
+			t.Fatalf("stat %s: %v", path, err)
+		}
+		if info.Size() == 0 {
+			t.Fatalf("%s is empty", path)
+		}
+	}
+}
+
+func TestRunWritesPprofProfilesForSubcommand(t *testing.T) {
+	headerHex, txHex := devnetProfileHex()
+	profile := writeProfile(t, `{"genesis_header_bytes_hex":"`+headerHex+`","genesis_tx_bytes_hex":"`+txHex+`"}`)
+	cpuPath := filepath.Join(t.TempDir(), "cpu.pprof")
+	memPath := filepath.Join(t.TempDir(), "mem.pprof")
+	var out bytes.Buffer
+	var errOut bytes.Buffer
+	code := run(
+		[]string{"--cpuprofile", cpuPath, "--memprofile", memPath, "--output", "json", profileValidateCommand, "--profile", profile},
+		&out,
+		&errOut,
+	)
+	if code != 0 {
+		t.Fatalf("expected exit code 0, got %d (stderr=%q)", code, errOut.String())
+	}
+	for _, path := range []string{cpuPath, memPath} {
+		info, err := os.Stat(path)
+		if err != nil {
+			t.Fatalf("stat %s: %v", path, err)
+		}
+		if info.Size() == 0 {
+			t.Fatalf("%s is empty", path)
+		}
+	}
+}
+
+func TestRunRejectsUnwritableProfilePath(t *testing.T) {
+	var out bytes.Buffer
+	var errOut bytes.Buffer
+	bad := filepath.Join(t.TempDir(), "missing", "cpu.pprof")
+	code := run([]string{"--dry-run", "--datadir", t.TempDir(), "--cpuprofile", bad}, &out, &errOut)
+	if code != 2 {
+		t.Fatalf("expected exit code 2, got %d", code)
+	}
+	if !strings.Contains(errOut.String(), "profiling failed: cpuprofile:") {
+		t.Fatalf("unexpected stderr: %q", errOut.String())
+	}
+}
+
 func TestRunMineBlocksResetsDirtyChainStateWhenBlockstoreEmpty(t *testing.T) {
 	dir := t.TempDir()
 	chainState := node.NewChainState()
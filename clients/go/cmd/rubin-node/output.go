@@ -0,0 +1,73 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// outputFormat selects how a subcommand prints its result: the historical
+// one-line text summary, or a single JSON object.
+type outputFormat string
+
+const (
+	outputText outputFormat = "text"
+	outputJSON outputFormat = "json"
+)
+
+// splitOutputFlag strips a global --output flag placed ahead of a
+// subcommand (rubin-node --output json status ...). It only applies to
+// subcommands: without one, args go to the node flags untouched, and an
+// --output that is not followed by a subcommand is rejected. decode-tx,
+// decode-block and verify-batch always print JSON and accept either value.
+func splitOutputFlag(args []string) (outputFormat, []string, error) {
+	if len(args) == 0 {
+		return outputText, args, nil
+	}
+	name, value, hasValue := strings.Cut(strings.TrimLeft(args[0], "-"), "=")
+	if !strings.HasPrefix(args[0], "-") || name != "output" {
+		return outputText, args, nil
+	}
+	rest := args[1:]
+	if !hasValue {
+		if len(rest) == 0 {
+			return "", nil, fmt.Errorf("--output requires a value (text or json)")
+		}
+		value, rest = rest[0], rest[1:]
+	}
+	format := outputFormat(value)
+	if format != outputText && format != outputJSON {
+		return "", nil, fmt.Errorf("invalid --output %q: want text or json", value)
+	}
+	if len(rest) == 0 || !isSubcommand(rest[0]) {
+		return "", nil, fmt.Errorf("--output must be followed by a subcommand")
+	}
+	return format, rest, nil
+}
+
+// isOutputFlag reports whether arg is the global --output flag, in any of
+// the -output, --output or --output=value forms.
+func isOutputFlag(arg string) bool {
+	name, _, _ := strings.Cut(strings.TrimLeft(arg, "-"), "=")
+	return strings.HasPrefix(arg, "-") && name == "output"
+}
+
+func isSubcommand(name string) bool {
+	switch name {
+	case profileValidateCommand, invalidateBlockCommand, reconsiderBlockCommand, rewindCommand,
+		benchValidateCommand, statusCommand, decodeTxCommand, decodeBlockCommand, verifyBatchCommand:
+		return true
+	}
+	return false
+}
+
+// writeResult prints a subcommand's result: text verbatim in text mode, v as
+// one JSON line in JSON mode.
+func writeResult(stdout io.Writer, format outputFormat, text string, v any) {
+	if format == outputJSON {
+		_ = json.NewEncoder(stdout).Encode(v)
+		return
+	}
+	_, _ = io.WriteString(stdout, text)
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/2tbmz9y2xt-lang/rubin-protocol/clients/go/node"
+)
+
+func TestSplitOutputFlag(t *testing.T) {
+	cases := []struct {
+		args    []string
+		format  outputFormat
+		rest    []string
+		wantErr string
+	}{
+		{args: nil, format: outputText},
+		{args: []string{"--datadir", "x"}, format: outputText, rest: []string{"--datadir", "x"}},
+		{args: []string{statusCommand}, format: outputText, rest: []string{statusCommand}},
+		{args: []string{"--output", "json", statusCommand}, format: outputJSON, rest: []string{statusCommand}},
+		{args: []string{"-output=text", rewindCommand, "--to-height", "1"}, format: outputText, rest: []string{rewindCommand, "--to-height", "1"}},
+		{args: []string{"--output"}, wantErr: "requires a value"},
+		{args: []string{"--output", "yaml", statusCommand}, wantErr: "want text or json"},
+		{args: []string{"--output", "json", "--datadir", "x"}, wantErr: "followed by a subcommand"},
+	}
+	for _, tc := range cases {
+		format, rest, err := splitOutputFlag(tc.args)
+		if tc.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("%v: err=%v, want %q", tc.args, err, tc.wantErr)
+			}
+			continue
+		}
+		if err != nil || format != tc.format || (len(rest) != 0 || len(tc.rest) != 0) && !reflect.DeepEqual(rest, tc.rest) {
+			t.Fatalf("%v: format=%q rest=%v err=%v", tc.args, format, rest, err)
+		}
+	}
+}
+
+func TestRunOutputJSONProfileValidate(t *testing.T) {
+	headerHex, txHex := devnetProfileHex()
+	path := writeProfile(t, `{"genesis_header_bytes_hex":"`+headerHex+`","genesis_tx_bytes_hex":"`+txHex+`"}`)
+
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{"--output", "json", profileValidateCommand, "--profile", path}, &stdout, &stderr); code != 0 {
+		t.Fatalf("exit=%d stderr=%q", code, stderr.String())
+	}
+	var got profileValidateResult
+	if err := json.Unmarshal(stdout.Bytes(), &got); err != nil {
+		t.Fatalf("decode %q: %v", stdout.String(), err)
+	}
+	chainID := node.DevnetGenesisChainID()
+	if got.ChainIDHex != hex.EncodeToString(chainID[:]) || got.GenesisBlockBytes != len(node.DevnetGenesisBlockBytes()) {
+		t.Fatalf("got %+v", got)
+	}
+}
+
+func TestRunOutputJSONStatus(t *testing.T) {
+	dir := t.TempDir()
+	hashes := mustMaintenanceChain(t, dir, 2)
+
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{"--output=json", statusCommand, "--datadir", dir}, &stdout, &stderr); code != 0 {
+		t.Fatalf("exit=%d stderr=%q", code, stderr.String())
+	}
+	var got statusResult
+	if err := json.Unmarshal(stdout.Bytes(), &got); err != nil {
+		t.Fatalf("decode %q: %v", stdout.String(), err)
+	}
+	if got.TipHeight == nil || *got.TipHeight != 2 || got.TipHashHex != hex.EncodeToString(hashes[2][:]) || !strings.HasPrefix(got.CumulativeWorkHex, "0x") {
+		t.Fatalf("got %s", stdout.String())
+	}
+}
+
+func TestRunOutputJSONRewind(t *testing.T) {
+	dir := t.TempDir()
+	hashes := mustMaintenanceChain(t, dir, 3)
+
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{"--output", "json", rewindCommand, "--datadir", dir, "--to-height", "1"}, &stdout, &stderr); code != 0 {
+		t.Fatalf("exit=%d stderr=%q", code, stderr.String())
+	}
+	var got maintenanceResult
+	if err := json.Unmarshal(stdout.Bytes(), &got); err != nil {
+		t.Fatalf("decode %q: %v", stdout.String(), err)
+	}
+	if got.TipHeight != 1 || got.TipHashHex != hex.EncodeToString(hashes[1][:]) || len(got.UtxoSetHashHex) != 64 {
+		t.Fatalf("got %+v", got)
+	}
+}
+
+func TestSplitProfileFlags(t *testing.T) {
+	cases := []struct {
+		args     []string
+		cpu, mem string
+		rest     []string
+	}{
+		{args: []string{"--cpuprofile", "c", benchValidateCommand, "--blocks", "2"}, cpu: "c", rest: []string{benchValidateCommand, "--blocks", "2"}},
+		{args: []string{"-memprofile=m", "-cpuprofile=c", "--output", "json", statusCommand}, cpu: "c", mem: "m", rest: []string{"--output", "json", statusCommand}},
+		// Without a subcommand the daemon flag set parses them itself.
+		{args: []string{"--cpuprofile", "c", "--dry-run"}, rest: []string{"--cpuprofile", "c", "--dry-run"}},
+		{args: []string{"--cpuprofile"}, rest: []string{"--cpuprofile"}},
+		{args: []string{statusCommand, "--cpuprofile", "c"}, rest: []string{statusCommand, "--cpuprofile", "c"}},
+	}
+	for _, tc := range cases {
+		cpu, mem, rest := splitProfileFlags(tc.args)
+		if cpu != tc.cpu || mem != tc.mem || !reflect.DeepEqual(rest, tc.rest) {
+			t.Fatalf("%v: cpu=%q mem=%q rest=%v", tc.args, cpu, mem, rest)
+		}
+	}
+}
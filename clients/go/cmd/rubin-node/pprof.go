@@ -0,0 +1,84 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"runtime/pprof"
+	"strings"
+)
+
+// splitProfileFlags strips global -cpuprofile/-memprofile flags placed
+// ahead of a subcommand (or ahead of --output and a subcommand), which
+// parses its own flags and would reject them. Anything else is returned
+// untouched for the daemon flag set, which registers the same flags.
+func splitProfileFlags(args []string) (cpuPath, memPath string, rest []string) {
+	rest = args
+	for len(rest) > 0 && strings.HasPrefix(rest[0], "-") {
+		name, value, hasValue := strings.Cut(strings.TrimLeft(rest[0], "-"), "=")
+		if name != "cpuprofile" && name != "memprofile" {
+			break
+		}
+		next := rest[1:]
+		if !hasValue {
+			if len(next) == 0 {
+				return "", "", args
+			}
+			value, next = next[0], next[1:]
+		}
+		if name == "cpuprofile" {
+			cpuPath = value
+		} else {
+			memPath = value
+		}
+		rest = next
+	}
+	if len(rest) == 0 || !isSubcommand(rest[0]) && !isOutputFlag(rest[0]) {
+		return "", "", args
+	}
+	return cpuPath, memPath, rest
+}
+
+// startPprof begins CPU profiling to cpuPath and arranges a heap profile to
+// memPath; either path may be empty. The returned stop func writes the
+// profiles and must run once the node's main operation has finished.
+func startPprof(cpuPath, memPath string) (func() error, error) {
+	cpuPath = strings.TrimSpace(cpuPath)
+	memPath = strings.TrimSpace(memPath)
+	var cpuFile *os.File
+	if cpuPath != "" {
+		f, err := os.Create(cpuPath)
+		if err != nil {
+			return nil, fmt.Errorf("cpuprofile: %w", err)
+		}
+		if err := pprof.StartCPUProfile(f); err != nil {
+			_ = f.Close()
+			return nil, fmt.Errorf("cpuprofile: %w", err)
+		}
+		cpuFile = f
+	}
+	return func() error {
+		if cpuFile != nil {
+			pprof.StopCPUProfile()
+			if err := cpuFile.Close(); err != nil {
+				return fmt.Errorf("cpuprofile: %w", err)
+			}
+		}
+		if memPath == "" {
+			return nil
+		}
+		f, err := os.Create(memPath)
+		if err != nil {
+			return fmt.Errorf("memprofile: %w", err)
+		}
+		runtime.GC() // materialize up-to-date allocation statistics
+		if err := pprof.WriteHeapProfile(f); err != nil {
+			_ = f.Close()
+			return fmt.Errorf("memprofile: %w", err)
+		}
+		if err := f.Close(); err != nil {
+			return fmt.Errorf("memprofile: %w", err)
+		}
+		return nil
+	}, nil
+}
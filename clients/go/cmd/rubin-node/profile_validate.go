@@ -0,0 +1,212 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/2tbmz9y2xt-lang/rubin-protocol/clients/go/consensus"
+	"github.com/2tbmz9y2xt-lang/rubin-protocol/clients/go/node"
+)
+
+const profileValidateCommand = "profile-validate"
+
+type chainProfile struct {
+	ChainIDHex            string `json:"chain_id_hex"`
+	GenesisHashHex        string `json:"genesis_hash_hex"`
+	GenesisHeaderBytesHex string `json:"genesis_header_bytes_hex"`
+	GenesisTxBytesHex     string `json:"genesis_tx_bytes_hex"`
+	// GenesisTxCount switches to numbered genesis_tx_bytes_<i>_hex keys
+	// (i = 0..count-1, in block order) for multi-transaction genesis blocks.
+	GenesisTxCount *uint64 `json:"genesis_tx_count"`
+}
+
+type profileField struct {
+	name  string
+	value string
+}
+
+// profileValidateResult is the --output json form of a valid profile.
+type profileValidateResult struct {
+	ChainIDHex        string `json:"chain_id_hex"`
+	GenesisHashHex    string `json:"genesis_hash_hex"`
+	GenesisBlockBytes int    `json:"genesis_block_bytes"`
+}
+
+type profileReport struct {
+	ChainID      [32]byte
+	GenesisHash  [32]byte
+	GenesisBlock []byte
+}
+
+// runProfileValidate checks a chain-instance profile before it is used to
+// boot a node. Every problem found is reported, not just the first.
+func runProfileValidate(args []string, output outputFormat, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("rubin-node "+profileValidateCommand, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	profilePath := fs.String("profile", "", "path to chain-instance profile JSON")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if strings.TrimSpace(*profilePath) == "" {
+		_, _ = fmt.Fprintln(stderr, "profile-validate: --profile is required")
+		return 2
+	}
+	raw, err := os.ReadFile(filepath.Clean(*profilePath))
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "profile-validate: %v\n", err)
+		return 2
+	}
+	report, problems := validateChainProfile(raw)
+	if len(problems) != 0 {
+		for _, problem := range problems {
+			_, _ = fmt.Fprintf(stderr, "profile invalid: %s\n", problem)
+		}
+		return 1
+	}
+	writeResult(stdout, output,
+		fmt.Sprintf("profile ok: chain_id=%x genesis_hash=%x genesis_block_bytes=%d\n", report.ChainID, report.GenesisHash, len(report.GenesisBlock)),
+		profileValidateResult{
+			ChainIDHex:        hex.EncodeToString(report.ChainID[:]),
+			GenesisHashHex:    hex.EncodeToString(report.GenesisHash[:]),
+			GenesisBlockBytes: len(report.GenesisBlock),
+		})
+	return 0
+}
+
+func validateChainProfile(raw []byte) (profileReport, []string) {
+	var report profileReport
+	var profile chainProfile
+	if err := json.Unmarshal(raw, &profile); err != nil {
+		return report, []string{fmt.Sprintf("decode profile: %v", err)}
+	}
+
+	var problems []string
+	headerBytes, err := decodeProfileHexField("genesis_header_bytes_hex", profile.GenesisHeaderBytesHex)
+	if err != nil {
+		problems = append(problems, err.Error())
+	} else if len(headerBytes) != consensus.BLOCK_HEADER_BYTES {
+		problems = append(problems, fmt.Sprintf("genesis_header_bytes_hex: must be %d bytes, got %d", consensus.BLOCK_HEADER_BYTES, len(headerBytes)))
+		headerBytes = nil
+	}
+
+	txFields, err := genesisTxFields(raw, profile)
+	if err != nil {
+		return report, append(problems, err.Error())
+	}
+	txs := make([][]byte, 0, len(txFields))
+	txids := make([][32]byte, 0, len(txFields))
+	for _, field := range txFields {
+		txBytes, err := decodeProfileHexField(field.name, field.value)
+		if err != nil {
+			problems = append(problems, err.Error())
+			continue
+		}
+		_, txid, _, consumed, err := consensus.ParseTx(txBytes)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%s: unparseable tx: %v", field.name, err))
+			continue
+		}
+		if consumed != len(txBytes) {
+			problems = append(problems, fmt.Sprintf("%s: %d trailing bytes after tx", field.name, len(txBytes)-consumed))
+			continue
+		}
+		txs = append(txs, txBytes)
+		txids = append(txids, txid)
+	}
+
+	if headerBytes == nil || len(txs) != len(txFields) {
+		return report, problems
+	}
+
+	header, err := consensus.ParseBlockHeaderBytes(headerBytes)
+	if err != nil {
+		return report, append(problems, fmt.Sprintf("genesis_header_bytes_hex: %v", err))
+	}
+	root, err := consensus.MerkleRootTxids(txids)
+	if err != nil {
+		return report, append(problems, fmt.Sprintf("genesis merkle root: %v", err))
+	}
+	if root != header.MerkleRoot {
+		problems = append(problems, fmt.Sprintf("genesis header merkle_root %x does not commit to genesis txs (want %x)", header.MerkleRoot, root))
+	}
+
+	report.ChainID = node.DeriveGenesisChainID(headerBytes, txs...)
+	report.GenesisBlock = node.AssembleGenesisBlockBytes(headerBytes, txs...)
+	report.GenesisHash, err = consensus.BlockHash(headerBytes)
+	if err != nil {
+		return report, append(problems, fmt.Sprintf("genesis hash: %v", err))
+	}
+	problems = appendProfileHashMismatch(problems, "chain_id_hex", profile.ChainIDHex, report.ChainID)
+	problems = appendProfileHashMismatch(problems, "genesis_hash_hex", profile.GenesisHashHex, report.GenesisHash)
+	return report, problems
+}
+
+// genesisTxFields lists the genesis tx hex fields in block order: the single
+// genesis_tx_bytes_hex key, or genesis_tx_bytes_<i>_hex when genesis_tx_count
+// is set.
+func genesisTxFields(raw []byte, profile chainProfile) ([]profileField, error) {
+	if profile.GenesisTxCount == nil {
+		return []profileField{{name: "genesis_tx_bytes_hex", value: profile.GenesisTxBytesHex}}, nil
+	}
+	var keys map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &keys); err != nil {
+		return nil, fmt.Errorf("decode profile: %v", err)
+	}
+	count := *profile.GenesisTxCount
+	if count == 0 {
+		return nil, fmt.Errorf("genesis_tx_count: must be at least 1")
+	}
+	// Each tx needs its own key, so the object size bounds any honest count.
+	if count > uint64(len(keys)) {
+		return nil, fmt.Errorf("genesis_tx_count: %d exceeds the number of profile keys", count)
+	}
+	if _, ok := keys["genesis_tx_bytes_hex"]; ok {
+		return nil, fmt.Errorf("genesis_tx_bytes_hex: not allowed with genesis_tx_count; use genesis_tx_bytes_<i>_hex")
+	}
+	fields := make([]profileField, 0, count)
+	for i := uint64(0); i < count; i++ {
+		name := fmt.Sprintf("genesis_tx_bytes_%d_hex", i)
+		var value string
+		if rawValue, ok := keys[name]; ok {
+			if err := json.Unmarshal(rawValue, &value); err != nil {
+				return nil, fmt.Errorf("%s: %v", name, err)
+			}
+		}
+		fields = append(fields, profileField{name: name, value: value})
+	}
+	return fields, nil
+}
+
+func decodeProfileHexField(name, value string) ([]byte, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, fmt.Errorf("%s: missing", name)
+	}
+	out, err := hex.DecodeString(trimHexPrefix(value))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return out, nil
+}
+
+// appendProfileHashMismatch cross-checks an optional pinned hash against the
+// value derived from the genesis bytes.
+func appendProfileHashMismatch(problems []string, name, value string, derived [32]byte) []string {
+	if strings.TrimSpace(value) == "" {
+		return problems
+	}
+	pinned, err := parseHex32Field(strings.TrimSuffix(name, "_hex"), value)
+	if err != nil {
+		return append(problems, err.Error())
+	}
+	if pinned != derived {
+		return append(problems, fmt.Sprintf("%s %x does not match derived %x", name, pinned, derived))
+	}
+	return problems
+}
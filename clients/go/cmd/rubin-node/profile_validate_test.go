@@ -0,0 +1,155 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha3"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/2tbmz9y2xt-lang/rubin-protocol/clients/go/consensus"
+	"github.com/2tbmz9y2xt-lang/rubin-protocol/clients/go/node"
+)
+
+func devnetProfileHex() (headerHex, txHex string) {
+	block := node.DevnetGenesisBlockBytes()
+	header := block[:consensus.BLOCK_HEADER_BYTES]
+	tx := block[consensus.BLOCK_HEADER_BYTES+1:] // skip CompactSize(1) tx_count
+	return hex.EncodeToString(header), hex.EncodeToString(tx)
+}
+
+func writeProfile(t *testing.T, body string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "profile.json")
+	if err := os.WriteFile(path, []byte(body), 0o600); err != nil {
+		t.Fatalf("write profile: %v", err)
+	}
+	return path
+}
+
+func TestRunProfileValidateAcceptsDevnetProfile(t *testing.T) {
+	headerHex, txHex := devnetProfileHex()
+	chainID := node.DevnetGenesisChainID()
+	path := writeProfile(t, `{"chain_id_hex":"`+hex.EncodeToString(chainID[:])+`","genesis_header_bytes_hex":"`+headerHex+`","genesis_tx_bytes_hex":"`+txHex+`"}`)
+
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{"profile-validate", "--profile", path}, &stdout, &stderr); code != 0 {
+		t.Fatalf("exit=%d stderr=%q", code, stderr.String())
+	}
+	genesisHash := node.DevnetGenesisBlockHash()
+	want := fmt.Sprintf("profile ok: chain_id=%x genesis_hash=%x genesis_block_bytes=%d\n", chainID, genesisHash, len(node.DevnetGenesisBlockBytes()))
+	if stdout.String() != want {
+		t.Fatalf("stdout=%q, want %q", stdout.String(), want)
+	}
+}
+
+func TestRunProfileValidateReportsMissingGenesisTx(t *testing.T) {
+	headerHex, _ := devnetProfileHex()
+	path := writeProfile(t, `{"genesis_header_bytes_hex":"`+headerHex+`"}`)
+
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{"profile-validate", "--profile", path}, &stdout, &stderr); code != 1 {
+		t.Fatalf("exit=%d, want 1", code)
+	}
+	if got, want := stderr.String(), "profile invalid: genesis_tx_bytes_hex: missing\n"; got != want {
+		t.Fatalf("stderr=%q, want %q", got, want)
+	}
+	if stdout.Len() != 0 {
+		t.Fatalf("unexpected stdout: %q", stdout.String())
+	}
+}
+
+func TestValidateChainProfileReportsAllProblems(t *testing.T) {
+	headerHex, txHex := devnetProfileHex()
+	_, problems := validateChainProfile([]byte(`{"genesis_header_bytes_hex":"` + headerHex[:20] + `","genesis_tx_bytes_hex":"zz"}`))
+	if len(problems) != 2 ||
+		!strings.HasPrefix(problems[0], "genesis_header_bytes_hex: must be 116 bytes") ||
+		!strings.HasPrefix(problems[1], "genesis_tx_bytes_hex: ") {
+		t.Fatalf("problems=%q", problems)
+	}
+
+	// Flip a merkle_root byte: the header no longer commits to the tx and the
+	// pinned chain id no longer matches the derived one.
+	header, _ := hex.DecodeString(headerHex)
+	header[4+32] ^= 0x01
+	chainID := node.DevnetGenesisChainID()
+	_, problems = validateChainProfile([]byte(`{"chain_id_hex":"` + hex.EncodeToString(chainID[:]) + `","genesis_header_bytes_hex":"` + hex.EncodeToString(header) + `","genesis_tx_bytes_hex":"` + txHex + `"}`))
+	if len(problems) != 2 ||
+		!strings.Contains(problems[0], "does not commit to genesis txs") ||
+		!strings.HasPrefix(problems[1], "chain_id_hex ") {
+		t.Fatalf("problems=%q", problems)
+	}
+}
+
+func TestRunProfileValidateRequiresProfileFlag(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{"profile-validate"}, &stdout, &stderr); code != 2 {
+		t.Fatalf("exit=%d, want 2", code)
+	}
+	if !strings.Contains(stderr.String(), "--profile is required") {
+		t.Fatalf("stderr=%q", stderr.String())
+	}
+}
+
+func TestValidateChainProfileMultipleGenesisTxs(t *testing.T) {
+	headerHex, coinbaseHex := devnetProfileHex()
+	header, _ := hex.DecodeString(headerHex)
+	coinbase, _ := hex.DecodeString(coinbaseHex)
+	premine, err := consensus.MarshalTx(&consensus.Tx{
+		Version: 1,
+		TxNonce: 1,
+		Inputs:  []consensus.TxInput{{PrevTxid: [32]byte{0x01}, Sequence: 0}},
+		Outputs: []consensus.TxOutput{{Value: 7, CovenantType: consensus.COV_TYPE_ANCHOR, CovenantData: make([]byte, 32)}},
+	})
+	if err != nil {
+		t.Fatalf("MarshalTx: %v", err)
+	}
+	var txids [][32]byte
+	for _, tx := range [][]byte{coinbase, premine} {
+		_, txid, _, _, err := consensus.ParseTx(tx)
+		if err != nil {
+			t.Fatalf("ParseTx: %v", err)
+		}
+		txids = append(txids, txid)
+	}
+	root, err := consensus.MerkleRootTxids(txids)
+	if err != nil {
+		t.Fatalf("MerkleRootTxids: %v", err)
+	}
+	copy(header[4+32:4+32+32], root[:])
+
+	profile := `{"genesis_header_bytes_hex":"` + hex.EncodeToString(header) + `","genesis_tx_count":2,` +
+		`"genesis_tx_bytes_0_hex":"` + coinbaseHex + `","genesis_tx_bytes_1_hex":"` + hex.EncodeToString(premine) + `"}`
+	report, problems := validateChainProfile([]byte(profile))
+	if len(problems) != 0 {
+		t.Fatalf("problems=%q", problems)
+	}
+
+	wantBlock := append(append(append(append([]byte(nil), header...), 0x02), coinbase...), premine...)
+	if !bytes.Equal(report.GenesisBlock, wantBlock) {
+		t.Fatalf("genesis block mismatch")
+	}
+	parsed, err := consensus.ParseBlockBytes(report.GenesisBlock)
+	if err != nil || parsed.TxCount != 2 {
+		t.Fatalf("ParseBlockBytes: tx_count=%v err=%v", parsed, err)
+	}
+	preimage := append([]byte("RUBIN-GENESIS-v1"), wantBlock...)
+	if want := sha3.Sum256(preimage); report.ChainID != want {
+		t.Fatalf("chain_id=%x, want %x", report.ChainID, want)
+	}
+	if report.ChainID == node.DeriveGenesisChainID(header, coinbase) {
+		t.Fatalf("chain_id must commit to both genesis txs")
+	}
+
+	_, problems = validateChainProfile([]byte(`{"genesis_header_bytes_hex":"` + headerHex + `","genesis_tx_count":2,"genesis_tx_bytes_0_hex":"` + coinbaseHex + `"}`))
+	if len(problems) != 1 || problems[0] != "genesis_tx_bytes_1_hex: missing" {
+		t.Fatalf("problems=%q", problems)
+	}
+	_, problems = validateChainProfile([]byte(`{"genesis_tx_count":2,"genesis_tx_bytes_hex":"` + coinbaseHex + `","genesis_tx_bytes_0_hex":"` + coinbaseHex + `"}`))
+	if len(problems) != 2 || !strings.HasPrefix(problems[1], "genesis_tx_bytes_hex: not allowed with genesis_tx_count") {
+		t.Fatalf("problems=%q", problems)
+	}
+}
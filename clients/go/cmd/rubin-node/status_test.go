@@ -0,0 +1,49 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/2tbmz9y2xt-lang/rubin-protocol/clients/go/node"
+)
+
+func TestRunStatusReportsTipAndUtxoCount(t *testing.T) {
+	dir := t.TempDir()
+	hashes := mustMaintenanceChain(t, dir, 2)
+	chainState, err := node.LoadChainState(node.ChainStatePath(dir))
+	if err != nil {
+		t.Fatalf("LoadChainState: %v", err)
+	}
+	if len(chainState.Utxos) == 0 {
+		t.Fatalf("expected utxos after mining")
+	}
+
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{statusCommand, "--datadir", dir}, &stdout, &stderr); code != 0 {
+		t.Fatalf("status exit=%d stderr=%s", code, stderr.String())
+	}
+	want := fmt.Sprintf("status ok: tip_height=2 tip_hash=%x chainstate_height=2 utxos=%d cumulative_work=0x", hashes[2], len(chainState.Utxos))
+	if !strings.HasPrefix(stdout.String(), want) {
+		t.Fatalf("stdout=%q, want prefix %q", stdout.String(), want)
+	}
+	if !strings.HasSuffix(stdout.String(), " index_version=1 pruned_below_height=0\n") {
+		t.Fatalf("stdout=%q", stdout.String())
+	}
+}
+
+func TestRunStatusDoesNotCreateMissingDatadir(t *testing.T) {
+	dir := t.TempDir() + "/absent"
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{statusCommand, "--datadir", dir}, &stdout, &stderr); code != 2 {
+		t.Fatalf("status exit=%d, want 2", code)
+	}
+	if !strings.Contains(stderr.String(), "has no blockstore index") {
+		t.Fatalf("stderr=%q", stderr.String())
+	}
+	if _, err := os.Stat(dir); !os.IsNotExist(err) {
+		t.Fatalf("status created %s: %v", dir, err)
+	}
+}
@@ -0,0 +1,185 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/2tbmz9y2xt-lang/rubin-protocol/clients/go/consensus"
+)
+
+const verifyBatchCommand = "verify-batch"
+
+// verifyBatchContext is one entry of the --context-json array: an input of a
+// transaction, the prevout it spends and the chain context to check it
+// under. WitnessStart is the input's first witness item; it may be omitted
+// for input 0 only.
+type verifyBatchContext struct {
+	TxHex                  string `json:"tx_hex"`
+	InputIndex             uint32 `json:"input_index"`
+	InputValue             uint64 `json:"input_value"`
+	PrevoutCovenantType    uint16 `json:"prevout_covenant_type"`
+	PrevoutCovenantDataHex string `json:"prevout_covenant_data"`
+	WitnessStart           *int   `json:"witness_start"`
+	Height                 uint64 `json:"height"`
+	BlockMTP               uint64 `json:"block_mtp"`
+	ChainIDHex             string `json:"chain_id"`
+}
+
+type verifyBatchResult struct {
+	InputIndex uint32 `json:"input_index"`
+	Ok         bool   `json:"ok,omitempty"`
+	Err        string `json:"err,omitempty"`
+}
+
+// verifyBatchGroup collects the entries sharing a transaction and chain
+// context, which are checked against one parse and one sighash cache.
+type verifyBatchGroup struct {
+	tx        *consensus.Tx
+	parseErr  error
+	actx      consensus.InputAuthorizationContext
+	positions []int
+	inputs    []consensus.InputAuthorization
+}
+
+// runVerifyBatch checks the spend authorization of many inputs read from a
+// JSON file and prints one result per entry, in file order. It exits 1 if
+// any input fails.
+func runVerifyBatch(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("rubin-node "+verifyBatchCommand, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	contextPath := fs.String("context-json", "", "path to a JSON array of verification contexts")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if strings.TrimSpace(*contextPath) == "" {
+		_, _ = fmt.Fprintf(stderr, "%s: --context-json is required\n", verifyBatchCommand)
+		return 2
+	}
+	raw, err := os.ReadFile(filepath.Clean(*contextPath))
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "%s: %v\n", verifyBatchCommand, err)
+		return 2
+	}
+	var contexts []verifyBatchContext
+	if err := json.Unmarshal(raw, &contexts); err != nil {
+		_, _ = fmt.Fprintf(stderr, "%s: decode --context-json: %v\n", verifyBatchCommand, err)
+		return 2
+	}
+	groups, order, err := groupVerifyBatchContexts(contexts)
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "%s: %v\n", verifyBatchCommand, err)
+		return 2
+	}
+
+	results := make([]verifyBatchResult, len(contexts))
+	failed := false
+	for _, key := range order {
+		g := groups[key]
+		errs := make([]error, len(g.inputs))
+		if g.parseErr != nil {
+			for i := range errs {
+				errs[i] = g.parseErr
+			}
+		} else if errs, err = consensus.ValidateInputAuthorizations(g.tx, g.inputs, g.actx); err != nil {
+			errs = make([]error, len(g.inputs))
+			for i := range errs {
+				errs[i] = err
+			}
+		}
+		for i, pos := range g.positions {
+			results[pos] = verifyBatchResult{InputIndex: g.inputs[i].InputIndex, Ok: errs[i] == nil}
+			if errs[i] != nil {
+				results[pos].Err = verifyBatchErrCode(errs[i])
+				failed = true
+			}
+		}
+	}
+	if code := writeDecodedJSON(verifyBatchCommand, results, stdout, stderr); code != 0 {
+		return code
+	}
+	if failed {
+		return 1
+	}
+	return 0
+}
+
+// groupVerifyBatchContexts parses each distinct transaction once and groups
+// entries by transaction and chain context, keeping first-seen group order.
+// A transaction that does not parse fails every entry that names it; a
+// malformed entry field is a usage error.
+func groupVerifyBatchContexts(contexts []verifyBatchContext) (map[string]*verifyBatchGroup, []string, error) {
+	groups := make(map[string]*verifyBatchGroup)
+	var order []string
+	for pos, c := range contexts {
+		covData, err := hex.DecodeString(c.PrevoutCovenantDataHex)
+		if err != nil {
+			return nil, nil, fmt.Errorf("entry %d: invalid prevout_covenant_data: %v", pos, err)
+		}
+		chainID, err := parseHex32Value(c.ChainIDHex)
+		if err != nil {
+			return nil, nil, fmt.Errorf("entry %d: invalid chain_id: %v", pos, err)
+		}
+		witnessStart := 0
+		switch {
+		case c.WitnessStart != nil:
+			witnessStart = *c.WitnessStart
+		case c.InputIndex != 0:
+			return nil, nil, fmt.Errorf("entry %d: witness_start is required for input_index %d", pos, c.InputIndex)
+		}
+		key := fmt.Sprintf("%s/%d/%d/%x", strings.ToLower(c.TxHex), c.Height, c.BlockMTP, chainID)
+		g, ok := groups[key]
+		if !ok {
+			g = &verifyBatchGroup{actx: consensus.InputAuthorizationContext{
+				Height:   c.Height,
+				BlockMTP: c.BlockMTP,
+				ChainID:  chainID,
+			}}
+			g.tx, g.parseErr = parseVerifyBatchTx(c.TxHex)
+			groups[key] = g
+			order = append(order, key)
+		}
+		g.positions = append(g.positions, pos)
+		g.inputs = append(g.inputs, consensus.InputAuthorization{
+			InputIndex:   c.InputIndex,
+			WitnessStart: witnessStart,
+			Entry: consensus.UtxoEntry{
+				Value:        c.InputValue,
+				CovenantType: c.PrevoutCovenantType,
+				CovenantData: covData,
+			},
+		})
+	}
+	return groups, order, nil
+}
+
+func parseVerifyBatchTx(txHex string) (*consensus.Tx, error) {
+	raw, err := hex.DecodeString(strings.TrimSpace(txHex))
+	if err != nil {
+		return nil, fmt.Errorf("%s: bad tx_hex: %v", consensus.TX_ERR_PARSE, err)
+	}
+	tx, _, _, n, err := consensus.ParseTx(raw)
+	if err != nil {
+		return nil, err
+	}
+	if n != len(raw) {
+		return nil, fmt.Errorf("%s: trailing bytes after tx", consensus.TX_ERR_PARSE)
+	}
+	return tx, nil
+}
+
+// verifyBatchErrCode reduces an error to its consensus code; errors raised
+// here rather than by consensus carry TX_ERR_PARSE.
+func verifyBatchErrCode(err error) string {
+	var txErr *consensus.TxError
+	if errors.As(err, &txErr) {
+		return string(txErr.Code)
+	}
+	return string(consensus.TX_ERR_PARSE)
+}
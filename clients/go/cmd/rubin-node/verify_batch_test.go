@@ -0,0 +1,127 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/2tbmz9y2xt-lang/rubin-protocol/clients/go/consensus"
+)
+
+// mustVerifyBatchTxHex builds a one-input tx whose ML-DSA witness cannot
+// match the all-zero P2PK key id, so the key binding check fails before any
+// signature verification.
+func mustVerifyBatchTxHex(t *testing.T) string {
+	t.Helper()
+	raw, err := consensus.MarshalTx(&consensus.Tx{
+		Version: 1,
+		TxKind:  0x00,
+		TxNonce: 1,
+		Inputs:  []consensus.TxInput{{PrevTxid: [32]byte{0x11}, PrevVout: 0}},
+		Outputs: []consensus.TxOutput{{Value: 1, CovenantType: consensus.COV_TYPE_P2PK, CovenantData: verifyBatchP2PKData()}},
+		Witness: []consensus.WitnessItem{{
+			SuiteID:   consensus.SUITE_ID_ML_DSA_87,
+			Pubkey:    make([]byte, consensus.ML_DSA_87_PUBKEY_BYTES),
+			Signature: make([]byte, consensus.ML_DSA_87_SIG_BYTES+1),
+		}},
+	})
+	if err != nil {
+		t.Fatalf("MarshalTx: %v", err)
+	}
+	return hex.EncodeToString(raw)
+}
+
+func verifyBatchP2PKData() []byte {
+	b := make([]byte, consensus.MAX_P2PK_COVENANT_DATA)
+	b[0] = consensus.SUITE_ID_ML_DSA_87
+	return b
+}
+
+func writeVerifyBatchContexts(t *testing.T, contexts []map[string]any) string {
+	t.Helper()
+	raw, err := json.Marshal(contexts)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "contexts.json")
+	if err := os.WriteFile(path, raw, 0o600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	return path
+}
+
+func TestRunVerifyBatchReportsPerInputCodes(t *testing.T) {
+	txHex := mustVerifyBatchTxHex(t)
+	chainID := strings.Repeat("00", 32)
+	entry := func(inputIndex int) map[string]any {
+		return map[string]any{
+			"tx_hex":                txHex,
+			"input_index":           inputIndex,
+			"input_value":           10,
+			"prevout_covenant_type": consensus.COV_TYPE_P2PK,
+			"prevout_covenant_data": hex.EncodeToString(verifyBatchP2PKData()),
+			"witness_start":         0,
+			"chain_id":              chainID,
+		}
+	}
+	unparsable := entry(0)
+	unparsable["tx_hex"] = txHex[:len(txHex)-2]
+	path := writeVerifyBatchContexts(t, []map[string]any{entry(0), entry(1), unparsable})
+
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{verifyBatchCommand, "--context-json", path}, &stdout, &stderr); code != 1 {
+		t.Fatalf("verify-batch exit=%d, want 1 (stderr=%s)", code, stderr.String())
+	}
+	var got []verifyBatchResult
+	if err := json.Unmarshal(stdout.Bytes(), &got); err != nil {
+		t.Fatalf("decode output: %v\n%s", err, stdout.String())
+	}
+	want := []verifyBatchResult{
+		{InputIndex: 0, Err: string(consensus.TX_ERR_SIG_INVALID)},
+		{InputIndex: 1, Err: string(consensus.TX_ERR_PARSE)},
+		{InputIndex: 0, Err: string(consensus.TX_ERR_PARSE)},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("results=%+v", got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("result %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestRunVerifyBatchEmptyBatchSucceeds(t *testing.T) {
+	path := writeVerifyBatchContexts(t, []map[string]any{})
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{verifyBatchCommand, "--context-json", path}, &stdout, &stderr); code != 0 {
+		t.Fatalf("verify-batch exit=%d stderr=%s", code, stderr.String())
+	}
+	if strings.TrimSpace(stdout.String()) != "[]" {
+		t.Fatalf("stdout=%q", stdout.String())
+	}
+}
+
+func TestRunVerifyBatchRejectsMalformedContext(t *testing.T) {
+	txHex := mustVerifyBatchTxHex(t)
+	chainID := strings.Repeat("00", 32)
+	for name, contexts := range map[string][]map[string]any{
+		"bad_chain_id":          {{"tx_hex": txHex, "chain_id": "00"}},
+		"bad_covenant_data":     {{"tx_hex": txHex, "chain_id": chainID, "prevout_covenant_data": "zz"}},
+		"missing_witness_start": {{"tx_hex": txHex, "chain_id": chainID, "input_index": 1}},
+	} {
+		path := writeVerifyBatchContexts(t, contexts)
+		var stdout, stderr bytes.Buffer
+		if code := run([]string{verifyBatchCommand, "--context-json", path}, &stdout, &stderr); code != 2 {
+			t.Fatalf("%s: exit=%d, want 2 (stderr=%s)", name, code, stderr.String())
+		}
+	}
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{verifyBatchCommand}, &stdout, &stderr); code != 2 {
+		t.Fatalf("missing --context-json: exit=%d, want 2", code)
+	}
+}
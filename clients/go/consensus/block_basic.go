@@ -32,6 +32,7 @@ type blockTxStats struct {
 	sumWeight uint64
 	sumDa     uint64
 	sumAnchor uint64
+	sumVerify uint64
 }
 
 func isCoinbasePrevout(in TxInput) bool {
@@ -65,6 +66,17 @@ func isCoinbaseTxInput(in TxInput) bool {
 	return isCoinbasePrevout(in) && len(in.ScriptSig) == 0 && in.Sequence == ^uint32(0)
 }
 
+// CheckBlockSerializedSize rejects block bytes above MAX_BLOCK_BYTES so an
+// import path can refuse them before ParseBlockBytes does any work. Every
+// serialized tx byte carries at least one unit of weight, so such a block
+// always exceeds MAX_BLOCK_WEIGHT and the early reject is consensus-neutral.
+func CheckBlockSerializedSize(b []byte) error {
+	if len(b) > MAX_BLOCK_BYTES {
+		return txerr(BLOCK_ERR_PARSE, "block exceeds MAX_BLOCK_BYTES")
+	}
+	return nil
+}
+
 func ParseBlockBytes(b []byte) (*ParsedBlock, error) {
 	if len(b) < BLOCK_HEADER_BYTES+1 {
 		return nil, txerr(BLOCK_ERR_PARSE, "block too short")
@@ -84,6 +96,12 @@ func ParseBlockBytes(b []byte) (*ParsedBlock, error) {
 	if txCount == 0 {
 		return nil, txerr(BLOCK_ERR_COINBASE_INVALID, "empty block tx list")
 	}
+	if txCount > MAX_BLOCK_TX_COUNT {
+		return nil, txerr(BLOCK_ERR_PARSE, "tx_count exceeds MAX_BLOCK_TX_COUNT")
+	}
+	if txCount > uint64(len(b)-off)/minTxBytes {
+		return nil, txerr(BLOCK_ERR_PARSE, "tx_count exceeds remaining bytes")
+	}
 
 	txs := make([]*Tx, 0)
 	txids := make([][32]byte, 0)
@@ -112,6 +130,34 @@ func ParseBlockBytes(b []byte) (*ParsedBlock, error) {
 	}, nil
 }
 
+// MarshalBlock serializes a ParsedBlock back into its wire-format bytes:
+// header || CompactSize(tx_count) || txs. It is the inverse of ParseBlockBytes.
+func MarshalBlock(pb *ParsedBlock) ([]byte, error) {
+	if pb == nil {
+		return nil, txerr(BLOCK_ERR_PARSE, "nil block")
+	}
+	if len(pb.HeaderBytes) != BLOCK_HEADER_BYTES {
+		return nil, txerr(BLOCK_ERR_PARSE, "invalid block header length")
+	}
+	if pb.TxCount != uint64(len(pb.Txs)) {
+		return nil, txerr(BLOCK_ERR_PARSE, "tx_count mismatch")
+	}
+	out := append([]byte(nil), pb.HeaderBytes...)
+	out = AppendCompactSize(out, pb.TxCount)
+	for _, tx := range pb.Txs {
+		txBytes, err := MarshalTx(tx)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, txBytes...)
+	}
+	return out, nil
+}
+
+// minTxBytes is the wire size of the smallest parseable tx: no inputs,
+// outputs, witness items or DA payload.
+const minTxBytes = 21
+
 // parseBlockTx parses a single transaction from b at the given offset,
 // advances off past the consumed bytes, and returns the parsed tx.
 func parseBlockTx(b []byte, off *int) (*Tx, [32]byte, [32]byte, int, error) {
@@ -213,7 +259,11 @@ func validateParsedBlockBasicWithContextAtHeight(
 }
 
 // validateParsedBlockChecks runs all basic block validation checks and returns
-// the block hash and resource stats on success.
+// the block hash and resource stats on success. The first failing check
+// decides the error code, so the checks run exactly in BlockValidationOrder
+// (steps "target_range" through "tx"). All of it runs before the connect path
+// applies any transaction to the UTXO set or verifies a signature, so a
+// misplaced coinbase never reaches that work.
 func validateParsedBlockChecks(
 	pb *ParsedBlock,
 	expectedPrevHash *[32]byte,
@@ -298,7 +348,7 @@ func ValidateBlockBasicWithContextAndFeesAtHeightAndRotation(blockBytes []byte,
 	if err != nil {
 		return nil, err
 	}
-	if err := validateCoinbaseValueBound(pb, blockHeight, new(big.Int).SetUint64(alreadyGenerated), sumFees); err != nil {
+	if err := validateCoinbaseValueBound(pb, blockHeight, new(big.Int).SetUint64(alreadyGenerated), sumFees, nil); err != nil {
 		return nil, err
 	}
 	return s, nil
@@ -313,6 +363,9 @@ func validateBlockResourceLimits(stats *blockTxStats) error {
 	if stats.sumWeight > MAX_BLOCK_WEIGHT {
 		return txerr(BLOCK_ERR_WEIGHT_EXCEEDED, "block weight exceeded")
 	}
+	if stats.sumVerify > MAX_BLOCK_VERIFY_COST {
+		return txerr(BLOCK_ERR_WEIGHT_EXCEEDED, "block verify cost exceeded")
+	}
 	if stats.sumDa > MAX_DA_BYTES_PER_BLOCK {
 		return txerr(BLOCK_ERR_WEIGHT_EXCEEDED, "DA bytes exceeded")
 	}
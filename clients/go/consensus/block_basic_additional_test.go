@@ -558,6 +558,9 @@ func TestValidateBlockResourceLimits_AllBounds(t *testing.T) {
 	if err := validateBlockResourceLimits(&blockTxStats{sumAnchor: MAX_ANCHOR_BYTES_PER_BLOCK + 1}); err == nil {
 		t.Fatal("anchor bytes exceeded should error")
 	}
+	if err := validateBlockResourceLimits(&blockTxStats{sumVerify: MAX_BLOCK_VERIFY_COST + 1}); err == nil {
+		t.Fatal("verify cost exceeded should error")
+	}
 }
 
 func TestTxWeightComponents_NilTx(t *testing.T) {
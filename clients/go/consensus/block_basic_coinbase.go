@@ -12,20 +12,48 @@ func validateCoinbaseStructure(pb *ParsedBlock, blockHeight uint64) error {
 	if len(pb.Txs[0].Outputs) == 0 {
 		return txerr(BLOCK_ERR_COINBASE_INVALID, "coinbase must have at least one output")
 	}
+	return checkCoinbaseHeight(pb.Txs[0], blockHeight)
+}
+
+// CoinbaseHeight returns the block height a canonical coinbase commits to.
+// The commitment is the locktime; there is no BIP34-style script push.
+func CoinbaseHeight(tx *Tx) (uint64, error) {
+	if !isCoinbaseTx(tx) {
+		return 0, txerr(BLOCK_ERR_COINBASE_INVALID, "tx is not a canonical coinbase")
+	}
+	return uint64(tx.Locktime), nil
+}
+
+// CheckCoinbaseHeight verifies that canonical coinbase tx commits to
+// blockHeight.
+func CheckCoinbaseHeight(tx *Tx, blockHeight uint64) error {
+	if _, err := CoinbaseHeight(tx); err != nil {
+		return err
+	}
+	return checkCoinbaseHeight(tx, blockHeight)
+}
+
+func checkCoinbaseHeight(tx *Tx, blockHeight uint64) error {
 	if blockHeight > uint64(^uint32(0)) {
 		return txerr(BLOCK_ERR_COINBASE_INVALID, "block height exceeds coinbase locktime range")
 	}
-	if pb.Txs[0].Locktime != uint32(blockHeight) {
+	if tx.Locktime != uint32(blockHeight) {
 		return txerr(BLOCK_ERR_COINBASE_INVALID, "coinbase locktime must equal block height")
 	}
 	return nil
 }
 
-func validateCoinbaseValueBound(pb *ParsedBlock, blockHeight uint64, alreadyGenerated *big.Int, sumFees uint64) error {
+// validateCoinbaseValueBound enforces sum(coinbase outputs) <= subsidy+fees.
+// A coinbase may split that value across several spendable outputs; its
+// CORE_ANCHOR outputs are value 0, so the sum is the spendable total.
+// Height 0 is exempt from that bound (block_subsidy(0) is 0 and the genesis
+// coinbase carries the premine); when genesisValue is set the genesis
+// coinbase must instead sum to exactly that amount.
+func validateCoinbaseValueBound(pb *ParsedBlock, blockHeight uint64, alreadyGenerated *big.Int, sumFees uint64, genesisValue *uint64) error {
 	if pb == nil || len(pb.Txs) == 0 {
 		return txerr(BLOCK_ERR_COINBASE_INVALID, "missing coinbase")
 	}
-	if blockHeight == 0 {
+	if blockHeight == 0 && genesisValue == nil {
 		return nil
 	}
 	coinbase := pb.Txs[0]
@@ -37,6 +65,12 @@ func validateCoinbaseValueBound(pb *ParsedBlock, blockHeight uint64, alreadyGene
 	if err != nil {
 		return err
 	}
+	if blockHeight == 0 {
+		if cmpU128(sumCoinbase, u128{hi: 0, lo: *genesisValue}) != 0 {
+			return txerr(BLOCK_ERR_SUBSIDY_EXCEEDED, "genesis coinbase value mismatch")
+		}
+		return nil
+	}
 	subsidy := BlockSubsidyBig(blockHeight, alreadyGenerated)
 	limit := u128{hi: 0, lo: subsidy}
 	limit, err = addU64ToU128Block(limit, sumFees)
@@ -77,6 +111,8 @@ func addU64ToU128Block(x u128, v uint64) (u128, error) {
 	return addU64ToU128WithCode(x, v, BLOCK_ERR_PARSE)
 }
 
+// validateCoinbaseWitnessCommitment requires exactly one coinbase CORE_ANCHOR
+// output to carry the block's witness commitment.
 func validateCoinbaseWitnessCommitment(pb *ParsedBlock) error {
 	if pb == nil || len(pb.Txs) == 0 || len(pb.Wtxids) == 0 {
 		return txerr(BLOCK_ERR_COINBASE_INVALID, "missing coinbase")
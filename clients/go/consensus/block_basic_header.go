@@ -6,7 +6,12 @@ func validateHeaderCommitments(pb *ParsedBlock, expectedPrevHash *[32]byte, expe
 	if err := PowCheck(pb.HeaderBytes, pb.Header.Target); err != nil {
 		return err
 	}
+	return validateHeaderLinkage(pb, expectedPrevHash, expectedTarget)
+}
 
+// validateHeaderLinkage checks the header fields that do not depend on the
+// nonce: expected target, prev_block_hash linkage, and merkle_root.
+func validateHeaderLinkage(pb *ParsedBlock, expectedPrevHash *[32]byte, expectedTarget *[32]byte) error {
 	if expectedTarget != nil && pb.Header.Target != *expectedTarget {
 		return txerr(BLOCK_ERR_TARGET_INVALID, "target mismatch")
 	}
@@ -46,6 +51,14 @@ func validateTimestampRules(headerTimestamp uint64, blockHeight uint64, prevTime
 	return nil
 }
 
+// MedianTimePast returns the median of the previous (up to 11) timestamps,
+// newest first, that a block at blockHeight and its timestamp locks are
+// checked against. ok is false at genesis or without context, where
+// consensus falls back to the block's own timestamp.
+func MedianTimePast(blockHeight uint64, prevTimestamps []uint64) (mtp uint64, ok bool, err error) {
+	return medianTimePast(blockHeight, prevTimestamps)
+}
+
 func medianTimePast(blockHeight uint64, prevTimestamps []uint64) (uint64, bool, error) {
 	if blockHeight == 0 || len(prevTimestamps) == 0 {
 		return 0, false, nil
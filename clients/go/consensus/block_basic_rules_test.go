@@ -652,3 +652,32 @@ func TestAddU64_Overflow(t *testing.T) {
 		t.Fatalf("expected overflow error")
 	}
 }
+
+func TestMarshalBlock_RoundTripAndRejects(t *testing.T) {
+	block := minimalBlockBytesForFuzz()
+	pb, err := ParseBlockBytes(block)
+	if err != nil {
+		t.Fatalf("ParseBlockBytes: %v", err)
+	}
+	out, err := MarshalBlock(pb)
+	if err != nil {
+		t.Fatalf("MarshalBlock: %v", err)
+	}
+	if string(out) != string(block) {
+		t.Fatalf("round-trip mismatch")
+	}
+
+	if _, err := MarshalBlock(nil); mustTxErrCode(t, err) != BLOCK_ERR_PARSE {
+		t.Fatalf("nil block: %v", err)
+	}
+	short := *pb
+	short.HeaderBytes = pb.HeaderBytes[:BLOCK_HEADER_BYTES-1]
+	if _, err := MarshalBlock(&short); mustTxErrCode(t, err) != BLOCK_ERR_PARSE {
+		t.Fatalf("short header: %v", err)
+	}
+	miscount := *pb
+	miscount.TxCount = 2
+	if _, err := MarshalBlock(&miscount); mustTxErrCode(t, err) != BLOCK_ERR_PARSE {
+		t.Fatalf("tx_count mismatch: %v", err)
+	}
+}
@@ -2,6 +2,7 @@ package consensus
 
 import (
 	"encoding/binary"
+	"strings"
 	"testing"
 )
 
@@ -134,3 +135,109 @@ func TestParseBlockBytes_MalformedCompactSizeEOF(t *testing.T) {
 		t.Fatalf("code=%s, want %s", got, BLOCK_ERR_PARSE)
 	}
 }
+
+// TestMaxBlockTxCount_IsConsensusNeutral pins the derivation of
+// MAX_BLOCK_TX_COUNT: one more minimal tx than the cap already overflows
+// MAX_BLOCK_WEIGHT, so the early parse bound rejects no otherwise-valid block.
+func TestMaxBlockTxCount_IsConsensusNeutral(t *testing.T) {
+	tx, _, _, _, err := ParseTx(minimalTxBytes())
+	if err != nil {
+		t.Fatalf("ParseTx: %v", err)
+	}
+	weight, _, _, err := TxWeightAndStats(tx)
+	if err != nil {
+		t.Fatalf("TxWeightAndStats: %v", err)
+	}
+	if weight != 78 {
+		t.Fatalf("minimal tx weight=%d, want 78", weight)
+	}
+	if (MAX_BLOCK_TX_COUNT+1)*weight <= MAX_BLOCK_WEIGHT {
+		t.Fatalf("MAX_BLOCK_TX_COUNT+1 minimal txs fit in MAX_BLOCK_WEIGHT")
+	}
+}
+
+// TestParseBlockBytes_TxCountAboveCapRejectedEarly declares one tx more than
+// MAX_BLOCK_TX_COUNT followed by a single malformed byte. The count check
+// must fire before any tx is parsed, so the tx parse error never surfaces.
+func TestParseBlockBytes_TxCountAboveCapRejectedEarly(t *testing.T) {
+	block := buildBlockBytes(t, hashWithPrefix(0xad), filledHash(0x02), filledHash(0xff), 11, [][]byte{minimalTxBytes()})
+	b := AppendCompactSize(block[:BLOCK_HEADER_BYTES:BLOCK_HEADER_BYTES], MAX_BLOCK_TX_COUNT+1)
+	b = append(b, 0xff)
+
+	_, err := ParseBlockBytes(b)
+	if err == nil {
+		t.Fatalf("expected error")
+	}
+	if got := mustTxErrCode(t, err); got != BLOCK_ERR_PARSE {
+		t.Fatalf("code=%s, want %s", got, BLOCK_ERR_PARSE)
+	}
+	if !strings.Contains(err.Error(), "tx_count exceeds MAX_BLOCK_TX_COUNT") {
+		t.Fatalf("err=%v, want tx_count cap rejection", err)
+	}
+
+	atCap := AppendCompactSize(block[:BLOCK_HEADER_BYTES:BLOCK_HEADER_BYTES], MAX_BLOCK_TX_COUNT)
+	atCap = append(atCap, 0xff)
+	if _, err := ParseBlockBytes(atCap); err == nil || strings.Contains(err.Error(), "MAX_BLOCK_TX_COUNT") {
+		t.Fatalf("tx_count at cap: err=%v, want a later parse error", err)
+	}
+}
+
+// TestParseBlockBytes_TxCountExceedsRemainingBytes declares more txs than the
+// remaining bytes could hold at minTxBytes each; the guard rejects before the
+// tx loop, while a count the bytes can cover still parses.
+func TestParseBlockBytes_TxCountExceedsRemainingBytes(t *testing.T) {
+	tx := minimalTxBytes()
+	if len(tx) != minTxBytes {
+		t.Fatalf("minimal tx len=%d, want %d", len(tx), minTxBytes)
+	}
+	block := buildBlockBytes(t, hashWithPrefix(0xae), filledHash(0x02), filledHash(0xff), 12, [][]byte{tx, tx})
+	header := block[:BLOCK_HEADER_BYTES:BLOCK_HEADER_BYTES]
+	body := block[BLOCK_HEADER_BYTES+1:]
+
+	for _, count := range []uint64{3, 1 << 16, MAX_BLOCK_TX_COUNT} {
+		b := append(AppendCompactSize(header, count), body...)
+		_, err := ParseBlockBytes(b)
+		if err == nil {
+			t.Fatalf("count=%d: expected error", count)
+		}
+		if got := mustTxErrCode(t, err); got != BLOCK_ERR_PARSE {
+			t.Fatalf("count=%d: code=%s, want %s", count, got, BLOCK_ERR_PARSE)
+		}
+		if !strings.Contains(err.Error(), "tx_count exceeds remaining bytes") {
+			t.Fatalf("count=%d: err=%v, want remaining-bytes rejection", count, err)
+		}
+	}
+
+	pb, err := ParseBlockBytes(append(AppendCompactSize(header, 2), body...))
+	if err != nil {
+		t.Fatalf("count=2: %v", err)
+	}
+	if pb.TxCount != 2 {
+		t.Fatalf("tx_count=%d, want 2", pb.TxCount)
+	}
+}
+
+// MAX_BLOCK_BYTES: header, a maximal tx_count CompactSize and one weight unit
+// per remaining byte still fit under the cap, so CheckBlockSerializedSize
+// rejects no otherwise-valid block.
+func TestMaxBlockBytes_IsConsensusNeutral(t *testing.T) {
+	if BLOCK_HEADER_BYTES+9+MAX_BLOCK_WEIGHT >= MAX_BLOCK_BYTES {
+		t.Fatalf("a weight-valid block can exceed MAX_BLOCK_BYTES")
+	}
+}
+
+// TestCheckBlockSerializedSize_RejectsBeforeParse uses an all-zero buffer,
+// which ParseBlockBytes would reject for its header; the size check must fire
+// first with its own reason.
+func TestCheckBlockSerializedSize_RejectsBeforeParse(t *testing.T) {
+	if err := CheckBlockSerializedSize(make([]byte, MAX_BLOCK_BYTES)); err != nil {
+		t.Fatalf("at cap: %v", err)
+	}
+	err := CheckBlockSerializedSize(make([]byte, MAX_BLOCK_BYTES+1))
+	if got := mustTxErrCode(t, err); got != BLOCK_ERR_PARSE {
+		t.Fatalf("code=%s, want %s", got, BLOCK_ERR_PARSE)
+	}
+	if !strings.Contains(err.Error(), "MAX_BLOCK_BYTES") {
+		t.Fatalf("err=%v, want size cap rejection", err)
+	}
+}
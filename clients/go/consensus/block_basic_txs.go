@@ -1,5 +1,10 @@
 package consensus
 
+// accumulateBlockResourceStats sums per-tx weight, DA, anchor and verify-cost
+// totals. It stops at the first transaction that pushes the running weight
+// past MAX_BLOCK_WEIGHT: the block is rejected with the same
+// BLOCK_ERR_WEIGHT_EXCEEDED validateBlockResourceLimits would report (weight
+// is its first check), without costing the remaining transactions.
 func accumulateBlockResourceStats(pb *ParsedBlock) (*blockTxStats, error) {
 	stats := &blockTxStats{}
 	for _, tx := range pb.Txs {
@@ -11,6 +16,9 @@ func accumulateBlockResourceStats(pb *ParsedBlock) (*blockTxStats, error) {
 		if err != nil {
 			return nil, err
 		}
+		if stats.sumWeight > MAX_BLOCK_WEIGHT {
+			return nil, txerr(BLOCK_ERR_WEIGHT_EXCEEDED, "block weight exceeded")
+		}
 		stats.sumDa, err = addBlockResourceStat(stats.sumDa, da, "sum_da overflow")
 		if err != nil {
 			return nil, err
@@ -19,6 +27,14 @@ func accumulateBlockResourceStats(pb *ParsedBlock) (*blockTxStats, error) {
 		if err != nil {
 			return nil, err
 		}
+		_, verifyCost, err := computeTxWitness(tx, legacyWitnessSigCost)
+		if err != nil {
+			return nil, err
+		}
+		stats.sumVerify, err = addBlockResourceStat(stats.sumVerify, verifyCost, "sum_verify_cost overflow")
+		if err != nil {
+			return nil, err
+		}
 	}
 	return stats, nil
 }
@@ -17,6 +17,19 @@ func TestAccumulateBlockResourceStats_NilTxError(t *testing.T) {
 	}
 }
 
+func TestAccumulateBlockResourceStats_StopsAtWeightCap(t *testing.T) {
+	overweight, _, _, _, err := ParseTx(txWithNonceAndOutputs(1, repeatedAnchorOutputs(1024, 17_000)))
+	if err != nil {
+		t.Fatalf("ParseTx: %v", err)
+	}
+	// The nil tx would fail with TX_ERR_PARSE if it were ever costed.
+	pb := &ParsedBlock{Txs: []*Tx{overweight, nil}}
+	_, err = accumulateBlockResourceStats(pb)
+	if got := mustTxErrCode(t, err); got != BLOCK_ERR_WEIGHT_EXCEEDED {
+		t.Fatalf("code=%s, want %s", got, BLOCK_ERR_WEIGHT_EXCEEDED)
+	}
+}
+
 func TestAddBlockResourceStat_OverflowMessages(t *testing.T) {
 	for _, tc := range []struct {
 		name string
@@ -2,6 +2,7 @@ package consensus
 
 import (
 	"bytes"
+	"slices"
 	"testing"
 )
 
@@ -119,3 +120,163 @@ func TestValidateBlockBasic_AnchorBytesPrecedeCoinbaseStructure(t *testing.T) {
 		t.Fatalf("code=%s, want %s", got, BLOCK_ERR_ANCHOR_BYTES_EXCEEDED)
 	}
 }
+
+func blockValidationStep(t *testing.T, name string) (int, ValidationCheck) {
+	t.Helper()
+	for i, check := range BlockValidationOrder {
+		if check.Name == name {
+			return i, check
+		}
+	}
+	t.Fatalf("step %q not in BlockValidationOrder", name)
+	return 0, ValidationCheck{}
+}
+
+// blockWithRoot builds a block over txs at prev/target; corruptRoot flips a
+// merkle_root byte.
+func blockWithRoot(t *testing.T, prev, target [32]byte, nonce uint64, corruptRoot bool, txs ...[]byte) []byte {
+	t.Helper()
+	txids := make([][32]byte, 0, len(txs))
+	for _, tx := range txs {
+		txids = append(txids, testTxID(t, tx))
+	}
+	root, err := MerkleRootTxids(txids)
+	if err != nil {
+		t.Fatalf("MerkleRootTxids: %v", err)
+	}
+	if corruptRoot {
+		root[0] ^= 0xff
+	}
+	return buildBlockBytes(t, prev, root, target, nonce, txs)
+}
+
+// verifyHeavyTxs returns transactions whose non-native witness items push
+// the block verify cost past MAX_BLOCK_VERIFY_COST while staying under
+// MAX_BLOCK_WEIGHT.
+func verifyHeavyTxs(t *testing.T) [][]byte {
+	t.Helper()
+	txCount := MAX_BLOCK_VERIFY_COST/(MAX_WITNESS_ITEMS*VERIFY_COST_UNKNOWN_SUITE) + 1
+	out := make([][]byte, 0, txCount)
+	for i := 0; i < txCount; i++ {
+		witness := make([]WitnessItem, MAX_WITNESS_ITEMS)
+		for j := range witness {
+			witness[j] = WitnessItem{SuiteID: 0x02, Pubkey: []byte{0x01}, Signature: []byte{0x01}}
+		}
+		b, err := MarshalTx(&Tx{
+			Version: TX_WIRE_VERSION,
+			TxNonce: uint64(i + 1),
+			Inputs:  []TxInput{{PrevTxid: [32]byte{byte(i + 1)}}},
+			Outputs: []TxOutput{{Value: 1, CovenantType: COV_TYPE_P2PK, CovenantData: validP2PKCovenantData()}},
+			Witness: witness,
+		})
+		if err != nil {
+			t.Fatalf("MarshalTx: %v", err)
+		}
+		out = append(out, b)
+	}
+	return out
+}
+
+func TestBlockValidationOrder_NamesUnique(t *testing.T) {
+	seen := map[string]bool{}
+	for _, check := range BlockValidationOrder {
+		if check.Name == "" || seen[check.Name] {
+			t.Fatalf("empty or duplicate step name %q", check.Name)
+		}
+		seen[check.Name] = true
+	}
+}
+
+// TestBlockValidationOrder_TwoRuleViolations builds blocks that break two
+// rules at once and asserts the code of the earlier BlockValidationOrder step.
+func TestBlockValidationOrder_TwoRuleViolations(t *testing.T) {
+	prev := hashWithPrefix(0xb0)
+	wrongPrev := hashWithPrefix(0xb1)
+	target := filledHash(0xff)
+	wrongTarget := filledHash(0xee)
+	tinyTarget := [32]byte{}
+	tinyTarget[31] = 0x01
+	oldTimestamps := []uint64{10}
+
+	p2pk := []testOutput{{value: 1, covenantType: COV_TYPE_P2PK, covenantData: validP2PKCovenantData()}}
+	noCommitCoinbase := coinbaseTxWithOutputs(1, p2pk)
+	overweightTx := txWithNonceAndOutputs(1, repeatedAnchorOutputs(1024, 17_000))
+	nonceTxA := txWithNonceAndOutputs(7, p2pk)
+	nonceTxB := txWithNonceAndOutputs(7, []testOutput{{value: 2, covenantType: COV_TYPE_P2PK, covenantData: validP2PKCovenantData()}})
+	badCovenantTx := txWithNonceAndOutputs(8, []testOutput{{value: 1, covenantType: COV_TYPE_ANCHOR, covenantData: []byte{0x01}}})
+	spendTx := txWithNonceAndOutputs(9, p2pk)
+	richCoinbase := func(txs ...[]byte) []byte {
+		return coinbaseWithWitnessCommitmentAndP2PKValueAtHeight(t, 1, BlockSubsidy(1, 0)+1, txs...)
+	}
+
+	basic := func(block []byte, expectedPrev, expectedTarget [32]byte, prevTimestamps []uint64) error {
+		_, err := ValidateBlockBasicWithContextAtHeight(block, &expectedPrev, &expectedTarget, 1, prevTimestamps)
+		return err
+	}
+
+	cases := []struct {
+		earlier, later string
+		want           ErrorCode
+		run            func() error
+	}{
+		{"pow", "linkage", BLOCK_ERR_POW_INVALID, func() error {
+			return basic(blockWithRoot(t, prev, tinyTarget, 1, false, noCommitCoinbase), wrongPrev, tinyTarget, nil)
+		}},
+		{"expected_target", "linkage", BLOCK_ERR_TARGET_INVALID, func() error {
+			return basic(blockWithRoot(t, prev, target, 2, false, noCommitCoinbase), wrongPrev, wrongTarget, nil)
+		}},
+		{"linkage", "merkle", BLOCK_ERR_LINKAGE_INVALID, func() error {
+			return basic(blockWithRoot(t, prev, target, 3, true, noCommitCoinbase), wrongPrev, target, nil)
+		}},
+		{"merkle", "witness_commitment", BLOCK_ERR_MERKLE_INVALID, func() error {
+			return basic(blockWithRoot(t, prev, target, 4, true, noCommitCoinbase), prev, target, nil)
+		}},
+		{"witness_commitment", "timestamp", BLOCK_ERR_WITNESS_COMMITMENT, func() error {
+			return basic(blockWithRoot(t, prev, target, 5, false, noCommitCoinbase), prev, target, oldTimestamps)
+		}},
+		{"timestamp", "weight", BLOCK_ERR_TIMESTAMP_OLD, func() error {
+			cb := coinbaseWithWitnessCommitmentAtHeight(t, 1, overweightTx)
+			return basic(blockWithRoot(t, prev, target, 6, false, cb, overweightTx), prev, target, oldTimestamps)
+		}},
+		{"weight", "coinbase", BLOCK_ERR_WEIGHT_EXCEEDED, func() error {
+			cb := coinbaseWithWitnessCommitmentAtHeight(t, 0, overweightTx)
+			return basic(blockWithRoot(t, prev, target, 7, false, cb, overweightTx), prev, target, nil)
+		}},
+		{"verify_cost", "coinbase", BLOCK_ERR_WEIGHT_EXCEEDED, func() error {
+			txs := verifyHeavyTxs(t)
+			cb := coinbaseWithWitnessCommitmentAtHeight(t, 0, txs...)
+			return basic(blockWithRoot(t, prev, target, 11, false, append([][]byte{cb}, txs...)...), prev, target, nil)
+		}},
+		{"coinbase", "tx", BLOCK_ERR_COINBASE_INVALID, func() error {
+			cb := coinbaseWithWitnessCommitmentAtHeight(t, 0, nonceTxA, nonceTxB)
+			return basic(blockWithRoot(t, prev, target, 8, false, cb, nonceTxA, nonceTxB), prev, target, nil)
+		}},
+		{"tx", "subsidy", TX_ERR_COVENANT_TYPE_INVALID, func() error {
+			cb := richCoinbase(badCovenantTx)
+			block := blockWithRoot(t, prev, target, 9, false, cb, badCovenantTx)
+			_, err := ValidateBlockBasicWithContextAndFeesAtHeight(block, &prev, &target, 1, nil, 0, 0)
+			return err
+		}},
+		{"utxo_apply", "subsidy", TX_ERR_MISSING_UTXO, func() error {
+			cb := richCoinbase(spendTx)
+			block := blockWithRoot(t, prev, target, 10, false, cb, spendTx)
+			_, err := ConnectBlockBasicInMemoryAtHeight(block, &prev, &target, 1, nil, &InMemoryChainState{}, [32]byte{})
+			return err
+		}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.earlier+"_before_"+tc.later, func(t *testing.T) {
+			earlierIdx, earlier := blockValidationStep(t, tc.earlier)
+			laterIdx, _ := blockValidationStep(t, tc.later)
+			if earlierIdx >= laterIdx {
+				t.Fatalf("%s (step %d) is not before %s (step %d)", tc.earlier, earlierIdx, tc.later, laterIdx)
+			}
+			if earlier.Codes != nil && !slices.Contains(earlier.Codes, tc.want) {
+				t.Fatalf("%s is not a %s code %v", tc.want, tc.earlier, earlier.Codes)
+			}
+			if got := mustTxErrCode(t, tc.run()); got != tc.want {
+				t.Fatalf("code=%s, want %s", got, tc.want)
+			}
+		})
+	}
+}
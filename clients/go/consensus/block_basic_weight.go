@@ -207,22 +207,26 @@ func computeTxDASize(tx *Tx) (uint64, uint64) {
 
 // txWeightAndStats computes legacy weight with hardcoded per-suite costs.
 func txWeightAndStats(tx *Tx) (uint64, uint64, uint64, error) {
-	return txWeightComponents(tx, func(w WitnessItem) (uint64, error) {
-		switch w.SuiteID {
-		case SUITE_ID_SIMPLICITY_ENVELOPE:
-			return SIMPLICITY_BASE_VERIFY_COST, nil
-		case SUITE_ID_ML_DSA_87:
-			if len(w.Pubkey) == ML_DSA_87_PUBKEY_BYTES && len(w.Signature) == ML_DSA_87_SIG_BYTES+1 {
-				return VERIFY_COST_ML_DSA_87, nil
-			}
-			// Malformed native witness: zero sig_cost because witness bytes still
-			// contribute via wit_size and validation rejects on cheap length checks
-			// without invoking expensive crypto verification.
-			return 0, nil
-		default:
-			return VERIFY_COST_UNKNOWN_SUITE, nil
+	return txWeightComponents(tx, legacyWitnessSigCost)
+}
+
+// legacyWitnessSigCost is the hardcoded per-suite verify cost used by
+// txWeightAndStats and the block verify-cost total.
+func legacyWitnessSigCost(w WitnessItem) (uint64, error) {
+	switch w.SuiteID {
+	case SUITE_ID_SIMPLICITY_ENVELOPE:
+		return SIMPLICITY_BASE_VERIFY_COST, nil
+	case SUITE_ID_ML_DSA_87:
+		if len(w.Pubkey) == ML_DSA_87_PUBKEY_BYTES && len(w.Signature) == ML_DSA_87_SIG_BYTES+1 {
+			return VERIFY_COST_ML_DSA_87, nil
 		}
-	})
+		// Malformed native witness: zero sig_cost because witness bytes still
+		// contribute via wit_size and validation rejects on cheap length checks
+		// without invoking expensive crypto verification.
+		return 0, nil
+	default:
+		return VERIFY_COST_UNKNOWN_SUITE, nil
+	}
 }
 
 func compactSizeLen(n uint64) uint64 {
@@ -160,3 +160,45 @@ func TestTxWeightComponents_SigCostOverflowPropagates(t *testing.T) {
 		t.Fatalf("code=%s, want %s", got, TX_ERR_PARSE)
 	}
 }
+
+func TestValidateBlockBodyChecks_VerifyCostExceededUnderWeightCap(t *testing.T) {
+	// Small non-native witness items are cheap in bytes but each is charged
+	// VERIFY_COST_UNKNOWN_SUITE, so enough of them exceed the verify-cost cap
+	// while the block stays well under MAX_BLOCK_WEIGHT.
+	perTx := MAX_WITNESS_ITEMS
+	txCount := MAX_BLOCK_VERIFY_COST/(perTx*VERIFY_COST_UNKNOWN_SUITE) + 1
+	txs := make([]*Tx, 0, txCount)
+	for i := 0; i < txCount; i++ {
+		witness := make([]WitnessItem, perTx)
+		for j := range witness {
+			witness[j] = WitnessItem{SuiteID: 0x02, Pubkey: []byte{0x01}, Signature: []byte{0x01}}
+		}
+		txs = append(txs, &Tx{
+			Version: TX_WIRE_VERSION,
+			TxNonce: uint64(i + 1),
+			Inputs:  []TxInput{{PrevTxid: [32]byte{byte(i + 1)}}},
+			Outputs: []TxOutput{{Value: 1, CovenantType: COV_TYPE_P2PK, CovenantData: validP2PKCovenantData()}},
+			Witness: witness,
+		})
+	}
+	pb := &ParsedBlock{Txs: txs, TxCount: uint64(len(txs))}
+
+	stats, err := accumulateBlockResourceStats(pb)
+	if err != nil {
+		t.Fatalf("accumulateBlockResourceStats: %v", err)
+	}
+	if stats.sumWeight > MAX_BLOCK_WEIGHT {
+		t.Fatalf("sum_weight=%d exceeds MAX_BLOCK_WEIGHT; test block must stay under the byte-weight cap", stats.sumWeight)
+	}
+	if stats.sumVerify <= MAX_BLOCK_VERIFY_COST {
+		t.Fatalf("sum_verify=%d, want > %d", stats.sumVerify, MAX_BLOCK_VERIFY_COST)
+	}
+
+	_, err = validateBlockBodyChecks(pb, 1, nil)
+	if err == nil {
+		t.Fatal("expected verify cost rejection")
+	}
+	if got := mustTxErrCode(t, err); got != BLOCK_ERR_WEIGHT_EXCEEDED {
+		t.Fatalf("code=%s, want %s", got, BLOCK_ERR_WEIGHT_EXCEEDED)
+	}
+}
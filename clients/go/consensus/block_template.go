@@ -0,0 +1,72 @@
+package consensus
+
+// ValidateBlockTemplateWithContextAtHeight runs the checks of
+// ValidateBlockBasicWithContextAtHeight except the proof-of-work requirement
+// that the header hash be below the target, so a miner can vet a template
+// before grinding nonces. The header target must still be within POW_LIMIT.
+func ValidateBlockTemplateWithContextAtHeight(
+	blockBytes []byte,
+	expectedPrevHash *[32]byte,
+	expectedTarget *[32]byte,
+	blockHeight uint64,
+	prevTimestamps []uint64,
+) (*BlockBasicSummary, error) {
+	pb, err := ParseBlockBytes(blockBytes)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateTemplateHeaderChecks(pb, expectedPrevHash, expectedTarget, blockHeight, prevTimestamps); err != nil {
+		return nil, err
+	}
+	stats, err := validateBlockBodyChecks(pb, blockHeight, nil)
+	if err != nil {
+		return nil, err
+	}
+	blockHash, err := BlockHash(pb.HeaderBytes)
+	if err != nil {
+		return nil, txerr(BLOCK_ERR_PARSE, "failed to hash block header")
+	}
+	return &BlockBasicSummary{
+		TxCount:   pb.TxCount,
+		SumWeight: stats.sumWeight,
+		SumDa:     stats.sumDa,
+		BlockHash: blockHash,
+	}, nil
+}
+
+// validateTemplateHeaderChecks mirrors validateBlockHeaderChecks with the PoW
+// hash comparison replaced by a target range check.
+func validateTemplateHeaderChecks(pb *ParsedBlock, expectedPrevHash *[32]byte, expectedTarget *[32]byte, blockHeight uint64, prevTimestamps []uint64) error {
+	if err := validateTargetRange(pb.Header.Target); err != nil {
+		return err
+	}
+	if err := validateHeaderLinkage(pb, expectedPrevHash, expectedTarget); err != nil {
+		return err
+	}
+	if err := validateCoinbaseWitnessCommitment(pb); err != nil {
+		return err
+	}
+	return validateTimestampRules(pb.Header.Timestamp, blockHeight, prevTimestamps)
+}
+
+var blockTemplateIDDST = []byte("RUBINv1-template-id/")
+
+// BlockTemplateID identifies a block template independently of nonce search:
+// SHA3-256 over a domain tag, the header without its trailing nonce, and the
+// tx_count-prefixed wtxid list. Two templates that differ only in nonce share
+// an id; any change to the header fields or the transaction set (including
+// witnesses) changes it.
+func BlockTemplateID(blockBytes []byte) ([32]byte, error) {
+	pb, err := ParseBlockBytes(blockBytes)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	preimage := make([]byte, 0, len(blockTemplateIDDST)+BLOCK_HEADER_BYTES-8+9+32*len(pb.Wtxids))
+	preimage = append(preimage, blockTemplateIDDST...)
+	preimage = append(preimage, pb.HeaderBytes[:BLOCK_HEADER_BYTES-8]...)
+	preimage = AppendCompactSize(preimage, pb.TxCount)
+	for _, wtxid := range pb.Wtxids {
+		preimage = append(preimage, wtxid[:]...)
+	}
+	return sha3_256(preimage), nil
+}
@@ -0,0 +1,82 @@
+package consensus
+
+import "testing"
+
+func TestValidateBlockTemplate_NonSolvingNonceAcceptedButFailsPow(t *testing.T) {
+	prev := hashWithPrefix(0x31)
+	var target [32]byte
+	target[31] = 0x01 // in range, but no realistic header hash is below it
+	cb := coinbaseWithWitnessCommitmentAtHeight(t, 1)
+	root := MerkleRootFromTxBytes(t, [][]byte{cb})
+	block := buildBlockBytes(t, prev, root, target, 7, [][]byte{cb})
+
+	s, err := ValidateBlockTemplateWithContextAtHeight(block, &prev, &target, 1, nil)
+	if err != nil {
+		t.Fatalf("ValidateBlockTemplateWithContextAtHeight: %v", err)
+	}
+	if s.TxCount != 1 {
+		t.Fatalf("tx_count=%d, want 1", s.TxCount)
+	}
+	if _, err := ValidateBlockBasicWithContextAtHeight(block, &prev, &target, 1, nil); mustTxErrCode(t, err) != BLOCK_ERR_POW_INVALID {
+		t.Fatalf("code=%s, want %s", mustTxErrCode(t, err), BLOCK_ERR_POW_INVALID)
+	}
+	if err := PowCheck(block[:BLOCK_HEADER_BYTES], target); mustTxErrCode(t, err) != BLOCK_ERR_POW_INVALID {
+		t.Fatalf("code=%s, want %s", mustTxErrCode(t, err), BLOCK_ERR_POW_INVALID)
+	}
+}
+
+func TestValidateBlockTemplate_StructuralErrors(t *testing.T) {
+	prev := hashWithPrefix(0x32)
+	target := filledHash(0xff)
+	cb := coinbaseWithWitnessCommitmentAtHeight(t, 1)
+	root := MerkleRootFromTxBytes(t, [][]byte{cb})
+
+	var zeroTarget [32]byte
+	block := buildBlockBytes(t, prev, root, zeroTarget, 7, [][]byte{cb})
+	if _, err := ValidateBlockTemplateWithContextAtHeight(block, &prev, nil, 1, nil); mustTxErrCode(t, err) != BLOCK_ERR_TARGET_INVALID {
+		t.Fatalf("code=%s, want %s", mustTxErrCode(t, err), BLOCK_ERR_TARGET_INVALID)
+	}
+
+	block = buildBlockBytes(t, prev, root, target, 7, [][]byte{cb})
+	wrongPrev := hashWithPrefix(0x33)
+	if _, err := ValidateBlockTemplateWithContextAtHeight(block, &wrongPrev, &target, 1, nil); mustTxErrCode(t, err) != BLOCK_ERR_LINKAGE_INVALID {
+		t.Fatalf("code=%s, want %s", mustTxErrCode(t, err), BLOCK_ERR_LINKAGE_INVALID)
+	}
+
+	block = buildBlockBytes(t, prev, hashWithPrefix(0x34), target, 7, [][]byte{cb})
+	if _, err := ValidateBlockTemplateWithContextAtHeight(block, &prev, &target, 1, nil); mustTxErrCode(t, err) != BLOCK_ERR_MERKLE_INVALID {
+		t.Fatalf("code=%s, want %s", mustTxErrCode(t, err), BLOCK_ERR_MERKLE_INVALID)
+	}
+}
+
+func TestBlockTemplateID_IgnoresNonceOnly(t *testing.T) {
+	prev := hashWithPrefix(0x32)
+	target := filledHash(0xff)
+	tx := txWithNonceAndOutputs(1, []testOutput{{value: 1, covenantType: COV_TYPE_P2PK, covenantData: validP2PKCovenantData()}})
+	otherTx := txWithNonceAndOutputs(2, []testOutput{{value: 1, covenantType: COV_TYPE_P2PK, covenantData: validP2PKCovenantData()}})
+	cb := coinbaseWithWitnessCommitmentAtHeight(t, 1, tx)
+	otherCb := coinbaseWithWitnessCommitmentAtHeight(t, 1, otherTx)
+
+	id := func(block []byte) [32]byte {
+		t.Helper()
+		h, err := BlockTemplateID(block)
+		if err != nil {
+			t.Fatalf("BlockTemplateID: %v", err)
+		}
+		return h
+	}
+	base := id(blockWithRoot(t, prev, target, 1, false, cb, tx))
+	if got := id(blockWithRoot(t, prev, target, 2, false, cb, tx)); got != base {
+		t.Fatalf("nonce-only change moved template id")
+	}
+	if got := id(blockWithRoot(t, prev, target, 1, false, otherCb, otherTx)); got == base {
+		t.Fatalf("different tx set shares template id")
+	}
+	if got := id(blockWithRoot(t, hashWithPrefix(0x33), target, 1, false, cb, tx)); got == base {
+		t.Fatalf("different prev_block_hash shares template id")
+	}
+
+	if _, err := BlockTemplateID([]byte{0x01}); mustTxErrCode(t, err) != BLOCK_ERR_PARSE {
+		t.Fatalf("err=%v, want %s", err, BLOCK_ERR_PARSE)
+	}
+}
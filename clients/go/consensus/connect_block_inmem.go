@@ -10,6 +10,11 @@ import "math/big"
 type InMemoryChainState struct {
 	Utxos            map[Outpoint]UtxoEntry
 	AlreadyGenerated *big.Int // already_generated(h): subsidy-only, excluding fees
+
+	// GenesisCoinbaseValue pins the height-0 coinbase output sum to the
+	// profile's premine amount. Genesis carries no subsidy, so it is otherwise
+	// exempt from the subsidy+fees bound; nil keeps that legacy exemption.
+	GenesisCoinbaseValue *uint64
 }
 
 type ConnectBlockBasicSummary struct {
@@ -134,7 +139,7 @@ func connectBlockBasicInMemoryAtHeightAndSuiteContext(
 		return nil, err
 	}
 
-	if err := validateCoinbaseValueBound(pb, input.BlockHeight, alreadyGenerated, sumFees); err != nil {
+	if err := validateCoinbaseValueBound(pb, input.BlockHeight, alreadyGenerated, sumFees, input.State.GenesisCoinbaseValue); err != nil {
 		return nil, err
 	}
 	if err := validateCoinbaseApplyOutputs(pb.Txs[0]); err != nil {
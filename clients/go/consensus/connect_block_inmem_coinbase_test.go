@@ -28,6 +28,31 @@ func coinbaseWithWitnessCommitmentAndVaultOutputAtHeight(t *testing.T, height ui
 	})
 }
 
+func TestCheckCoinbaseHeight(t *testing.T) {
+	coinbase, _, _, _, err := ParseTx(coinbaseTxWithOutputs(5, []testOutput{{value: 1, covenantType: COV_TYPE_ANCHOR, covenantData: make([]byte, 32)}}))
+	if err != nil {
+		t.Fatalf("ParseTx: %v", err)
+	}
+	if height, err := CoinbaseHeight(coinbase); err != nil || height != 5 {
+		t.Fatalf("CoinbaseHeight=%d, %v; want 5", height, err)
+	}
+	if err := CheckCoinbaseHeight(coinbase, 5); err != nil {
+		t.Fatalf("CheckCoinbaseHeight(5): %v", err)
+	}
+	for _, height := range []uint64{4, 1<<32 + 5} {
+		err := CheckCoinbaseHeight(coinbase, height)
+		if got := mustTxErrCode(t, err); got != BLOCK_ERR_COINBASE_INVALID {
+			t.Fatalf("height %d: code=%s, want %s", height, got, BLOCK_ERR_COINBASE_INVALID)
+		}
+	}
+
+	notCoinbase := *coinbase
+	notCoinbase.TxNonce = 1
+	if _, err := CoinbaseHeight(&notCoinbase); mustTxErrCode(t, err) != BLOCK_ERR_COINBASE_INVALID {
+		t.Fatalf("non-coinbase accepted: %v", err)
+	}
+}
+
 func TestConnectBlockBasicInMemoryAtHeight_RejectsCoinbaseVaultOutput(t *testing.T) {
 	height := uint64(1)
 	prev := hashWithPrefix(0xb1)
@@ -1,6 +1,8 @@
 package consensus
 
 import (
+	"bytes"
+	"encoding/binary"
 	"math/big"
 	"testing"
 )
@@ -242,6 +244,53 @@ func TestConnectBlockBasicInMemoryAtHeight_Height0_DoesNotAdvanceAlreadyGenerate
 	}
 }
 
+func TestConnectBlockBasicInMemoryAtHeight_GenesisCoinbaseValue(t *testing.T) {
+	height := uint64(0)
+	prev := hashWithPrefix(0x13)
+	target := filledHash(0xff)
+
+	coinbase := coinbaseWithWitnessCommitmentAndP2PKValueAtHeight(t, height, GENESIS_ALLOCATION)
+	cbTxid := testTxID(t, coinbase)
+	root, err := MerkleRootTxids([][32]byte{cbTxid})
+	if err != nil {
+		t.Fatalf("MerkleRootTxids: %v", err)
+	}
+	block := buildBlockBytes(t, prev, root, target, 5, [][]byte{coinbase})
+
+	// Genesis is exempt from the subsidy+fees bound (block_subsidy(0) == 0)
+	// unless the profile pins a premine amount.
+	state := &InMemoryChainState{}
+	if _, err := ConnectBlockBasicInMemoryAtHeight(block, &prev, &target, height, nil, state, [32]byte{}); err != nil {
+		t.Fatalf("unpinned genesis: %v", err)
+	}
+
+	premine := uint64(GENESIS_ALLOCATION)
+	state = &InMemoryChainState{GenesisCoinbaseValue: &premine}
+	if _, err := ConnectBlockBasicInMemoryAtHeight(block, &prev, &target, height, nil, state, [32]byte{}); err != nil {
+		t.Fatalf("matching genesis premine: %v", err)
+	}
+	state = &InMemoryChainState{GenesisCoinbaseValue: &premine}
+	if _, err := ConnectBlockParallelSigVerify(block, &prev, &target, height, nil, state, [32]byte{}, 1); err != nil {
+		t.Fatalf("matching genesis premine (parallel): %v", err)
+	}
+
+	for _, want := range []uint64{GENESIS_ALLOCATION - 1, GENESIS_ALLOCATION + 1} {
+		state = &InMemoryChainState{GenesisCoinbaseValue: &want}
+		_, err := ConnectBlockBasicInMemoryAtHeight(block, &prev, &target, height, nil, state, [32]byte{})
+		if got := mustTxErrCode(t, err); got != BLOCK_ERR_SUBSIDY_EXCEEDED {
+			t.Fatalf("premine=%d: code=%s, want %s", want, got, BLOCK_ERR_SUBSIDY_EXCEEDED)
+		}
+		if len(state.Utxos) != 0 {
+			t.Fatalf("premine=%d: state mutated on reject", want)
+		}
+		state = &InMemoryChainState{GenesisCoinbaseValue: &want}
+		_, err = ConnectBlockParallelSigVerify(block, &prev, &target, height, nil, state, [32]byte{}, 1)
+		if got := mustTxErrCode(t, err); got != BLOCK_ERR_SUBSIDY_EXCEEDED {
+			t.Fatalf("premine=%d (parallel): code=%s, want %s", want, got, BLOCK_ERR_SUBSIDY_EXCEEDED)
+		}
+	}
+}
+
 func TestConnectBlockBasicInMemoryAtHeight_RejectsSubsidyExceeded(t *testing.T) {
 	height := uint64(1)
 
@@ -301,3 +350,168 @@ func TestConnectBlockBasicInMemoryAtHeight_RejectsSubsidyExceeded(t *testing.T)
 		t.Fatalf("code=%s, want %s", got, BLOCK_ERR_SUBSIDY_EXCEEDED)
 	}
 }
+
+// TestConnectBlockBasicInMemoryAtHeight_WeightCapSkipsSigVerify connects a
+// block whose second transaction carries a well-formed ML-DSA-87 witness.
+// Behind an overweight transaction the block fails on the weight cap before
+// any signature is verified; without it, verification is reached.
+func TestConnectBlockBasicInMemoryAtHeight_WeightCapSkipsSigVerify(t *testing.T) {
+	pubkey := bytes.Repeat([]byte{0x11}, ML_DSA_87_PUBKEY_BYTES)
+	signature := append(bytes.Repeat([]byte{0x22}, ML_DSA_87_SIG_BYTES), SIGHASH_ALL)
+	signedTx := txWithOneInputOneOutputAndWitness(SUITE_ID_ML_DSA_87, pubkey, signature)
+	overweightTx := txWithNonceAndOutputs(2, repeatedAnchorOutputs(1024, 17_000))
+
+	verifyCalls := 0
+	orig := opensslVerifySigOneShotFn
+	opensslVerifySigOneShotFn = func(_ string, _ []byte, _ []byte, _ []byte) (bool, error) {
+		verifyCalls++
+		return false, nil
+	}
+	defer func() { opensslVerifySigOneShotFn = orig }()
+
+	connect := func(nonce uint64, txs ...[]byte) error {
+		coinbase := coinbaseWithWitnessCommitmentAtHeight(t, 1, txs...)
+		txids := [][32]byte{testTxID(t, coinbase)}
+		for _, tx := range txs {
+			txids = append(txids, testTxID(t, tx))
+		}
+		root, err := MerkleRootTxids(txids)
+		if err != nil {
+			t.Fatalf("MerkleRootTxids: %v", err)
+		}
+		prev := hashWithPrefix(0xa4)
+		target := filledHash(0xff)
+		block := buildBlockBytes(t, prev, root, target, nonce, append([][]byte{coinbase}, txs...))
+		state := &InMemoryChainState{Utxos: map[Outpoint]UtxoEntry{
+			{Vout: 0}: {Value: 100, CovenantType: COV_TYPE_P2PK, CovenantData: p2pkCovenantDataForPubkey(pubkey)},
+		}}
+		_, err = ConnectBlockBasicInMemoryAtHeight(block, &prev, &target, 1, nil, state, [32]byte{})
+		return err
+	}
+
+	err := connect(44, overweightTx, signedTx)
+	if got := mustTxErrCode(t, err); got != BLOCK_ERR_WEIGHT_EXCEEDED {
+		t.Fatalf("code=%s, want %s", got, BLOCK_ERR_WEIGHT_EXCEEDED)
+	}
+	if verifyCalls != 0 {
+		t.Fatalf("signature verification ran %d times on an overweight block", verifyCalls)
+	}
+
+	// The control needs a working ML-DSA backend to reach the hook.
+	mustMLDSA87Keypair(t)
+	err = connect(45, signedTx)
+	if got := mustTxErrCode(t, err); got != TX_ERR_SIG_INVALID {
+		t.Fatalf("code=%s, want %s", got, TX_ERR_SIG_INVALID)
+	}
+	if verifyCalls == 0 {
+		t.Fatalf("control block never reached signature verification")
+	}
+}
+
+// TestConnectBlockBasicInMemoryAtHeight_MisplacedCoinbaseRejectedEarly puts a
+// second coinbase at index 1 ahead of a spend with a well-formed ML-DSA-87
+// witness: the block fails basic validation, leaving the chainstate untouched
+// and never reaching signature verification.
+func TestConnectBlockBasicInMemoryAtHeight_MisplacedCoinbaseRejectedEarly(t *testing.T) {
+	pubkey := bytes.Repeat([]byte{0x11}, ML_DSA_87_PUBKEY_BYTES)
+	signature := append(bytes.Repeat([]byte{0x22}, ML_DSA_87_SIG_BYTES), SIGHASH_ALL)
+	signedTx := txWithOneInputOneOutputAndWitness(SUITE_ID_ML_DSA_87, pubkey, signature)
+	misplaced := coinbaseTxWithOutputs(7, []testOutput{
+		{value: 1, covenantType: COV_TYPE_P2PK, covenantData: validP2PKCovenantData()},
+	})
+	coinbase := coinbaseWithWitnessCommitmentAtHeight(t, 1, misplaced, signedTx)
+
+	root, err := MerkleRootTxids([][32]byte{testTxID(t, coinbase), testTxID(t, misplaced), testTxID(t, signedTx)})
+	if err != nil {
+		t.Fatalf("MerkleRootTxids: %v", err)
+	}
+	prev := hashWithPrefix(0xa5)
+	target := filledHash(0xff)
+	block := buildBlockBytes(t, prev, root, target, 46, [][]byte{coinbase, misplaced, signedTx})
+
+	verifyCalls := 0
+	orig := opensslVerifySigOneShotFn
+	opensslVerifySigOneShotFn = func(_ string, _ []byte, _ []byte, _ []byte) (bool, error) {
+		verifyCalls++
+		return false, nil
+	}
+	defer func() { opensslVerifySigOneShotFn = orig }()
+
+	spent := Outpoint{Vout: 0}
+	state := &InMemoryChainState{
+		Utxos:            map[Outpoint]UtxoEntry{spent: {Value: 100, CovenantType: COV_TYPE_P2PK, CovenantData: p2pkCovenantDataForPubkey(pubkey)}},
+		AlreadyGenerated: big.NewInt(5),
+	}
+	_, err = ConnectBlockBasicInMemoryAtHeight(block, &prev, &target, 1, nil, state, [32]byte{})
+	if want := "BLOCK_ERR_COINBASE_INVALID: coinbase-like tx is only allowed at index 0"; err == nil || err.Error() != want {
+		t.Fatalf("err=%v, want %s", err, want)
+	}
+	if verifyCalls != 0 {
+		t.Fatalf("signature verification ran %d times", verifyCalls)
+	}
+	if _, ok := state.Utxos[spent]; !ok || len(state.Utxos) != 1 {
+		t.Fatalf("utxo set mutated: %v", state.Utxos)
+	}
+	if state.AlreadyGenerated.Cmp(big.NewInt(5)) != 0 {
+		t.Fatalf("already_generated=%s, want 5", state.AlreadyGenerated)
+	}
+}
+
+// TestConnectBlock_TimestampLockUsesBlockMTP connects a block whose own
+// timestamp is past a timestamp-mode HTLC refund lock while its MTP (from
+// prevTimestamps) is not. Both connect paths must enforce the lock against
+// the MTP; once the MTP reaches lock_value only the dummy signature fails.
+func TestConnectBlock_TimestampLockUsesBlockMTP(t *testing.T) {
+	const lock = 2000
+	const headerTimestamp = lock + 500
+
+	prevOut := Outpoint{Txid: hashWithPrefix(0xc7), Vout: 0}
+	_, refundPub, claimKeyID, refundKeyID := makeMLKeyMaterial(0x46)
+	spend := txBytesFromTx(t, &Tx{
+		Version: 1,
+		TxNonce: 1,
+		Inputs:  []TxInput{{PrevTxid: prevOut.Txid, PrevVout: prevOut.Vout}},
+		Outputs: []TxOutput{{Value: 90, CovenantType: COV_TYPE_P2PK, CovenantData: validP2PKCovenantData()}},
+		Witness: []WitnessItem{
+			{SuiteID: SUITE_ID_SENTINEL, Pubkey: refundKeyID[:], Signature: []byte{0x01}},
+			{SuiteID: SUITE_ID_ML_DSA_87, Pubkey: refundPub, Signature: dummyMLSignature(SIGHASH_ALL)},
+		},
+	})
+
+	prev := hashWithPrefix(0xc8)
+	target := filledHash(0xff)
+	block := blockWithRoot(t, prev, target, 1, false, coinbaseWithWitnessCommitmentAtHeight(t, 1, spend), spend)
+	binary.LittleEndian.PutUint64(block[4+32+32:], headerTimestamp)
+
+	connect := map[string]func(prevTimestamps []uint64, state *InMemoryChainState) error{
+		"inmem": func(prevTimestamps []uint64, state *InMemoryChainState) error {
+			_, err := ConnectBlockBasicInMemoryAtHeight(block, &prev, &target, 1, prevTimestamps, state, [32]byte{})
+			return err
+		},
+		"parallel": func(prevTimestamps []uint64, state *InMemoryChainState) error {
+			_, err := ConnectBlockParallelSigVerify(block, &prev, &target, 1, prevTimestamps, state, [32]byte{}, 1)
+			return err
+		},
+	}
+	for name, run := range connect {
+		t.Run(name, func(t *testing.T) {
+			newState := func() *InMemoryChainState {
+				return &InMemoryChainState{Utxos: map[Outpoint]UtxoEntry{
+					prevOut: makeHTLCEntry(sha3_256([]byte("preimage")), LOCK_MODE_TIMESTAMP, lock, claimKeyID, refundKeyID),
+				}}
+			}
+
+			if got := mustTxErrCode(t, run([]uint64{lock - 1}, newState())); got != TX_ERR_TIMELOCK_NOT_MET {
+				t.Fatalf("MTP below lock: code=%s, want %s", got, TX_ERR_TIMELOCK_NOT_MET)
+			}
+
+			err := run([]uint64{lock}, newState())
+			if err == nil {
+				t.Fatalf("expected dummy signature to fail")
+			}
+			if got := mustTxErrCode(t, err); got == TX_ERR_TIMELOCK_NOT_MET {
+				t.Fatalf("MTP at lock: code=%s", got)
+			}
+		})
+	}
+}
@@ -56,6 +56,49 @@ func ConnectBlockParallelSigVerifyWithSuiteContext(
 	rotation RotationProvider,
 	registry *SuiteRegistry,
 	workers int,
+) (*ConnectBlockBasicSummary, error) {
+	return connectBlockDeferredSigs(
+		blockBytes, expectedPrevHash, expectedTarget, blockHeight, prevTimestamps,
+		state, chainID, rotation, registry, workers, true,
+	)
+}
+
+// ConnectBlockAssumeValidWithSuiteContext connects a block without verifying
+// its signatures. Nothing here checks that the block is an ancestor of an
+// assumevalid block; the caller must establish that. It runs every check of
+// ConnectBlockParallelSigVerifyWithSuiteContext (wire, PoW, UTXO, covenant,
+// witness binding, value and subsidy) but discards the deferred signature
+// queue instead of flushing it. Never use it for blocks above the trusted
+// point: an invalid signature is accepted here.
+func ConnectBlockAssumeValidWithSuiteContext(
+	blockBytes []byte,
+	expectedPrevHash *[32]byte,
+	expectedTarget *[32]byte,
+	blockHeight uint64,
+	prevTimestamps []uint64,
+	state *InMemoryChainState,
+	chainID [32]byte,
+	rotation RotationProvider,
+	registry *SuiteRegistry,
+) (*ConnectBlockBasicSummary, error) {
+	return connectBlockDeferredSigs(
+		blockBytes, expectedPrevHash, expectedTarget, blockHeight, prevTimestamps,
+		state, chainID, rotation, registry, 1, false,
+	)
+}
+
+func connectBlockDeferredSigs(
+	blockBytes []byte,
+	expectedPrevHash *[32]byte,
+	expectedTarget *[32]byte,
+	blockHeight uint64,
+	prevTimestamps []uint64,
+	state *InMemoryChainState,
+	chainID [32]byte,
+	rotation RotationProvider,
+	registry *SuiteRegistry,
+	workers int,
+	verifySigs bool,
 ) (*ConnectBlockBasicSummary, error) {
 	if state == nil {
 		return nil, txerr(BLOCK_ERR_PARSE, "nil chainstate")
@@ -147,14 +190,16 @@ func ConnectBlockParallelSigVerifyWithSuiteContext(
 
 	// Flush the signature queue: verify all collected signatures in parallel.
 	// Returns the first error by submission order (deterministic within the
-	// deferred-sig model).
-	if err := sigQueue.Flush(); err != nil {
-		return nil, err
+	// deferred-sig model). Assumevalid connects drop the queue unverified.
+	if verifySigs {
+		if err := sigQueue.Flush(); err != nil {
+			return nil, err
+		}
 	}
 	workerPanics := sigQueue.Panics()
 
 	// Enforce coinbase bound using locally computed fees.
-	if err := validateCoinbaseValueBound(pb, blockHeight, alreadyGenerated, sumFees); err != nil {
+	if err := validateCoinbaseValueBound(pb, blockHeight, alreadyGenerated, sumFees, state.GenesisCoinbaseValue); err != nil {
 		return nil, err
 	}
 	if err := validateCoinbaseApplyOutputs(pb.Txs[0]); err != nil {
@@ -19,10 +19,14 @@ const (
 	TAIL_EMISSION_PER_BLOCK = 19_025_875
 
 	MAX_BLOCK_WEIGHT        = 68_000_000
+	MAX_BLOCK_VERIFY_COST   = 1_000_000  // summed witness verify cost; bounds cheap-bytes/high-verify blocks
 	MAX_BLOCK_BYTES         = 72_000_000 // operational P2P cap; not a consensus validity bound
 	MAX_DA_BYTES_PER_BLOCK  = 32_000_000
 	MIN_DA_RETENTION_BLOCKS = 15_120
 	MAX_RELAY_MSG_BYTES     = 96_000_000
+	// Derived parse bound: the smallest tx weighs 78, so a block declaring
+	// more txs than this always exceeds MAX_BLOCK_WEIGHT.
+	MAX_BLOCK_TX_COUNT = MAX_BLOCK_WEIGHT / 78
 
 	MAX_DA_MANIFEST_BYTES_PER_TX  = 65_536
 	CHUNK_BYTES                   = 524_288
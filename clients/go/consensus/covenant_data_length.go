@@ -0,0 +1,68 @@
+package consensus
+
+// Fixed framing of the variable-length covenants: CORE_VAULT is
+// owner_lock_id(32) || threshold(1) || key_count(1) || keys || whitelist_count(2) || whitelist,
+// CORE_MULTISIG is threshold(1) || key_count(1) || keys, and CORE_SIMPLICITY is
+// program_cmr(32) || compact_size(state_len) || state.
+const (
+	vaultCovenantFixedBytes      = 32 + 1 + 1 + 2
+	multisigCovenantFixedBytes   = 1 + 1
+	simplicityCovenantFixedBytes = 32
+)
+
+// CovenantDataLengthValid reports whether length is a covenant_data length
+// covType can take. It is the single length table for output creation and
+// spend. Fixed-size covenants must match exactly. For CORE_VAULT,
+// CORE_MULTISIG and CORE_SIMPLICITY it accepts every length some in-range key,
+// whitelist or state count produces; their parsers still match the length
+// against the counts actually encoded.
+func CovenantDataLengthValid(covType uint16, length int) bool {
+	if length < 0 {
+		return false
+	}
+	switch covType {
+	case COV_TYPE_P2PK:
+		return length == MAX_P2PK_COVENANT_DATA
+	case COV_TYPE_ANCHOR:
+		return length > 0 && length <= MAX_ANCHOR_PAYLOAD_SIZE
+	case COV_TYPE_DA_COMMIT:
+		return length == 32
+	case COV_TYPE_HTLC:
+		return length == MAX_HTLC_COVENANT_DATA
+	case COV_TYPE_CORE_STEALTH:
+		return length == MAX_STEALTH_COVENANT_DATA
+	case COV_TYPE_VAULT:
+		entries, ok := covenantDataEntryCount(length, vaultCovenantFixedBytes)
+		return ok && entries >= 2 && entries <= MAX_VAULT_KEYS+MAX_VAULT_WHITELIST_ENTRIES
+	case COV_TYPE_MULTISIG:
+		keys, ok := covenantDataEntryCount(length, multisigCovenantFixedBytes)
+		return ok && keys >= 1 && keys <= MAX_MULTISIG_KEYS
+	case COV_TYPE_CORE_SIMPLICITY:
+		return simplicityCovenantDataLengthValid(length)
+	default:
+		return false
+	}
+}
+
+// covenantDataEntryCount returns how many 32-byte entries follow fixed framing
+// bytes in a covenant_data of length, if it divides evenly.
+func covenantDataEntryCount(length int, fixed int) (int, bool) {
+	if length < fixed || (length-fixed)%32 != 0 {
+		return 0, false
+	}
+	return (length - fixed) / 32, true
+}
+
+func simplicityCovenantDataLengthValid(length int) bool {
+	rest := length - simplicityCovenantFixedBytes
+	for _, prefix := range []int{1, 3, 5, 9} {
+		stateLen := rest - prefix
+		if stateLen < 0 || uint64(stateLen) > MAX_SIMPLICITY_STATE_BYTES {
+			continue
+		}
+		if compactSizeLen(uint64(stateLen)) == uint64(prefix) {
+			return true
+		}
+	}
+	return false
+}
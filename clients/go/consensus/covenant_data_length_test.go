@@ -0,0 +1,169 @@
+package consensus
+
+import (
+	"encoding/binary"
+	"testing"
+)
+
+// covenantDataOfLength returns covenant_data of exactly length bytes that is
+// well-formed whenever CovenantDataLengthValid accepts the length, so creation
+// and spend can only disagree with the table on length itself.
+func covenantDataOfLength(covType uint16, length int) []byte {
+	data := make([]byte, length)
+	sortedEntry := func(off int, i int) {
+		binary.BigEndian.PutUint16(data[off:off+2], uint16(i+1))
+	}
+	switch covType {
+	case COV_TYPE_P2PK:
+		if length > 0 {
+			data[0] = SUITE_ID_ML_DSA_87
+		}
+	case COV_TYPE_HTLC:
+		if length >= MAX_HTLC_COVENANT_DATA {
+			data[32] = LOCK_MODE_HEIGHT
+			data[33] = 1 // lock_value
+			data[41] = 1 // claim_key_id differs from refund_key_id
+		}
+	case COV_TYPE_MULTISIG:
+		if length >= multisigCovenantFixedBytes+32 {
+			keys := min((length-multisigCovenantFixedBytes)/32, 255)
+			data[0], data[1] = 1, byte(keys)
+			for i := 0; i < keys; i++ {
+				sortedEntry(multisigCovenantFixedBytes+32*i, i)
+			}
+		}
+	case COV_TYPE_VAULT:
+		if length >= vaultCovenantFixedBytes+64 {
+			entries := (length - vaultCovenantFixedBytes) / 32
+			keys := min(entries-1, MAX_VAULT_KEYS)
+			whitelist := entries - keys
+			for i := range 32 {
+				data[i] = 0xff // owner_lock_id sorts after every whitelist entry
+			}
+			data[32], data[33] = 1, byte(keys)
+			off := 34
+			for i := 0; i < keys; i++ {
+				sortedEntry(off, i)
+				off += 32
+			}
+			binary.LittleEndian.PutUint16(data[off:off+2], uint16(whitelist))
+			off += 2
+			for i := 0; i < whitelist && off+32 <= length; i++ {
+				sortedEntry(off, i)
+				off += 32
+			}
+		}
+	case COV_TYPE_CORE_SIMPLICITY:
+		if rest := length - simplicityCovenantFixedBytes; rest > 0 {
+			stateLen := rest - 1
+			if stateLen >= 0xfd {
+				stateLen = rest - 3
+			}
+			if stateLen >= 0 {
+				data = AppendCompactSize(data[:simplicityCovenantFixedBytes], uint64(stateLen))
+				data = append(data, make([]byte, length-len(data))...)
+			}
+		}
+	}
+	return data
+}
+
+func creationAcceptsCovenantData(t *testing.T, covType uint16, data []byte) bool {
+	t.Helper()
+	if covType == COV_TYPE_CORE_SIMPLICITY {
+		// Creation parses the covenant only once the deployment gate passes.
+		_, _, err := parseCoreSimplicityCovenantData(1, data)
+		return err == nil
+	}
+	out := TxOutput{Value: 1, CovenantType: covType, CovenantData: data}
+	if covType == COV_TYPE_ANCHOR || covType == COV_TYPE_DA_COMMIT {
+		out.Value = 0
+	}
+	_, _, err := validateTxOutputCovenantGenesis(0x01, out, [32]byte{}, 0, DefaultRotationProvider{}, nil)
+	return err == nil
+}
+
+func spendAcceptsCovenantData(t *testing.T, covType uint16, data []byte) bool {
+	t.Helper()
+	switch covType {
+	case COV_TYPE_P2PK:
+		// A canonical witness under the wrong key gets past every covenant_data
+		// check and fails key binding instead.
+		err := validateP2PKSpendAtHeight(p2pkSpendCheck{
+			entry: UtxoEntry{Value: 1, CovenantType: covType, CovenantData: data},
+			witness: WitnessItem{
+				SuiteID:   SUITE_ID_ML_DSA_87,
+				Pubkey:    make([]byte, ML_DSA_87_PUBKEY_BYTES),
+				Signature: make([]byte, ML_DSA_87_SIG_BYTES+1),
+			},
+		})
+		if err == nil {
+			t.Fatalf("P2PK spend with a mismatched key unexpectedly passed")
+		}
+		return mustTxErrCode(t, err) != TX_ERR_COVENANT_TYPE_INVALID
+	case COV_TYPE_CORE_SIMPLICITY:
+		_, _, err := parseCoreSimplicityCovenantData(1, data)
+		return err == nil
+	default:
+		return checkSpendCovenant(covType, data) == nil
+	}
+}
+
+func TestCovenantDataLengthValid_CreationAndSpendAgree(t *testing.T) {
+	maxVault := vaultCovenantFixedBytes + 32*(MAX_VAULT_KEYS+MAX_VAULT_WHITELIST_ENTRIES)
+	extra := map[uint16][]int{
+		COV_TYPE_ANCHOR:          {MAX_ANCHOR_PAYLOAD_SIZE, MAX_ANCHOR_PAYLOAD_SIZE + 1},
+		COV_TYPE_CORE_STEALTH:    {MAX_STEALTH_COVENANT_DATA - 1, MAX_STEALTH_COVENANT_DATA, MAX_STEALTH_COVENANT_DATA + 1},
+		COV_TYPE_VAULT:           {maxVault - 1, maxVault, maxVault + 32},
+		COV_TYPE_MULTISIG:        {multisigCovenantFixedBytes + 32*MAX_MULTISIG_KEYS, multisigCovenantFixedBytes + 32*(MAX_MULTISIG_KEYS+1)},
+		COV_TYPE_CORE_SIMPLICITY: {simplicityCovenantFixedBytes + 3 + MAX_SIMPLICITY_STATE_BYTES, simplicityCovenantFixedBytes + 3 + MAX_SIMPLICITY_STATE_BYTES + 1},
+	}
+	spendable := map[uint16]bool{
+		COV_TYPE_P2PK:            true,
+		COV_TYPE_HTLC:            true,
+		COV_TYPE_CORE_STEALTH:    true,
+		COV_TYPE_VAULT:           true,
+		COV_TYPE_MULTISIG:        true,
+		COV_TYPE_CORE_SIMPLICITY: true,
+	}
+	for _, covType := range []uint16{
+		COV_TYPE_P2PK, COV_TYPE_ANCHOR, COV_TYPE_DA_COMMIT, COV_TYPE_HTLC,
+		COV_TYPE_CORE_STEALTH, COV_TYPE_VAULT, COV_TYPE_MULTISIG, COV_TYPE_CORE_SIMPLICITY,
+	} {
+		lengths := extra[covType]
+		for length := 0; length <= 300; length++ {
+			lengths = append(lengths, length)
+		}
+		accepted := 0
+		for _, length := range lengths {
+			data := covenantDataOfLength(covType, length)
+			want := CovenantDataLengthValid(covType, length)
+			if want {
+				accepted++
+			}
+			if got := creationAcceptsCovenantData(t, covType, data); got != want {
+				t.Fatalf("covenant_type=0x%04x len=%d: creation accepts=%v, table=%v", covType, length, got, want)
+			}
+			if !spendable[covType] {
+				continue
+			}
+			if got := spendAcceptsCovenantData(t, covType, data); got != want {
+				t.Fatalf("covenant_type=0x%04x len=%d: spend accepts=%v, table=%v", covType, length, got, want)
+			}
+		}
+		if accepted == 0 {
+			t.Fatalf("covenant_type=0x%04x: no accepted length exercised", covType)
+		}
+	}
+}
+
+func TestCovenantDataLengthValid_RejectsUnknownAndNegative(t *testing.T) {
+	for _, covType := range []uint16{COV_TYPE_RESERVED_FUTURE, 0xffff} {
+		if CovenantDataLengthValid(covType, MAX_P2PK_COVENANT_DATA) {
+			t.Fatalf("covenant_type=0x%04x accepted", covType)
+		}
+	}
+	if CovenantDataLengthValid(COV_TYPE_ANCHOR, -1) {
+		t.Fatalf("negative length accepted")
+	}
+}
@@ -85,7 +85,7 @@ func validateP2PKGenesisOutput(out TxOutput, blockHeight uint64, rotation Rotati
 	if out.Value == 0 {
 		return txerr(TX_ERR_COVENANT_TYPE_INVALID, "CORE_P2PK value must be > 0")
 	}
-	if len(out.CovenantData) != MAX_P2PK_COVENANT_DATA {
+	if !CovenantDataLengthValid(COV_TYPE_P2PK, len(out.CovenantData)) {
 		return txerr(TX_ERR_COVENANT_TYPE_INVALID, "invalid CORE_P2PK covenant_data length")
 	}
 	suiteID := out.CovenantData[0]
@@ -99,8 +99,7 @@ func validateAnchorGenesisOutput(out TxOutput) error {
 	if out.Value != 0 {
 		return txerr(TX_ERR_COVENANT_TYPE_INVALID, "CORE_ANCHOR value must be 0")
 	}
-	covLen := len(out.CovenantData)
-	if covLen == 0 || covLen > MAX_ANCHOR_PAYLOAD_SIZE {
+	if !CovenantDataLengthValid(COV_TYPE_ANCHOR, len(out.CovenantData)) {
 		return txerr(TX_ERR_COVENANT_TYPE_INVALID, "invalid CORE_ANCHOR covenant_data length")
 	}
 	return nil
@@ -160,7 +159,7 @@ func validateDACommitGenesisOutput(txKind byte, out TxOutput) error {
 	if out.Value != 0 {
 		return txerr(TX_ERR_COVENANT_TYPE_INVALID, "CORE_DA_COMMIT value must be 0")
 	}
-	if len(out.CovenantData) != 32 {
+	if !CovenantDataLengthValid(COV_TYPE_DA_COMMIT, len(out.CovenantData)) {
 		return txerr(TX_ERR_COVENANT_TYPE_INVALID, "invalid CORE_DA_COMMIT covenant_data length")
 	}
 	return nil
@@ -0,0 +1,66 @@
+package consensus
+
+// CovenantWitnessSuitePermitted reports whether a witness item of suiteID may
+// authorize a spend of covType, using the same suite gates as the spend
+// validators, before any length, key-binding or signature check. It returns
+// nil when permitted and the error the spend path raises otherwise. For
+// CORE_HTLC it covers the signature item; the path selector item is always the
+// sentinel. A nil nativeSpend or registry means the pre-rotation defaults.
+func CovenantWitnessSuitePermitted(covType uint16, suiteID uint8, nativeSpend *NativeSuiteSet, registry *SuiteRegistry) error {
+	if nativeSpend == nil {
+		nativeSpend = DefaultRotationProvider{}.NativeSpendSuites(0)
+	}
+	if registry == nil {
+		registry = DefaultSuiteRegistry()
+	}
+	switch covType {
+	case COV_TYPE_P2PK:
+		return nativeWitnessSuitePermitted("CORE_P2PK", suiteID, nativeSpend, registry)
+	case COV_TYPE_HTLC:
+		return nativeWitnessSuitePermitted("CORE_HTLC", suiteID, nativeSpend, registry)
+	case COV_TYPE_CORE_STEALTH:
+		return nativeWitnessSuitePermitted("CORE_STEALTH", suiteID, nativeSpend, registry)
+	case COV_TYPE_MULTISIG, COV_TYPE_VAULT:
+		// Threshold slots accept a keyless sentinel as "no signature".
+		if suiteID == SUITE_ID_SENTINEL {
+			return nil
+		}
+		context := "CORE_MULTISIG"
+		if covType == COV_TYPE_VAULT {
+			context = "CORE_VAULT"
+		}
+		return nativeWitnessSuitePermitted(context, suiteID, nativeSpend, registry)
+	case COV_TYPE_CORE_SIMPLICITY:
+		return simplicityWitnessSuitePermitted(suiteID)
+	default:
+		return txerr(TX_ERR_COVENANT_TYPE_INVALID, "unsupported covenant in basic apply")
+	}
+}
+
+// nativeWitnessSuite is the suite gate every native spend validator applies
+// to a signing witness item before its length, key-binding and signature
+// checks: the suite must be in the native spend set and registered. It
+// returns the suite's registered parameters.
+func nativeWitnessSuite(context string, suiteID uint8, nativeSpend *NativeSuiteSet, registry *SuiteRegistry) (SuiteParams, error) {
+	if !nativeSpend.Contains(suiteID) {
+		return SuiteParams{}, txerr(TX_ERR_SIG_ALG_INVALID, context+" suite not in native spend set")
+	}
+	params, ok := registry.Lookup(suiteID)
+	if !ok {
+		return SuiteParams{}, txerr(TX_ERR_SIG_ALG_INVALID, context+" suite not registered")
+	}
+	return params, nil
+}
+
+func nativeWitnessSuitePermitted(context string, suiteID uint8, nativeSpend *NativeSuiteSet, registry *SuiteRegistry) error {
+	_, err := nativeWitnessSuite(context, suiteID, nativeSpend, registry)
+	return err
+}
+
+// simplicityWitnessSuitePermitted is the CORE_SIMPLICITY envelope suite gate.
+func simplicityWitnessSuitePermitted(suiteID uint8) error {
+	if suiteID != SUITE_ID_SIMPLICITY_ENVELOPE {
+		return txerr(TX_ERR_SIG_ALG_INVALID, "CORE_SIMPLICITY witness suite must be 0xF0")
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package consensus
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCovenantWitnessSuitePermitted_Table(t *testing.T) {
+	const (
+		sentinel = SUITE_ID_SENTINEL
+		mldsa    = SUITE_ID_ML_DSA_87
+		slh      = testSuiteIDSLHDSASHAKE256f
+		envelope = SUITE_ID_SIMPLICITY_ENVELOPE
+	)
+	type want struct {
+		code ErrorCode // empty when permitted
+	}
+	ok := want{}
+	sigAlg := want{TX_ERR_SIG_ALG_INVALID}
+	covType := want{TX_ERR_COVENANT_TYPE_INVALID}
+
+	cases := []struct {
+		name    string
+		covType uint16
+		suites  map[uint8]want
+	}{
+		{"P2PK", COV_TYPE_P2PK, map[uint8]want{sentinel: sigAlg, mldsa: ok, slh: sigAlg, envelope: sigAlg}},
+		{"HTLC", COV_TYPE_HTLC, map[uint8]want{sentinel: sigAlg, mldsa: ok, slh: sigAlg, envelope: sigAlg}},
+		{"STEALTH", COV_TYPE_CORE_STEALTH, map[uint8]want{sentinel: sigAlg, mldsa: ok, slh: sigAlg, envelope: sigAlg}},
+		{"MULTISIG", COV_TYPE_MULTISIG, map[uint8]want{sentinel: ok, mldsa: ok, slh: sigAlg, envelope: sigAlg}},
+		{"VAULT", COV_TYPE_VAULT, map[uint8]want{sentinel: ok, mldsa: ok, slh: sigAlg, envelope: sigAlg}},
+		{"SIMPLICITY", COV_TYPE_CORE_SIMPLICITY, map[uint8]want{sentinel: sigAlg, mldsa: sigAlg, slh: sigAlg, envelope: ok}},
+		{"ANCHOR", COV_TYPE_ANCHOR, map[uint8]want{sentinel: covType, mldsa: covType, slh: covType, envelope: covType}},
+		{"DA_COMMIT", COV_TYPE_DA_COMMIT, map[uint8]want{sentinel: covType, mldsa: covType, slh: covType, envelope: covType}},
+		{"UNKNOWN", 0xffff, map[uint8]want{sentinel: covType, mldsa: covType, slh: covType, envelope: covType}},
+	}
+	for _, tc := range cases {
+		for suiteID, w := range tc.suites {
+			err := CovenantWitnessSuitePermitted(tc.covType, suiteID, nil, nil)
+			if w.code == "" {
+				if err != nil {
+					t.Fatalf("%s suite=0x%02x: unexpected error %v", tc.name, suiteID, err)
+				}
+				continue
+			}
+			if err == nil {
+				t.Fatalf("%s suite=0x%02x: expected %s, got permitted", tc.name, suiteID, w.code)
+			}
+			if got := mustTxErrCode(t, err); got != w.code {
+				t.Fatalf("%s suite=0x%02x: code=%s, want %s", tc.name, suiteID, got, w.code)
+			}
+		}
+	}
+}
+
+func TestCovenantWitnessSuitePermitted_FollowsRotationContext(t *testing.T) {
+	registry := NewSuiteRegistryFromParams([]SuiteParams{
+		{SuiteID: SUITE_ID_ML_DSA_87, PubkeyLen: ML_DSA_87_PUBKEY_BYTES, SigLen: ML_DSA_87_SIG_BYTES, VerifyCost: VERIFY_COST_ML_DSA_87, AlgName: "ML-DSA-87"},
+		{SuiteID: testSuiteIDSLHDSASHAKE256f, PubkeyLen: SLH_DSA_SHAKE_256F_PUBKEY_BYTES, SigLen: SLH_DSA_SHAKE_256F_SIG_BYTES, VerifyCost: VERIFY_COST_UNKNOWN_SUITE, AlgName: "SLH-DSA-SHAKE-256f"},
+	})
+	rotation := DescriptorRotationProvider{Descriptor: CryptoRotationDescriptor{
+		Name:        "slh",
+		OldSuiteID:  SUITE_ID_ML_DSA_87,
+		NewSuiteID:  testSuiteIDSLHDSASHAKE256f,
+		SpendHeight: math.MaxUint64,
+	}}
+	nativeSpend := rotation.NativeSpendSuites(0)
+	for _, covType := range []uint16{COV_TYPE_P2PK, COV_TYPE_HTLC, COV_TYPE_CORE_STEALTH, COV_TYPE_MULTISIG, COV_TYPE_VAULT} {
+		if err := CovenantWitnessSuitePermitted(covType, testSuiteIDSLHDSASHAKE256f, nativeSpend, registry); err != nil {
+			t.Fatalf("covenant_type=0x%04x: SLH-DSA rejected once native and registered: %v", covType, err)
+		}
+		// Native but unregistered still fails closed.
+		err := CovenantWitnessSuitePermitted(covType, testSuiteIDSLHDSASHAKE256f, nativeSpend, DefaultSuiteRegistry())
+		if err == nil || mustTxErrCode(t, err) != TX_ERR_SIG_ALG_INVALID {
+			t.Fatalf("covenant_type=0x%04x: unregistered SLH-DSA err=%v", covType, err)
+		}
+	}
+	if err := CovenantWitnessSuitePermitted(COV_TYPE_CORE_SIMPLICITY, testSuiteIDSLHDSASHAKE256f, nativeSpend, registry); err == nil {
+		t.Fatalf("CORE_SIMPLICITY accepted a non-envelope suite")
+	}
+}
@@ -538,6 +538,41 @@ func TestValidateBlockBasic_DA_CapsBeforeIntegrityChecks(t *testing.T) {
 	}
 }
 
+func TestValidateBlockBasic_DA_BytesExceeded(t *testing.T) {
+	// Two well-formed DA sets of full chunks: MAX_DA_CHUNK_COUNT+1 chunks put
+	// the block's DA bytes just over MAX_DA_BYTES_PER_BLOCK while its weight
+	// stays under MAX_BLOCK_WEIGHT.
+	payload := bytes.Repeat([]byte{0x55}, int(CHUNK_BYTES))
+	chunkHash := sha3_256(payload)
+	setSizes := []int{int(MAX_DA_CHUNK_COUNT), 1}
+	if uint64(setSizes[0]+setSizes[1])*CHUNK_BYTES <= MAX_DA_BYTES_PER_BLOCK {
+		t.Fatalf("fixture does not exceed MAX_DA_BYTES_PER_BLOCK")
+	}
+
+	var txs [][]byte
+	nonce := uint64(1)
+	for set, chunkCount := range setSizes {
+		daID := filled32(byte(0xd0 + set))
+		commitment := sha3_256(bytes.Repeat(payload, chunkCount))
+		txs = append(txs, daCommitTxBytes(nonce, daID, uint16(chunkCount), commitment))
+		nonce++
+		for i := 0; i < chunkCount; i++ {
+			txs = append(txs, daChunkTxBytes(nonce, daID, uint16(i), chunkHash, payload))
+			nonce++
+		}
+	}
+	block, prev, target := buildDABlockBytes(t, txs...)
+
+	_, err := ValidateBlockBasic(block, &prev, &target)
+	if err == nil {
+		t.Fatalf("expected error")
+	}
+	te, ok := err.(*TxError)
+	if !ok || te.Code != BLOCK_ERR_WEIGHT_EXCEEDED || te.Msg != "DA bytes exceeded" {
+		t.Fatalf("err=%v, want %s DA bytes exceeded", err, BLOCK_ERR_WEIGHT_EXCEEDED)
+	}
+}
+
 func TestValidateBlockBasic_DA_CompletenessPriorityOverPayloadMismatch(t *testing.T) {
 	daIncomplete := filled32(0xcc)
 	daPayloadMismatch := filled32(0xcd)
@@ -12,6 +12,27 @@ const (
 	FEATUREBIT_FAILED    FeatureBitState = "FAILED"
 )
 
+// A header signals for deployment bits only when its version's top three
+// bits are 001, which keeps signaling versions distinct from plain ones.
+// Bits 29..31 are part of that pattern and can never signal.
+const (
+	VERSIONBITS_TOP_MASK uint32 = 0xE0000000
+	VERSIONBITS_TOP_BITS uint32 = 0x20000000
+	VERSIONBITS_MAX_BIT  uint8  = 28
+)
+
+// VersionBitsConforming reports whether version carries the signaling
+// top-bit pattern.
+func VersionBitsConforming(version uint32) bool {
+	return version&VERSIONBITS_TOP_MASK == VERSIONBITS_TOP_BITS
+}
+
+// VersionSignalsBit reports whether version signals for bit: the version
+// must conform to the top-bit pattern and have bit set.
+func VersionSignalsBit(version uint32, bit uint8) bool {
+	return bit <= VERSIONBITS_MAX_BIT && VersionBitsConforming(version) && (version>>bit)&1 == 1
+}
+
 type FeatureBitDeployment struct {
 	Name          string
 	Bit           uint8
@@ -28,7 +49,7 @@ type FeatureBitEval struct {
 }
 
 func (d FeatureBitDeployment) Validate() error {
-	if d.Bit > 31 {
+	if d.Bit > VERSIONBITS_MAX_BIT {
 		return txerr(BLOCK_ERR_PARSE, fmt.Sprintf("featurebits: bit out of range: %d", d.Bit))
 	}
 	if d.Name == "" {
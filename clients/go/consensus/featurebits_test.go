@@ -162,4 +162,38 @@ func TestFeatureBits_BitRange(t *testing.T) {
 	if err == nil {
 		t.Fatalf("expected error")
 	}
+	d.Bit = VERSIONBITS_MAX_BIT + 1
+	if err := d.Validate(); err == nil {
+		t.Fatalf("expected error for top-pattern bit %d", d.Bit)
+	}
+	d.Bit = VERSIONBITS_MAX_BIT
+	if err := d.Validate(); err != nil {
+		t.Fatalf("bit %d: %v", d.Bit, err)
+	}
+}
+
+func TestVersionSignalsBitRequiresTopBits(t *testing.T) {
+	const bit = 3
+	if !VersionSignalsBit(VERSIONBITS_TOP_BITS|1<<bit, bit) {
+		t.Fatalf("conforming version with bit %d set must signal", bit)
+	}
+	for _, version := range []uint32{
+		1 << bit,                          // no top bits
+		0x40000000 | 1<<bit,               // 010 prefix
+		0xE0000000 | 1<<bit,               // 111 prefix
+		VERSIONBITS_TOP_BITS | 1<<(bit+1), // other bit only
+	} {
+		if VersionSignalsBit(version, bit) {
+			t.Fatalf("version %#x must not signal bit %d", version, bit)
+		}
+	}
+	if VersionSignalsBit(VERSIONBITS_TOP_BITS|1<<bit, 0) {
+		t.Fatalf("bit %d signaling version must not count for bit 0", bit)
+	}
+	if VersionSignalsBit(VERSIONBITS_TOP_BITS, 29) {
+		t.Fatalf("top-pattern bit 29 must never signal")
+	}
+	if VersionBitsConforming(1) || !VersionBitsConforming(VERSIONBITS_TOP_BITS|1) {
+		t.Fatalf("unexpected VersionBitsConforming result")
+	}
 }
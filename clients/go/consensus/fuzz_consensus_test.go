@@ -3,6 +3,10 @@ package consensus
 import (
 	"bytes"
 	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
 	"testing"
 )
 
@@ -44,6 +48,37 @@ func minimalBlockBytesForFuzz() []byte {
 	return out
 }
 
+// addFixtureHexSeeds seeds f with every vector field named hexField across the
+// conformance fixtures. Missing fixtures (out-of-repo runs) add nothing.
+func addFixtureHexSeeds(f *testing.F, hexField string) {
+	f.Helper()
+	files, err := filepath.Glob(filepath.Join("..", "..", "..", "conformance", "fixtures", "CV-*.json"))
+	if err != nil {
+		f.Fatalf("glob: %v", err)
+	}
+	for _, path := range files {
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			f.Fatalf("read %s: %v", path, err)
+		}
+		var doc struct {
+			Vectors []map[string]json.RawMessage `json:"vectors"`
+		}
+		if err := json.Unmarshal(raw, &doc); err != nil {
+			f.Fatalf("parse %s: %v", path, err)
+		}
+		for _, v := range doc.Vectors {
+			var value string
+			if json.Unmarshal(v[hexField], &value) != nil {
+				continue
+			}
+			if b, err := hex.DecodeString(value); err == nil {
+				f.Add(b)
+			}
+		}
+	}
+}
+
 func FuzzReadCompactSize(f *testing.F) {
 	f.Add([]byte{0x00})
 	f.Add([]byte{0xfc})
@@ -65,21 +100,72 @@ func FuzzReadCompactSize(f *testing.F) {
 	})
 }
 
+func FuzzCompactSize(f *testing.F) {
+	// One seed per size class, plus non-minimal and truncated encodings.
+	f.Add([]byte{0x00})
+	f.Add([]byte{0xfc})
+	f.Add([]byte{0xfd, 0xfd, 0x00})
+	f.Add([]byte{0xfe, 0x00, 0x00, 0x01, 0x00})
+	f.Add([]byte{0xff, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00})
+	f.Add([]byte{0xfd, 0xfc, 0x00})
+	f.Add([]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0x00, 0x00, 0x00, 0x00})
+	f.Add([]byte{0xfe, 0x00})
+	f.Add([]byte{})
+	f.Fuzz(func(t *testing.T, b []byte) {
+		v, consumed, err := DecodeCompactSize(b)
+		if err != nil {
+			return
+		}
+		enc := EncodeCompactSize(v)
+		if len(enc) != consumed {
+			t.Fatalf("re-encoded length=%d consumed=%d (value=%d)", len(enc), consumed, v)
+		}
+		v2, consumed2, err := DecodeCompactSize(enc)
+		if err != nil {
+			t.Fatalf("DecodeCompactSize(re-encoded %x): %v", enc, err)
+		}
+		if v2 != v || consumed2 != consumed {
+			t.Fatalf("round-trip value=%d/%d consumed=%d/%d", v2, v, consumed2, consumed)
+		}
+		if !bytes.Equal(enc, b[:consumed]) {
+			t.Fatalf("decoded prefix %x differs from canonical encoding %x", b[:consumed], enc)
+		}
+	})
+}
+
 func FuzzParseTx(f *testing.F) {
 	f.Add(minimalTxBytesForFuzz())
+	addFixtureHexSeeds(f, "tx_hex")
 	f.Fuzz(func(t *testing.T, b []byte) {
-		_, _, _, n, err := ParseTx(b)
+		tx, _, _, n, err := ParseTx(b)
 		if err != nil {
 			return
 		}
-		if n != len(b) {
+		if n <= 0 || n > len(b) {
 			t.Fatalf("consumed=%d len=%d", n, len(b))
 		}
+		// Parser/serializer symmetry: the accepted prefix is the canonical
+		// encoding. ParseTx leaves trailing bytes to its caller.
+		out, err := MarshalTx(tx)
+		if err != nil {
+			t.Fatalf("MarshalTx(parsed): %v", err)
+		}
+		if !bytes.Equal(out, b[:n]) {
+			t.Fatalf("re-serialization mismatch:\n got=%x\nwant=%x", out, b[:n])
+		}
 	})
 }
 
 func FuzzParseBlockBytes(f *testing.F) {
 	f.Add(minimalBlockBytesForFuzz())
+	addFixtureHexSeeds(f, "block_hex")
+	// Truncated tx list and malformed header seeds.
+	{
+		full := minimalBlockBytesForFuzz()
+		f.Add(full[:len(full)-1])
+		f.Add(append(append([]byte(nil), full[:BLOCK_HEADER_BYTES]...), 0x02))
+		f.Add(full[:BLOCK_HEADER_BYTES-1])
+	}
 	// tx_count boundary seeds from RUB-351 fuzz contract
 	{
 		base := minimalBlockBytesForFuzz()[:BLOCK_HEADER_BYTES]
@@ -118,6 +204,15 @@ func FuzzParseBlockBytes(f *testing.F) {
 		if pb.TxCount != uint64(len(pb.Txs)) || pb.TxCount != uint64(len(pb.Txids)) || pb.TxCount != uint64(len(pb.Wtxids)) {
 			t.Fatalf("inconsistent tx sizes: tx_count=%d txs=%d txids=%d wtxids=%d", pb.TxCount, len(pb.Txs), len(pb.Txids), len(pb.Wtxids))
 		}
+		// ParseBlockBytes rejects trailing bytes, so the re-serialization must
+		// reproduce the whole input.
+		out, err := MarshalBlock(pb)
+		if err != nil {
+			t.Fatalf("MarshalBlock(parsed): %v", err)
+		}
+		if !bytes.Equal(out, b) {
+			t.Fatalf("re-serialization mismatch:\n got=%x\nwant=%x", out, b)
+		}
 	})
 }
 
@@ -229,7 +229,7 @@ func FuzzAccumulateBlockResourceStats(f *testing.F) {
 		}
 
 		// Invariant: weight monotonicity — N identical txs should produce
-		// sumWeight = N * single_tx_weight (or overflow error).
+		// sumWeight = N * single_tx_weight (or an overflow / weight-cap error).
 		if numTxs > 0 {
 			singlePb := &ParsedBlock{Txs: []*Tx{tx}}
 			singleStats, singleErr := accumulateBlockResourceStats(singlePb)
@@ -1,6 +1,9 @@
 package consensus
 
-import "encoding/binary"
+import (
+	"crypto/subtle"
+	"encoding/binary"
+)
 
 type HTLCCovenant struct {
 	Hash        [32]byte
@@ -11,7 +14,7 @@ type HTLCCovenant struct {
 }
 
 func ParseHTLCCovenantData(covData []byte) (*HTLCCovenant, error) {
-	if len(covData) != MAX_HTLC_COVENANT_DATA {
+	if !CovenantDataLengthValid(COV_TYPE_HTLC, len(covData)) {
 		return nil, txerr(TX_ERR_COVENANT_TYPE_INVALID, "CORE_HTLC covenant_data length mismatch")
 	}
 
@@ -171,8 +174,12 @@ func validateHTLCClaimPath(c *HTLCCovenant, pathSig []byte, pathKeyID [32]byte)
 	if len(pathSig) != 3+preLen {
 		return [32]byte{}, txerr(TX_ERR_PARSE, "CORE_HTLC claim payload length mismatch")
 	}
+	// Key IDs and the covenant hash are public chain data and are compared with
+	// plain equality. The preimage hash is derived from the claim secret, so it
+	// is compared in constant time to avoid leaking a matching prefix.
 	preimage := pathSig[3:]
-	if sha3_256(preimage) != c.Hash {
+	preimageHash := sha3_256(preimage)
+	if subtle.ConstantTimeCompare(preimageHash[:], c.Hash[:]) != 1 {
 		return [32]byte{}, txerr(TX_ERR_SIG_INVALID, "CORE_HTLC claim preimage hash mismatch")
 	}
 	return c.ClaimKeyID, nil
@@ -209,13 +216,9 @@ func validateHTLCSignaturePrecheck(
 	registry *SuiteRegistry,
 ) error {
 	nativeSpend := rotation.NativeSpendSuites(blockHeight)
-	if !nativeSpend.Contains(sigItem.SuiteID) {
-		return txerr(TX_ERR_SIG_ALG_INVALID, "CORE_HTLC suite not in native spend set")
-	}
-
-	params, ok := registry.Lookup(sigItem.SuiteID)
-	if !ok {
-		return txerr(TX_ERR_SIG_ALG_INVALID, "CORE_HTLC suite not registered")
+	params, err := nativeWitnessSuite("CORE_HTLC", sigItem.SuiteID, nativeSpend, registry)
+	if err != nil {
+		return err
 	}
 
 	if len(sigItem.Pubkey) != params.PubkeyLen || len(sigItem.Signature) != params.SigLen+1 {
@@ -233,6 +233,45 @@ func TestApplyNonCoinbaseTxBasic_HTLCUnknownPath(t *testing.T) {
 	}
 }
 
+// TestApplyNonCoinbaseTxBasicWithMTP_RefundTimestampLockUsesMTP splits the
+// block timestamp and MTP around a timestamp-mode HTLC refund lock: only MTP
+// decides whether TX_ERR_TIMELOCK_NOT_MET fires.
+func TestApplyNonCoinbaseTxBasicWithMTP_RefundTimestampLockUsesMTP(t *testing.T) {
+	var chainID [32]byte
+	prev := hashWithPrefix(0xa2)
+	txBytes := txWithOneInputOneOutput(prev, 0, 90, COV_TYPE_P2PK, validP2PKCovenantData())
+	tx, txid := mustParseTxForUtxo(t, txBytes)
+
+	_, refundPub, claimKeyID, refundKeyID := makeMLKeyMaterial(0x45)
+	tx.Witness = []WitnessItem{
+		{SuiteID: SUITE_ID_SENTINEL, Pubkey: refundKeyID[:], Signature: []byte{0x01}},
+		{SuiteID: SUITE_ID_ML_DSA_87, Pubkey: refundPub, Signature: dummyMLSignature(SIGHASH_ALL)},
+	}
+	const lock = 2000
+	utxos := map[Outpoint]UtxoEntry{
+		{Txid: prev, Vout: 0}: makeHTLCEntry(sha3_256([]byte("preimage")), LOCK_MODE_TIMESTAMP, lock, claimKeyID, refundKeyID),
+	}
+
+	// Block timestamp past the lock, MTP before it: the lock holds.
+	_, err := ApplyNonCoinbaseTxBasicWithMTP(tx, txid, utxos, 100, lock+1000, lock-1000, chainID)
+	if err == nil {
+		t.Fatalf("expected error")
+	}
+	if got := mustTxErrCode(t, err); got != TX_ERR_TIMELOCK_NOT_MET {
+		t.Fatalf("code=%s, want %s", got, TX_ERR_TIMELOCK_NOT_MET)
+	}
+
+	// MTP past the lock, block timestamp before it: the lock is satisfied and
+	// validation moves on to the (dummy) signature.
+	_, err = ApplyNonCoinbaseTxBasicWithMTP(tx, txid, utxos, 100, lock-1000, lock, chainID)
+	if err == nil {
+		t.Fatalf("expected dummy signature to fail")
+	}
+	if got := mustTxErrCode(t, err); got == TX_ERR_TIMELOCK_NOT_MET {
+		t.Fatalf("code=%s with MTP at lock_value", got)
+	}
+}
+
 func TestParseHTLCCovenantData_Nil(t *testing.T) {
 	_, err := ParseHTLCCovenantData(nil)
 	if err == nil {
@@ -761,3 +800,33 @@ func TestHTLCSpend_ActiveSuite_Accepted(t *testing.T) {
 		t.Fatalf("should NOT fail with TX_ERR_SIG_ALG_INVALID at height 3 (pre-sunset), got: %v", err)
 	}
 }
+
+func TestValidateHTLCClaimPath_PreimageConstantTimeCompare(t *testing.T) {
+	preimage := []byte("rubin-htlc-secret-preimage")
+	_, _, claimKeyID, refundKeyID := makeMLKeyMaterial(0x44)
+	c := &HTLCCovenant{
+		Hash:        sha3_256(preimage),
+		LockMode:    LOCK_MODE_HEIGHT,
+		LockValue:   1,
+		ClaimKeyID:  claimKeyID,
+		RefundKeyID: refundKeyID,
+	}
+
+	got, err := validateHTLCClaimPath(c, encodeHTLCClaimPayload(preimage), claimKeyID)
+	if err != nil {
+		t.Fatalf("correct preimage rejected: %v", err)
+	}
+	if got != claimKeyID {
+		t.Fatalf("key_id=%x, want claim key_id %x", got, claimKeyID)
+	}
+
+	wrong := append([]byte(nil), preimage...)
+	wrong[len(wrong)-1] ^= 0x01
+	_, err = validateHTLCClaimPath(c, encodeHTLCClaimPayload(wrong), claimKeyID)
+	if err == nil {
+		t.Fatalf("expected error for incorrect preimage")
+	}
+	if got := mustTxErrCode(t, err); got != TX_ERR_SIG_INVALID {
+		t.Fatalf("code=%s, want %s", got, TX_ERR_SIG_INVALID)
+	}
+}
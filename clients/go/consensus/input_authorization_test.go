@@ -0,0 +1,67 @@
+package consensus
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateInputAuthorizations_ReportsEachInput(t *testing.T) {
+	kp := mustMLDSA87Keypair(t)
+	tx, _, _, chainID := testSighashContextTx()
+	tx.Inputs = append(tx.Inputs, TxInput{PrevTxid: [32]byte{0x43}})
+	entry := UtxoEntry{Value: 5, CovenantType: COV_TYPE_P2PK, CovenantData: p2pkCovenantDataForPubkey(kp.PubkeyBytes())}
+	// Input 1 carries a signature over input 0's digest.
+	w0 := signP2PKInputWitness(t, tx, 0, entry.Value, chainID, kp)
+	tx.Witness = []WitnessItem{w0, w0}
+
+	errs, err := ValidateInputAuthorizations(tx, []InputAuthorization{
+		{InputIndex: 0, Entry: entry, WitnessStart: 0},
+		{InputIndex: 1, Entry: entry, WitnessStart: 1},
+	}, InputAuthorizationContext{ChainID: chainID})
+	if err != nil {
+		t.Fatalf("ValidateInputAuthorizations: %v", err)
+	}
+	if errs[0] != nil {
+		t.Fatalf("input 0: %v", errs[0])
+	}
+	if got := mustTxErrCode(t, errs[1]); got != TX_ERR_SIG_INVALID {
+		t.Fatalf("input 1 code=%s, want %s", got, TX_ERR_SIG_INVALID)
+	}
+}
+
+func TestValidateInputAuthorization_RejectsBeforeCrypto(t *testing.T) {
+	tx, _, _, chainID := testSighashContextTx()
+	tx.Witness = []WitnessItem{{
+		SuiteID:   SUITE_ID_ML_DSA_87,
+		Pubkey:    make([]byte, ML_DSA_87_PUBKEY_BYTES),
+		Signature: make([]byte, ML_DSA_87_SIG_BYTES+1),
+	}}
+	p2pk := UtxoEntry{Value: 1, CovenantType: COV_TYPE_P2PK, CovenantData: validP2PKCovenantData()}
+	actx := InputAuthorizationContext{ChainID: chainID}
+
+	cases := []struct {
+		name  string
+		input InputAuthorization
+		code  ErrorCode
+		msg   string
+	}{
+		{"input_index_out_of_range", InputAuthorization{InputIndex: 1, Entry: p2pk}, TX_ERR_PARSE, "input_index out of range"},
+		{"witness_underflow", InputAuthorization{Entry: p2pk, WitnessStart: 1}, TX_ERR_PARSE, "witness underflow"},
+		{"key_binding", InputAuthorization{Entry: p2pk}, TX_ERR_SIG_INVALID, "key binding mismatch"},
+		{"unknown_covenant", InputAuthorization{Entry: UtxoEntry{CovenantType: 0xfff0}}, TX_ERR_COVENANT_TYPE_INVALID, ""},
+		{"simplicity", InputAuthorization{Entry: UtxoEntry{CovenantType: COV_TYPE_CORE_SIMPLICITY}}, TX_ERR_COVENANT_TYPE_INVALID, "whole transaction"},
+	}
+	for _, tc := range cases {
+		err := ValidateInputAuthorization(tx, tc.input, actx)
+		if got := mustTxErrCode(t, err); got != tc.code {
+			t.Fatalf("%s: code=%s, want %s (%v)", tc.name, got, tc.code, err)
+		}
+		if !strings.Contains(err.Error(), tc.msg) {
+			t.Fatalf("%s: err=%v, want %q", tc.name, err, tc.msg)
+		}
+	}
+
+	if _, err := ValidateInputAuthorizations(nil, nil, actx); err == nil {
+		t.Fatal("nil tx should error")
+	}
+}
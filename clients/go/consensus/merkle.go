@@ -11,6 +11,9 @@ func WitnessMerkleRootWtxids(wtxids [][32]byte) ([32]byte, error) {
 	if len(wtxids) == 0 {
 		return zero, txerr(TX_ERR_PARSE, "merkle: empty wtxid list")
 	}
+	if err := checkMerkleLeafCount(len(wtxids)); err != nil {
+		return zero, err
+	}
 	ids := make([][32]byte, len(wtxids))
 	copy(ids, wtxids)
 	// Break self-reference: coinbase witness commitment tree uses a zero id for index 0.
@@ -25,11 +28,24 @@ func WitnessCommitmentHash(witnessRoot [32]byte) [32]byte {
 	return sha3_256(buf)
 }
 
+// checkMerkleLeafCount rejects more leaves than a block can carry. Block
+// parsing already caps tx_count; re-checking here keeps direct callers from
+// driving the level allocations past a valid block's leaf count.
+func checkMerkleLeafCount(n int) error {
+	if uint64(n) > MAX_BLOCK_TX_COUNT {
+		return txerr(BLOCK_ERR_MERKLE_INVALID, "merkle: leaf count exceeds MAX_BLOCK_TX_COUNT")
+	}
+	return nil
+}
+
 func merkleRootTagged(ids [][32]byte, leafTag byte, nodeTag byte) ([32]byte, error) {
 	var zero [32]byte
 	if len(ids) == 0 {
 		return zero, txerr(TX_ERR_PARSE, "merkle: empty id list")
 	}
+	if err := checkMerkleLeafCount(len(ids)); err != nil {
+		return zero, err
+	}
 
 	level := make([][32]byte, 0, len(ids))
 	var leafPreimage [1 + 32]byte
@@ -60,3 +76,53 @@ func merkleRootTagged(ids [][32]byte, leafTag byte, nodeTag byte) ([32]byte, err
 
 	return level[0], nil
 }
+
+// MerkleAccumulator builds the MerkleRootTxids root one txid at a time for
+// streaming block assembly. It keeps one perfect-subtree root per set bit of
+// the leaf count; folding them right to left reproduces the odd-promotion
+// rule, so AddLeaf and Root are O(log n). The zero value is empty.
+type MerkleAccumulator struct {
+	peaks [][32]byte // perfect-subtree roots, largest (leftmost) first
+	count uint64
+}
+
+// AddLeaf appends txid as the next leaf.
+func (a *MerkleAccumulator) AddLeaf(txid [32]byte) {
+	var leafPreimage [1 + 32]byte
+	leafPreimage[0] = 0x00
+	copy(leafPreimage[1:], txid[:])
+	h := sha3_256(leafPreimage[:])
+	for k := a.count; k&1 == 1; k >>= 1 {
+		last := len(a.peaks) - 1
+		h = merkleNodeHash(0x01, a.peaks[last], h)
+		a.peaks = a.peaks[:last]
+	}
+	a.peaks = append(a.peaks, h)
+	a.count++
+}
+
+// Len returns the number of leaves added.
+func (a *MerkleAccumulator) Len() uint64 {
+	return a.count
+}
+
+// Root returns the root over all leaves added so far; it errors like
+// MerkleRootTxids on an empty set.
+func (a *MerkleAccumulator) Root() ([32]byte, error) {
+	if len(a.peaks) == 0 {
+		return [32]byte{}, txerr(TX_ERR_PARSE, "merkle: empty id list")
+	}
+	root := a.peaks[len(a.peaks)-1]
+	for i := len(a.peaks) - 2; i >= 0; i-- {
+		root = merkleNodeHash(0x01, a.peaks[i], root)
+	}
+	return root, nil
+}
+
+func merkleNodeHash(nodeTag byte, left, right [32]byte) [32]byte {
+	var nodePreimage [1 + 32 + 32]byte
+	nodePreimage[0] = nodeTag
+	copy(nodePreimage[1:33], left[:])
+	copy(nodePreimage[33:], right[:])
+	return sha3_256(nodePreimage[:])
+}
@@ -94,3 +94,56 @@ func TestWitnessCommitmentHash(t *testing.T) {
 		t.Fatalf("commitment hash mismatch")
 	}
 }
+
+func TestMerkleAccumulator_MatchesBatchRoot(t *testing.T) {
+	var acc MerkleAccumulator
+	if _, err := acc.Root(); err == nil {
+		t.Fatalf("expected error for empty accumulator")
+	}
+	var txids [][32]byte
+	for i := 0; i < 7; i++ {
+		txid := filledHash(byte(0x10 + i))
+		txids = append(txids, txid)
+		acc.AddLeaf(txid)
+
+		got, err := acc.Root()
+		if err != nil {
+			t.Fatalf("leaves=%d: Root: %v", i+1, err)
+		}
+		want, err := MerkleRootTxids(txids)
+		if err != nil {
+			t.Fatalf("leaves=%d: MerkleRootTxids: %v", i+1, err)
+		}
+		if got != want {
+			t.Fatalf("leaves=%d: incremental root %x, batch root %x", i+1, got, want)
+		}
+	}
+	if acc.Len() != 7 {
+		t.Fatalf("len=%d, want 7", acc.Len())
+	}
+}
+
+func TestMerkleRootTxids_LeafCountCap(t *testing.T) {
+	ids := make([][32]byte, MAX_BLOCK_TX_COUNT+1)
+	if _, err := MerkleRootTxids(ids[:MAX_BLOCK_TX_COUNT]); err != nil {
+		t.Fatalf("at cap: %v", err)
+	}
+
+	for name, fn := range map[string]func([][32]byte) ([32]byte, error){
+		"txids":  MerkleRootTxids,
+		"wtxids": WitnessMerkleRootWtxids,
+	} {
+		if _, err := fn(ids); err == nil {
+			t.Fatalf("%s: expected error over cap", name)
+		} else if got := mustTxErrCode(t, err); got != BLOCK_ERR_MERKLE_INVALID {
+			t.Fatalf("%s: code=%s, want %s", name, got, BLOCK_ERR_MERKLE_INVALID)
+		}
+		// Rejection happens before any leaf copy or level is allocated.
+		allocs := testing.AllocsPerRun(10, func() {
+			_, _ = fn(ids)
+		})
+		if allocs > 2 {
+			t.Fatalf("%s: over-cap rejection allocated %.0f times", name, allocs)
+		}
+	}
+}
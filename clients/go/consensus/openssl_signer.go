@@ -137,8 +137,16 @@ import (
 	"unsafe"
 )
 
+// SLH-DSA-SHAKE-256f sizes (FIPS 205). SLH-DSA is not a native consensus
+// suite (CANONICAL §14); these exist for the non-consensus signer helper only.
+const (
+	SLH_DSA_SHAKE_256F_PUBKEY_BYTES = 64
+	SLH_DSA_SHAKE_256F_SIG_BYTES    = 49_856
+)
+
 var keygenAllowlist = map[string]int{
-	"ML-DSA-87": ML_DSA_87_PUBKEY_BYTES,
+	"ML-DSA-87":          ML_DSA_87_PUBKEY_BYTES,
+	"SLH-DSA-SHAKE-256f": SLH_DSA_SHAKE_256F_PUBKEY_BYTES,
 }
 
 func validateOpenSSLAlgorithm(alg string, expectedPubkeyLen int, action string) error {
@@ -367,6 +375,50 @@ func (k *MLDSA87Keypair) SignDigest32(digest [32]byte) ([]byte, error) {
 	return sig, err
 }
 
+// SLHDSASHAKE256fKeypair is the SLH-DSA-SHAKE-256f counterpart of
+// MLDSA87Keypair. It is a non-consensus helper for tooling and tests; spends
+// signed with it are only accepted where the live binding policy admits the
+// suite.
+type SLHDSASHAKE256fKeypair struct {
+	pkey   *C.EVP_PKEY
+	pubkey []byte
+}
+
+func (k *SLHDSASHAKE256fKeypair) Close() {
+	if k == nil || k.pkey == nil {
+		return
+	}
+	C.EVP_PKEY_free(k.pkey)
+	k.pkey = nil
+}
+
+func (k *SLHDSASHAKE256fKeypair) PubkeyBytes() []byte {
+	if k == nil {
+		return nil
+	}
+	return append([]byte(nil), k.pubkey...)
+}
+
+func NewSLHDSASHAKE256fKeypair() (*SLHDSASHAKE256fKeypair, error) {
+	pkey, pub, err := newOpenSSLRawKeypair("SLH-DSA-SHAKE-256f", SLH_DSA_SHAKE_256F_PUBKEY_BYTES)
+	if err != nil {
+		return nil, err
+	}
+	kp := &SLHDSASHAKE256fKeypair{pkey: pkey, pubkey: pub}
+	runtime.SetFinalizer(kp, func(k *SLHDSASHAKE256fKeypair) { k.Close() })
+	return kp, nil
+}
+
+func (k *SLHDSASHAKE256fKeypair) SignDigest32(digest [32]byte) ([]byte, error) {
+	if k == nil || k.pkey == nil {
+		return nil, fmt.Errorf("nil keypair")
+	}
+	sig, err := signOpenSSLDigest32(k.pkey, digest, SLH_DSA_SHAKE_256F_SIG_BYTES, SLH_DSA_SHAKE_256F_SIG_BYTES)
+	// Same finalizer window as MLDSA87Keypair.SignDigest32.
+	runtime.KeepAlive(k)
+	return sig, err
+}
+
 func (k *MLDSA87Keypair) PrivateKeyDER() ([]byte, error) {
 	if err := ensureOpenSSLBootstrap(); err != nil {
 		return nil, err
@@ -0,0 +1,133 @@
+package consensus
+
+import (
+	"strings"
+	"testing"
+)
+
+const testSuiteIDSLHDSASHAKE256f = 0x02
+
+func mustSLHDSASHAKE256fKeypair(t *testing.T) *SLHDSASHAKE256fKeypair {
+	t.Helper()
+	kp, err := NewSLHDSASHAKE256fKeypair()
+	if err != nil {
+		if strings.Contains(err.Error(), "unsupported") {
+			t.Skipf("SLH-DSA backend unavailable in this OpenSSL build: %v", err)
+		}
+		t.Fatalf("NewSLHDSASHAKE256fKeypair: %v", err)
+	}
+	t.Cleanup(func() { kp.Close() })
+	return kp
+}
+
+func TestOpenSSL_SLHDSASHAKE256f_SignVerifyRoundtrip(t *testing.T) {
+	kp := mustSLHDSASHAKE256fKeypair(t)
+	if got := len(kp.PubkeyBytes()); got != SLH_DSA_SHAKE_256F_PUBKEY_BYTES {
+		t.Fatalf("pubkey len=%d, want %d", got, SLH_DSA_SHAKE_256F_PUBKEY_BYTES)
+	}
+
+	var msg [32]byte
+	msg[0] = 0x5a
+	sig, err := kp.SignDigest32(msg)
+	if err != nil {
+		t.Fatalf("SignDigest32: %v", err)
+	}
+	ok, err := VerifySLHDSASHAKE256fDigest32(kp.PubkeyBytes(), sig, msg)
+	if err != nil {
+		t.Fatalf("VerifySLHDSASHAKE256fDigest32: %v", err)
+	}
+	if !ok {
+		t.Fatalf("VerifySLHDSASHAKE256fDigest32=false")
+	}
+
+	msg[0] ^= 0x01
+	ok, err = VerifySLHDSASHAKE256fDigest32(kp.PubkeyBytes(), sig, msg)
+	if err != nil {
+		t.Fatalf("VerifySLHDSASHAKE256fDigest32 tampered: %v", err)
+	}
+	if ok {
+		t.Fatalf("VerifySLHDSASHAKE256fDigest32=true for tampered digest")
+	}
+}
+
+func TestVerifySLHDSASHAKE256fDigest32_RejectsWrongLengthsBeforeOpenSSL(t *testing.T) {
+	var msg [32]byte
+	ok, err := VerifySLHDSASHAKE256fDigest32(make([]byte, SLH_DSA_SHAKE_256F_PUBKEY_BYTES-1), make([]byte, SLH_DSA_SHAKE_256F_SIG_BYTES), msg)
+	if err != nil {
+		t.Fatalf("VerifySLHDSASHAKE256fDigest32 err: %v", err)
+	}
+	if ok {
+		t.Fatalf("VerifySLHDSASHAKE256fDigest32=true for invalid pubkey length")
+	}
+}
+
+// SLH-DSA is not in the live binding policy, so a P2PK spend under a rotation
+// that activates it must reach the verifier binding and fail closed there
+// rather than being accepted by a registry entry alone.
+func TestValidateP2PKSpendAtHeight_SLHDSAPostActivationFailsClosedAtLiveBinding(t *testing.T) {
+	kp := mustSLHDSASHAKE256fKeypair(t)
+	registry := NewSuiteRegistryFromParams([]SuiteParams{
+		{SuiteID: SUITE_ID_ML_DSA_87, PubkeyLen: ML_DSA_87_PUBKEY_BYTES, SigLen: ML_DSA_87_SIG_BYTES, VerifyCost: VERIFY_COST_ML_DSA_87, AlgName: "ML-DSA-87"},
+		{SuiteID: testSuiteIDSLHDSASHAKE256f, PubkeyLen: SLH_DSA_SHAKE_256F_PUBKEY_BYTES, SigLen: SLH_DSA_SHAKE_256F_SIG_BYTES, VerifyCost: VERIFY_COST_UNKNOWN_SUITE, AlgName: "SLH-DSA-SHAKE-256f"},
+	})
+	rotation := DescriptorRotationProvider{Descriptor: CryptoRotationDescriptor{
+		Name:         "test-slh-activation",
+		OldSuiteID:   SUITE_ID_ML_DSA_87,
+		NewSuiteID:   testSuiteIDSLHDSASHAKE256f,
+		CreateHeight: 1,
+		SpendHeight:  5,
+	}}
+
+	pub := kp.PubkeyBytes()
+	keyID := sha3_256(pub)
+	covData := make([]byte, MAX_P2PK_COVENANT_DATA)
+	covData[0] = testSuiteIDSLHDSASHAKE256f
+	copy(covData[1:], keyID[:])
+	entry := UtxoEntry{Value: 1, CovenantType: COV_TYPE_P2PK, CovenantData: covData}
+
+	tx, inputIndex, inputValue, chainID := testSighashContextTx()
+	digest, err := SighashV1DigestWithType(tx, inputIndex, inputValue, chainID, SIGHASH_ALL)
+	if err != nil {
+		t.Fatalf("SighashV1DigestWithType: %v", err)
+	}
+	sig, err := kp.SignDigest32(digest)
+	if err != nil {
+		t.Fatalf("SignDigest32: %v", err)
+	}
+	if ok, err := VerifySLHDSASHAKE256fDigest32(pub, sig, digest); err != nil || !ok {
+		t.Fatalf("raw verify ok=%v err=%v", ok, err)
+	}
+	w := WitnessItem{SuiteID: testSuiteIDSLHDSASHAKE256f, Pubkey: pub, Signature: append(sig, SIGHASH_ALL)}
+
+	check := func(height uint64) error {
+		return validateP2PKSpendAtHeight(p2pkSpendCheck{
+			entry:       entry,
+			witness:     w,
+			blockHeight: height,
+			rotation:    rotation,
+			sig: spendSigContext{
+				tx:         tx,
+				inputIndex: inputIndex,
+				inputValue: inputValue,
+				chainID:    chainID,
+				registry:   registry,
+			},
+		})
+	}
+
+	err = check(3)
+	if got := mustTxErrCode(t, err); got != TX_ERR_SIG_ALG_INVALID {
+		t.Fatalf("pre-activation code=%s, want %s", got, TX_ERR_SIG_ALG_INVALID)
+	}
+	if !strings.Contains(err.Error(), "not in native spend set") {
+		t.Fatalf("pre-activation err=%v, want native spend set rejection", err)
+	}
+
+	err = check(10)
+	if got := mustTxErrCode(t, err); got != TX_ERR_SIG_ALG_INVALID {
+		t.Fatalf("post-activation code=%s, want %s", got, TX_ERR_SIG_ALG_INVALID)
+	}
+	if !strings.Contains(err.Error(), "resolveSuiteVerifierBinding") {
+		t.Fatalf("post-activation err=%v, want live binding rejection", err)
+	}
+}
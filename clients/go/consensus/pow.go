@@ -56,6 +56,28 @@ func RetargetV1Clamped(targetOld [32]byte, windowTimestamps []uint64) ([32]byte,
 	return retargetV1WithActual(targetOld, tActual)
 }
 
+// CheckRetargetTimeWarp is an opt-in strict-mode guard, not a consensus
+// rule. RetargetV1Clamped bounds each forward step but takes the window's
+// first timestamp as given, so a boundary block backdated below the previous
+// window's last block stretches T_actual and lowers difficulty (time-warp).
+// It rejects a window whose first timestamp is more than maxBackstep below
+// prevWindowLast, or whose last timestamp is more than maxBackstep below
+// its predecessor. Networks choose maxBackstep; callers skip the check to
+// disable it.
+func CheckRetargetTimeWarp(prevWindowLast uint64, windowTimestamps []uint64, maxBackstep uint64) error {
+	if len(windowTimestamps) != int(WINDOW_SIZE) {
+		return txerr(TX_ERR_PARSE, "retarget: invalid window timestamp count")
+	}
+	if first := windowTimestamps[0]; first < prevWindowLast && prevWindowLast-first > maxBackstep {
+		return txerr(BLOCK_ERR_TIMESTAMP_OLD, "retarget: window start backdated past previous window end")
+	}
+	last := windowTimestamps[len(windowTimestamps)-1]
+	if prev := windowTimestamps[len(windowTimestamps)-2]; last < prev && prev-last > maxBackstep {
+		return txerr(BLOCK_ERR_TIMESTAMP_OLD, "retarget: window end backdated past its predecessor")
+	}
+	return nil
+}
+
 func retargetV1WithActual(targetOld [32]byte, tActual uint64) ([32]byte, error) {
 	powLimit := new(big.Int).SetBytes(POW_LIMIT[:])
 	tOld := new(big.Int).SetBytes(targetOld[:]) // big-endian
@@ -105,10 +127,8 @@ func PowCheck(headerBytes []byte, target [32]byte) error {
 	if len(headerBytes) != BLOCK_HEADER_BYTES {
 		return txerr(TX_ERR_PARSE, "pow: invalid header length")
 	}
-	targetInt := new(big.Int).SetBytes(target[:])
-	powLimit := new(big.Int).SetBytes(POW_LIMIT[:])
-	if targetInt.Sign() == 0 || targetInt.Cmp(powLimit) > 0 {
-		return txerr(BLOCK_ERR_TARGET_INVALID, "target out of range")
+	if err := validateTargetRange(target); err != nil {
+		return err
 	}
 
 	h, err := BlockHash(headerBytes)
@@ -121,6 +141,15 @@ func PowCheck(headerBytes []byte, target [32]byte) error {
 	return nil
 }
 
+func validateTargetRange(target [32]byte) error {
+	targetInt := new(big.Int).SetBytes(target[:])
+	powLimit := new(big.Int).SetBytes(POW_LIMIT[:])
+	if targetInt.Sign() == 0 || targetInt.Cmp(powLimit) > 0 {
+		return txerr(BLOCK_ERR_TARGET_INVALID, "target out of range")
+	}
+	return nil
+}
+
 func bigIntToBytes32(x *big.Int) ([32]byte, error) {
 	var out [32]byte
 	if x.Sign() < 0 {
@@ -79,6 +79,50 @@ func TestRetargetV1Clamped_LastStepJump(t *testing.T) {
 	}
 }
 
+func TestCheckRetargetTimeWarp_RejectsBackdatedBoundary(t *testing.T) {
+	targetOld := mustBytes32Hex(t, "0000000000000000000000000000000000000000000000000000000000001000")
+	const prevWindowLast = uint64(10_000_000)
+	honest := make([]uint64, WINDOW_SIZE)
+	honest[0] = prevWindowLast + TARGET_BLOCK_INTERVAL
+	for i := 1; i < len(honest); i++ {
+		honest[i] = honest[i-1] + TARGET_BLOCK_INTERVAL
+	}
+	if err := CheckRetargetTimeWarp(prevWindowLast, honest, MAX_FUTURE_DRIFT); err != nil {
+		t.Fatalf("honest window: %v", err)
+	}
+
+	// Time-warp: backdate the boundary block, then advance at the clamp
+	// ceiling so T_actual balloons and the target eases.
+	warped := make([]uint64, WINDOW_SIZE)
+	warped[0] = prevWindowLast - 5_000_000
+	for i := 1; i < len(warped); i++ {
+		warped[i] = warped[i-1] + MAX_TIMESTAMP_STEP_PER_BLOCK
+	}
+	eased, err := RetargetV1Clamped(targetOld, warped)
+	if err != nil {
+		t.Fatalf("RetargetV1Clamped: %v", err)
+	}
+	if new(big.Int).SetBytes(eased[:]).Cmp(new(big.Int).SetBytes(targetOld[:])) <= 0 {
+		t.Fatalf("warped window did not ease the target: %x", eased)
+	}
+	err = CheckRetargetTimeWarp(prevWindowLast, warped, MAX_FUTURE_DRIFT)
+	if got := mustTxErrCode(t, err); got != BLOCK_ERR_TIMESTAMP_OLD {
+		t.Fatalf("warped start: code=%s, want %s", got, BLOCK_ERR_TIMESTAMP_OLD)
+	}
+	if err := CheckRetargetTimeWarp(prevWindowLast, warped, 6_000_000); err != nil {
+		t.Fatalf("lenient backstep: %v", err)
+	}
+
+	honest[len(honest)-1] = honest[len(honest)-2] - MAX_FUTURE_DRIFT - 1
+	err = CheckRetargetTimeWarp(prevWindowLast, honest, MAX_FUTURE_DRIFT)
+	if got := mustTxErrCode(t, err); got != BLOCK_ERR_TIMESTAMP_OLD {
+		t.Fatalf("warped end: code=%s, want %s", got, BLOCK_ERR_TIMESTAMP_OLD)
+	}
+	if err := CheckRetargetTimeWarp(prevWindowLast, honest[:1], MAX_FUTURE_DRIFT); err == nil {
+		t.Fatalf("short window: expected error")
+	}
+}
+
 func TestRetargetV1Clamped_InvalidWindowLength(t *testing.T) {
 	targetOld := mustBytes32Hex(t, "0000000000000000000000000000000000000000000000000000000000001000")
 	_, err := RetargetV1Clamped(targetOld, []uint64{0, 120})
@@ -78,19 +78,15 @@ func validateP2PKSpendQ(
 	}
 
 	nativeSpend := rotation.NativeSpendSuites(blockHeight)
-	if !nativeSpend.Contains(w.SuiteID) {
-		return txerr(TX_ERR_SIG_ALG_INVALID, "CORE_P2PK suite not in native spend set")
-	}
-
-	params, ok := registry.Lookup(w.SuiteID)
-	if !ok {
-		return txerr(TX_ERR_SIG_ALG_INVALID, "CORE_P2PK suite not registered")
+	params, err := nativeWitnessSuite("CORE_P2PK", w.SuiteID, nativeSpend, registry)
+	if err != nil {
+		return err
 	}
 
 	if len(w.Pubkey) != params.PubkeyLen || len(w.Signature) != params.SigLen+1 {
 		return txerr(TX_ERR_SIG_NONCANONICAL, "non-canonical witness item lengths")
 	}
-	if len(entry.CovenantData) != MAX_P2PK_COVENANT_DATA || entry.CovenantData[0] != w.SuiteID {
+	if !CovenantDataLengthValid(COV_TYPE_P2PK, len(entry.CovenantData)) || entry.CovenantData[0] != w.SuiteID {
 		return txerr(TX_ERR_COVENANT_TYPE_INVALID, "CORE_P2PK covenant_data invalid")
 	}
 	var keyID [32]byte
@@ -147,13 +143,9 @@ func validateThresholdSigSpendQ(
 			continue
 		}
 
-		if !nativeSpend.Contains(w.SuiteID) {
-			return rollbackOnError(txerr(TX_ERR_SIG_ALG_INVALID, context+" suite not in native spend set"))
-		}
-
-		params, ok := registry.Lookup(w.SuiteID)
-		if !ok {
-			return rollbackOnError(txerr(TX_ERR_SIG_ALG_INVALID, context+" suite not registered"))
+		params, err := nativeWitnessSuite(context, w.SuiteID, nativeSpend, registry)
+		if err != nil {
+			return rollbackOnError(err)
 		}
 
 		if len(w.Pubkey) != params.PubkeyLen || len(w.Signature) != params.SigLen+1 {
@@ -261,13 +253,9 @@ func validateHTLCSpendQ(
 	}
 
 	nativeSpend := rotation.NativeSpendSuites(blockHeight)
-	if !nativeSpend.Contains(sigItem.SuiteID) {
-		return txerr(TX_ERR_SIG_ALG_INVALID, "CORE_HTLC suite not in native spend set")
-	}
-
-	params, ok := registry.Lookup(sigItem.SuiteID)
-	if !ok {
-		return txerr(TX_ERR_SIG_ALG_INVALID, "CORE_HTLC suite not registered")
+	params, err := nativeWitnessSuite("CORE_HTLC", sigItem.SuiteID, nativeSpend, registry)
+	if err != nil {
+		return err
 	}
 
 	if len(sigItem.Pubkey) != params.PubkeyLen || len(sigItem.Signature) != params.SigLen+1 {
@@ -326,13 +314,9 @@ func validateCoreStealthSpendQ(
 	}
 
 	nativeSpend := rotation.NativeSpendSuites(blockHeight)
-	if !nativeSpend.Contains(w.SuiteID) {
-		return txerr(TX_ERR_SIG_ALG_INVALID, "CORE_STEALTH suite not in native spend set")
-	}
-
-	params, ok := registry.Lookup(w.SuiteID)
-	if !ok {
-		return txerr(TX_ERR_SIG_ALG_INVALID, "CORE_STEALTH suite not registered")
+	params, err := nativeWitnessSuite("CORE_STEALTH", w.SuiteID, nativeSpend, registry)
+	if err != nil {
+		return err
 	}
 
 	if len(w.Pubkey) != params.PubkeyLen || len(w.Signature) != params.SigLen+1 {
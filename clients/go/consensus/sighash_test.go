@@ -143,3 +143,82 @@ func TestSighashV1PrehashCacheSingleOutputHashNilCacheFallsBackToZeroHash(t *tes
 		t.Fatalf("nil cache single output hash mismatch")
 	}
 }
+
+// sighashPropertyTx derives a deterministic three-input tx from seed.
+func sighashPropertyTx(seed byte) *Tx {
+	tx := &Tx{Version: 1, TxKind: 0x00, TxNonce: uint64(seed) + 1, Locktime: uint32(seed)}
+	for i := byte(0); i < 3; i++ {
+		h := sha3_256([]byte{seed, i})
+		tx.Inputs = append(tx.Inputs, TxInput{
+			PrevTxid: h,
+			PrevVout: binary.LittleEndian.Uint32(h[:4]) % 8,
+			Sequence: binary.LittleEndian.Uint32(h[4:8]),
+		})
+	}
+	tx.Outputs = []TxOutput{
+		{Value: uint64(seed) + 7, CovenantType: COV_TYPE_P2PK, CovenantData: validP2PKCovenantData()},
+	}
+	return tx
+}
+
+func cloneSighashPropertyTx(tx *Tx) *Tx {
+	out := *tx
+	out.Inputs = append([]TxInput(nil), tx.Inputs...)
+	out.Outputs = append([]TxOutput(nil), tx.Outputs...)
+	return &out
+}
+
+func TestSighashV1Digest_InputOrderCommitmentProperty(t *testing.T) {
+	perms := [][3]int{{0, 1, 2}, {0, 2, 1}, {1, 0, 2}, {1, 2, 0}, {2, 0, 1}, {2, 1, 0}}
+	var chainID [32]byte
+	chainID[0] = 0x5a
+
+	for seed := byte(0); seed < 16; seed++ {
+		base := sighashPropertyTx(seed)
+		for signed := range base.Inputs {
+			inputValue := uint64(signed) + 100
+			want, err := SighashV1Digest(base, uint32(signed), inputValue, chainID)
+			if err != nil {
+				t.Fatalf("seed=%d input=%d: %v", seed, signed, err)
+			}
+			// A structurally identical tx yields the same digest for the same input.
+			again, err := SighashV1Digest(cloneSighashPropertyTx(base), uint32(signed), inputValue, chainID)
+			if err != nil || again != want {
+				t.Fatalf("seed=%d input=%d: digest not stable across identical tx (err=%v)", seed, signed, err)
+			}
+
+			for _, perm := range perms[1:] {
+				permuted := cloneSighashPropertyTx(base)
+				newIndex := 0
+				for to, from := range perm {
+					permuted.Inputs[to] = base.Inputs[from]
+					if from == signed {
+						newIndex = to
+					}
+				}
+				got, err := SighashV1Digest(permuted, uint32(newIndex), inputValue, chainID)
+				if err != nil {
+					t.Fatalf("seed=%d input=%d perm=%v: %v", seed, signed, perm, err)
+				}
+				if got == want {
+					t.Fatalf("seed=%d input=%d perm=%v: digest insensitive to input order", seed, signed, perm)
+				}
+
+				// ANYONECANPAY commits only to the signed input and its index, so
+				// reordering the other inputs around it must not change the digest.
+				acp := uint8(SIGHASH_ALL | SIGHASH_ANYONECANPAY)
+				wantACP, err := SighashV1DigestWithType(base, uint32(signed), inputValue, chainID, acp)
+				if err != nil {
+					t.Fatalf("seed=%d input=%d: %v", seed, signed, err)
+				}
+				gotACP, err := SighashV1DigestWithType(permuted, uint32(newIndex), inputValue, chainID, acp)
+				if err != nil {
+					t.Fatalf("seed=%d input=%d perm=%v: %v", seed, signed, perm, err)
+				}
+				if (gotACP == wantACP) != (newIndex == signed) {
+					t.Fatalf("seed=%d input=%d perm=%v: ANYONECANPAY digest equal=%v, index moved=%v", seed, signed, perm, gotACP == wantACP, newIndex != signed)
+				}
+			}
+		}
+	}
+}
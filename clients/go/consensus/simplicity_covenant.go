@@ -96,8 +96,8 @@ func validateCoreSimplicityDeploymentActive(chainID [32]byte, height uint64, pro
 type simplicityTxContextProvider func() (*SimplicityTxContext, error)
 
 func parseCoreSimplicityWitnessEnvelope(witness WitnessItem) (parsedSimplicityEnvelope, error) {
-	if witness.SuiteID != SUITE_ID_SIMPLICITY_ENVELOPE {
-		return parsedSimplicityEnvelope{}, txerr(TX_ERR_SIG_ALG_INVALID, "CORE_SIMPLICITY witness suite must be 0xF0")
+	if err := simplicityWitnessSuitePermitted(witness.SuiteID); err != nil {
+		return parsedSimplicityEnvelope{}, err
 	}
 	if len(witness.Pubkey) != 0 {
 		return parsedSimplicityEnvelope{}, txerr(TX_ERR_PARSE, "non-canonical Simplicity envelope witness item")
@@ -131,20 +131,16 @@ func validateP2PKSpendAtHeight(check p2pkSpendCheck) error {
 	rotation, registry := defaultSpendProviders(check.rotation, check.sig.registry)
 	w := check.witness
 	nativeSpend := rotation.NativeSpendSuites(check.blockHeight)
-	if !nativeSpend.Contains(w.SuiteID) {
-		return txerr(TX_ERR_SIG_ALG_INVALID, "CORE_P2PK suite not in native spend set")
-	}
-
-	params, ok := registry.Lookup(w.SuiteID)
-	if !ok {
-		return txerr(TX_ERR_SIG_ALG_INVALID, "CORE_P2PK suite not registered")
+	params, err := nativeWitnessSuite("CORE_P2PK", w.SuiteID, nativeSpend, registry)
+	if err != nil {
+		return err
 	}
 
 	if len(w.Pubkey) != params.PubkeyLen || len(w.Signature) != params.SigLen+1 {
 		return txerr(TX_ERR_SIG_NONCANONICAL, "non-canonical witness item lengths")
 	}
 
-	if len(check.entry.CovenantData) != MAX_P2PK_COVENANT_DATA || check.entry.CovenantData[0] != w.SuiteID {
+	if !CovenantDataLengthValid(COV_TYPE_P2PK, len(check.entry.CovenantData)) || check.entry.CovenantData[0] != w.SuiteID {
 		return txerr(TX_ERR_COVENANT_TYPE_INVALID, "CORE_P2PK covenant_data invalid")
 	}
 
@@ -210,13 +206,9 @@ func validateThresholdWitness(w WitnessItem, key [32]byte, nativeSpend *NativeSu
 		return false, err
 	}
 
-	if !nativeSpend.Contains(w.SuiteID) {
-		return false, txerr(TX_ERR_SIG_ALG_INVALID, sig.context+" suite not in native spend set")
-	}
-
-	params, ok := registry.Lookup(w.SuiteID)
-	if !ok {
-		return false, txerr(TX_ERR_SIG_ALG_INVALID, sig.context+" suite not registered")
+	params, err := nativeWitnessSuite(sig.context, w.SuiteID, nativeSpend, registry)
+	if err != nil {
+		return false, err
 	}
 
 	if len(w.Pubkey) != params.PubkeyLen || len(w.Signature) != params.SigLen+1 {
@@ -6,7 +6,7 @@ type StealthCovenant struct {
 }
 
 func ParseStealthCovenantData(covData []byte) (*StealthCovenant, error) {
-	if len(covData) != MAX_STEALTH_COVENANT_DATA {
+	if !CovenantDataLengthValid(COV_TYPE_CORE_STEALTH, len(covData)) {
 		return nil, txerr(TX_ERR_COVENANT_TYPE_INVALID, "CORE_STEALTH covenant_data length mismatch")
 	}
 	if ML_KEM_1024_CT_BYTES+32 != MAX_STEALTH_COVENANT_DATA {
@@ -67,13 +67,9 @@ func validateCoreStealthSpendAtHeight(input coreStealthSpendValidation) error {
 	}
 
 	nativeSpend := input.rotation.NativeSpendSuites(input.blockHeight)
-	if !nativeSpend.Contains(input.w.SuiteID) {
-		return txerr(TX_ERR_SIG_ALG_INVALID, "CORE_STEALTH suite not in native spend set")
-	}
-
-	params, ok := input.registry.Lookup(input.w.SuiteID)
-	if !ok {
-		return txerr(TX_ERR_SIG_ALG_INVALID, "CORE_STEALTH suite not registered")
+	params, err := nativeWitnessSuite("CORE_STEALTH", input.w.SuiteID, nativeSpend, input.registry)
+	if err != nil {
+		return err
 	}
 
 	if len(input.w.Pubkey) != params.PubkeyLen || len(input.w.Signature) != params.SigLen+1 {
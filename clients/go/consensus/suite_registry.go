@@ -45,6 +45,24 @@ func IsStructuralWitnessCarrierSuiteID(suiteID uint8) bool {
 	return suiteID >= SUITE_ID_SIMPLICITY_ENVELOPE && suiteID <= 0xfe
 }
 
+// WitnessSuiteSizes returns the canonical witness item lengths for suiteID.
+// sigLen is the wire signature length including the trailing sighash_type
+// byte; when sigIsRange is set it is an upper bound rather than exact. The
+// sentinel sizes are the keyless form; covenant selector items are checked
+// by isCanonicalSentinelWitnessItem. Suites without fixed sizes error.
+func WitnessSuiteSizes(suiteID uint8) (pubLen int, sigLen int, sigIsRange bool, err error) {
+	switch suiteID {
+	case SUITE_ID_SENTINEL:
+		return 0, 0, false, nil
+	case SUITE_ID_ML_DSA_87:
+		return ML_DSA_87_PUBKEY_BYTES, ML_DSA_87_SIG_BYTES + 1, false, nil
+	case SUITE_ID_SIMPLICITY_ENVELOPE:
+		return 0, MAX_SIMPLICITY_ENVELOPE_BYTES + 1, true, nil
+	default:
+		return 0, 0, false, fmt.Errorf("no witness sizes for suite 0x%02x", suiteID)
+	}
+}
+
 // DefaultSuiteRegistry returns the registry containing all currently defined
 // native signature suites. Pre-rotation, this is ML-DSA-87 only.
 func DefaultSuiteRegistry() *SuiteRegistry {
@@ -48,6 +48,34 @@ func TestDefaultSuiteRegistry_LookupSentinel(t *testing.T) {
 	}
 }
 
+func TestWitnessSuiteSizes(t *testing.T) {
+	cases := []struct {
+		suiteID uint8
+		pubLen  int
+		sigLen  int
+		isRange bool
+	}{
+		{SUITE_ID_SENTINEL, 0, 0, false},
+		{SUITE_ID_ML_DSA_87, 2592, 4628, false},
+		{SUITE_ID_SIMPLICITY_ENVELOPE, 0, MAX_SIMPLICITY_ENVELOPE_BYTES + 1, true},
+	}
+	for _, tc := range cases {
+		pubLen, sigLen, isRange, err := WitnessSuiteSizes(tc.suiteID)
+		if err != nil {
+			t.Fatalf("suite 0x%02x: %v", tc.suiteID, err)
+		}
+		if pubLen != tc.pubLen || sigLen != tc.sigLen || isRange != tc.isRange {
+			t.Fatalf("suite 0x%02x: got (%d,%d,%v), want (%d,%d,%v)", tc.suiteID, pubLen, sigLen, isRange, tc.pubLen, tc.sigLen, tc.isRange)
+		}
+	}
+	// 0x02 is the CLI's SLH-DSA stand-in; it has no consensus suite sizes.
+	for _, suiteID := range []uint8{0x02, 0xff} {
+		if _, _, _, err := WitnessSuiteSizes(suiteID); err == nil {
+			t.Fatalf("suite 0x%02x: expected error", suiteID)
+		}
+	}
+}
+
 func TestSuiteRegistry_IsRegistered(t *testing.T) {
 	reg := DefaultSuiteRegistry()
 	if !reg.IsRegistered(SUITE_ID_ML_DSA_87) {
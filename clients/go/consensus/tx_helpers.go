@@ -221,7 +221,7 @@ func signableP2PKEntry(utxoSet map[Outpoint]UtxoEntry, in TxInput, keyID [32]byt
 	if entry.CovenantType != COV_TYPE_P2PK {
 		return UtxoEntry{}, fmt.Errorf("unsupported covenant type for signing: 0x%04x", entry.CovenantType)
 	}
-	if len(entry.CovenantData) != MAX_P2PK_COVENANT_DATA || entry.CovenantData[0] != SUITE_ID_ML_DSA_87 {
+	if !CovenantDataLengthValid(COV_TYPE_P2PK, len(entry.CovenantData)) || entry.CovenantData[0] != SUITE_ID_ML_DSA_87 {
 		return UtxoEntry{}, txerr(TX_ERR_COVENANT_TYPE_INVALID, "CORE_P2PK covenant_data invalid")
 	}
 	if !bytes.Equal(entry.CovenantData[1:33], keyID[:]) {
@@ -5,6 +5,23 @@ import "fmt"
 // MarshalTx serializes a Tx into its canonical wire-format bytes.
 // The output is the exact inverse of ParseTx (roundtrip property).
 func MarshalTx(tx *Tx) ([]byte, error) {
+	b, err := TxNoWitnessBytes(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	b = appendWitnessSection(b, tx.Witness)
+
+	// DA payload
+	b = AppendCompactSize(b, uint64(len(tx.DaPayload)))
+	b = append(b, tx.DaPayload...)
+
+	return b, nil
+}
+
+// TxNoWitnessBytes serializes the witness-free core of a Tx: every field up to
+// and including the DA core fields. SHA3-256 of these bytes is the txid.
+func TxNoWitnessBytes(tx *Tx) ([]byte, error) {
 	if tx == nil {
 		return nil, fmt.Errorf("nil tx")
 	}
@@ -45,19 +62,25 @@ func MarshalTx(tx *Tx) ([]byte, error) {
 	}
 	b = append(b, daCore...)
 
-	// Witness
-	b = AppendCompactSize(b, uint64(len(tx.Witness)))
-	for _, w := range tx.Witness {
+	return b, nil
+}
+
+// WitnessBytes serializes a witness section: CompactSize item count, then per
+// item suite_id | CompactSize pubkey_len | pubkey | CompactSize sig_len | sig.
+// It is the bytes between TxNoWitnessBytes and the DA payload in the wtxid
+// preimage.
+func WitnessBytes(witness []WitnessItem) []byte {
+	return appendWitnessSection(nil, witness)
+}
+
+func appendWitnessSection(b []byte, witness []WitnessItem) []byte {
+	b = AppendCompactSize(b, uint64(len(witness)))
+	for _, w := range witness {
 		b = append(b, w.SuiteID)
 		b = AppendCompactSize(b, uint64(len(w.Pubkey)))
 		b = append(b, w.Pubkey...)
 		b = AppendCompactSize(b, uint64(len(w.Signature)))
 		b = append(b, w.Signature...)
 	}
-
-	// DA payload
-	b = AppendCompactSize(b, uint64(len(tx.DaPayload)))
-	b = append(b, tx.DaPayload...)
-
-	return b, nil
+	return b
 }
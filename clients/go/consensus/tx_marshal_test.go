@@ -2,6 +2,10 @@ package consensus
 
 import (
 	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
 	"testing"
 )
 
@@ -210,3 +214,173 @@ func TestMarshalTx_DAKindMissingCoreError(t *testing.T) {
 		t.Fatalf("expected error when tx_kind=0x02 has no DaChunkCore")
 	}
 }
+
+func TestTxNoWitnessBytes_HashesToTxid(t *testing.T) {
+	tx := &Tx{
+		Version: 1,
+		TxKind:  0x00,
+		TxNonce: 3,
+		Inputs:  []TxInput{{PrevTxid: [32]byte{0x09}, PrevVout: 2, Sequence: 1}},
+		Outputs: []TxOutput{{Value: 7, CovenantType: COV_TYPE_P2PK, CovenantData: validP2PKCovenantData()}},
+		Witness: []WitnessItem{{SuiteID: 0x02, Pubkey: []byte{0x01, 0x02}, Signature: []byte{0x03}}}, // non-native/unknown suite
+	}
+	full, err := MarshalTx(tx)
+	if err != nil {
+		t.Fatalf("MarshalTx: %v", err)
+	}
+	_, txid, _, _, err := ParseTx(full)
+	if err != nil {
+		t.Fatalf("ParseTx: %v", err)
+	}
+	core, err := TxNoWitnessBytes(tx)
+	if err != nil {
+		t.Fatalf("TxNoWitnessBytes: %v", err)
+	}
+	if !bytes.HasPrefix(full, core) || len(core) == len(full) {
+		t.Fatalf("no-witness bytes must be a strict prefix of the full tx bytes")
+	}
+	if sha3_256(core) != txid {
+		t.Fatalf("sha3(no-witness bytes) != txid")
+	}
+	if _, err := TxNoWitnessBytes(nil); err == nil {
+		t.Fatalf("expected error for nil tx")
+	}
+}
+
+func TestWitnessBytes_ConcatenationReproducesTxBytes(t *testing.T) {
+	tx := &Tx{
+		Version:   1,
+		TxKind:    0x00,
+		TxNonce:   4,
+		Inputs:    []TxInput{{PrevTxid: [32]byte{0x0a}, PrevVout: 1}},
+		Outputs:   []TxOutput{{Value: 9, CovenantType: COV_TYPE_P2PK, CovenantData: validP2PKCovenantData()}},
+		Witness:   []WitnessItem{{SuiteID: 0x02, Pubkey: []byte{0x01}, Signature: []byte{0x02, 0x03}}}, // non-native/unknown suite
+		DaPayload: nil,
+	}
+	full, err := MarshalTx(tx)
+	if err != nil {
+		t.Fatalf("MarshalTx: %v", err)
+	}
+	core, err := TxNoWitnessBytes(tx)
+	if err != nil {
+		t.Fatalf("TxNoWitnessBytes: %v", err)
+	}
+	witness := WitnessBytes(tx.Witness)
+	want := []byte{0x01, 0x02, 0x01, 0x01, 0x02, 0x02, 0x03}
+	if !bytes.Equal(witness, want) {
+		t.Fatalf("WitnessBytes=%x, want %x", witness, want)
+	}
+
+	joined := append(append(append([]byte(nil), core...), witness...), AppendCompactSize(nil, uint64(len(tx.DaPayload)))...)
+	joined = append(joined, tx.DaPayload...)
+	if !bytes.Equal(joined, full) {
+		t.Fatalf("core || witness || da_payload != MarshalTx")
+	}
+	_, _, wtxid, _, err := ParseTx(full)
+	if err != nil {
+		t.Fatalf("ParseTx: %v", err)
+	}
+	if sha3_256(joined) != wtxid {
+		t.Fatalf("sha3(reconstructed bytes) != wtxid")
+	}
+
+	if got := WitnessBytes(nil); !bytes.Equal(got, []byte{0x00}) {
+		t.Fatalf("WitnessBytes(nil)=%x, want 00", got)
+	}
+}
+
+// TestMarshalTx_FixtureCorpusRoundtrip re-serializes every transaction the
+// conformance fixtures carry, either as tx_hex or inside block_hex, and
+// requires the parsed form to marshal back to the exact wire bytes. Vectors
+// that do not parse are negative cases and are skipped.
+func TestMarshalTx_FixtureCorpusRoundtrip(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("..", "..", "..", "conformance", "fixtures", "CV-*.json"))
+	if err != nil {
+		t.Fatalf("glob: %v", err)
+	}
+	if len(files) == 0 {
+		t.Skip("conformance/fixtures not found (run from repo root)")
+	}
+	checked := 0
+	for _, path := range files {
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("read %s: %v", path, err)
+		}
+		var doc struct {
+			Vectors []map[string]json.RawMessage `json:"vectors"`
+		}
+		if err := json.Unmarshal(raw, &doc); err != nil {
+			t.Fatalf("parse %s: %v", path, err)
+		}
+		for _, v := range doc.Vectors {
+			var id string
+			_ = json.Unmarshal(v["id"], &id)
+			where := filepath.Base(path) + " " + id
+			if b, ok := fixtureVectorHex(v, "tx_hex"); ok {
+				if _, _, _, n, err := ParseTx(b); err == nil {
+					requireTxRoundtrip(t, where, b[:n])
+					checked++
+				}
+			}
+			if b, ok := fixtureVectorHex(v, "block_hex"); ok {
+				checked += requireBlockTxsRoundtrip(t, where, b)
+			}
+		}
+	}
+	if checked == 0 {
+		t.Fatalf("no fixture transactions parsed")
+	}
+}
+
+func fixtureVectorHex(v map[string]json.RawMessage, field string) ([]byte, bool) {
+	var s string
+	if json.Unmarshal(v[field], &s) != nil || s == "" {
+		return nil, false
+	}
+	b, err := hex.DecodeString(s)
+	return b, err == nil
+}
+
+func requireTxRoundtrip(t *testing.T, where string, wire []byte) {
+	t.Helper()
+	tx, _, _, _, err := ParseTx(wire)
+	if err != nil {
+		t.Fatalf("%s: ParseTx: %v", where, err)
+	}
+	got, err := MarshalTx(tx)
+	if err != nil {
+		t.Fatalf("%s: MarshalTx: %v", where, err)
+	}
+	if !bytes.Equal(got, wire) {
+		t.Fatalf("%s: tx round-trip mismatch\n got %x\nwant %x", where, got, wire)
+	}
+}
+
+// requireBlockTxsRoundtrip round-trips each tx of a parseable block and
+// returns how many it checked.
+func requireBlockTxsRoundtrip(t *testing.T, where string, block []byte) int {
+	t.Helper()
+	pb, err := ParseBlockBytes(block)
+	if err != nil {
+		return 0
+	}
+	off := BLOCK_HEADER_BYTES
+	if _, n, err := DecodeCompactSize(block[off:]); err != nil {
+		t.Fatalf("%s: tx_count: %v", where, err)
+	} else {
+		off += n
+	}
+	for i := range pb.Txs {
+		_, _, _, n, err := ParseTx(block[off:])
+		if err != nil {
+			t.Fatalf("%s: tx %d: ParseTx: %v", where, i, err)
+		}
+		requireTxRoundtrip(t, where, block[off:off+n])
+		off += n
+	}
+	if off != len(block) {
+		t.Fatalf("%s: %d trailing block bytes after %d txs", where, len(block)-off, len(pb.Txs))
+	}
+	return len(pb.Txs)
+}
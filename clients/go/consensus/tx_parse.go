@@ -410,11 +410,15 @@ func validateWitnessItemLengths(item WitnessItem, pubLen int, sigLen int) error
 			return txerr(TX_ERR_PARSE, "non-canonical sentinel witness item")
 		}
 	case SUITE_ID_ML_DSA_87:
-		if pubLen != ML_DSA_87_PUBKEY_BYTES || sigLen != ML_DSA_87_SIG_BYTES+1 {
+		wantPub, wantSig, _, _ := WitnessSuiteSizes(SUITE_ID_ML_DSA_87)
+		if pubLen != wantPub || sigLen != wantSig {
 			return txerr(TX_ERR_SIG_NONCANONICAL, "non-canonical ML-DSA witness item lengths")
 		}
 	case SUITE_ID_SIMPLICITY_ENVELOPE:
-		if pubLen != 0 {
+		// Only the pubkey length is structural here; the envelope size bound
+		// is a spend-time rule.
+		wantPub, _, _, _ := WitnessSuiteSizes(SUITE_ID_SIMPLICITY_ENVELOPE)
+		if pubLen != wantPub {
 			return txerr(TX_ERR_PARSE, "non-canonical Simplicity envelope witness item")
 		}
 		if err := validateSimplicityEnvelopeSignature(item.Signature); err != nil {
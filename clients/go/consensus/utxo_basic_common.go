@@ -18,10 +18,15 @@ type UtxoApplySummary struct {
 	UtxoCount uint64
 }
 
+// ApplyNonCoinbaseTxBasic is ApplyNonCoinbaseTxBasicWithMTP with blockTimestamp
+// standing in for the block MTP.
 func ApplyNonCoinbaseTxBasic(tx *Tx, txid [32]byte, utxoSet map[Outpoint]UtxoEntry, height uint64, blockTimestamp uint64, chainID [32]byte) (*UtxoApplySummary, error) {
 	return ApplyNonCoinbaseTxBasicWithMTP(tx, txid, utxoSet, height, blockTimestamp, blockTimestamp, chainID)
 }
 
+// ApplyNonCoinbaseTxBasicWithMTP evaluates timestamp-mode timelocks against
+// blockMTP (median-time-past), never the block's own timestamp, which the
+// miner chooses; blockTimestamp is accepted for signature compatibility only.
 func ApplyNonCoinbaseTxBasicWithMTP(
 	tx *Tx,
 	txid [32]byte,
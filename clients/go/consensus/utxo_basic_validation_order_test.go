@@ -0,0 +1,70 @@
+package consensus
+
+import (
+	"slices"
+	"testing"
+)
+
+func txValidationStep(t *testing.T, name string) (int, ValidationCheck) {
+	t.Helper()
+	for i, check := range TxValidationOrder {
+		if check.Name == name {
+			return i, check
+		}
+	}
+	t.Fatalf("step %q not in TxValidationOrder", name)
+	return 0, ValidationCheck{}
+}
+
+// TestTxValidationOrder_TwoRuleViolations applies transactions that break
+// two rules at once and asserts the code of the earlier TxValidationOrder
+// step.
+func TestTxValidationOrder_TwoRuleViolations(t *testing.T) {
+	prevTxid := hashWithPrefix(0xc0)
+	utxos := map[Outpoint]UtxoEntry{
+		{Txid: prevTxid}: {Value: 100, CovenantType: COV_TYPE_P2PK, CovenantData: validP2PKCovenantData()},
+	}
+	badCovenant := validP2PKCovenantData()[:MAX_P2PK_COVENANT_DATA-1]
+
+	// tx spends the 100-unit P2PK UTXO into one P2PK output of outValue.
+	tx := func(nonce uint64, prevTxid [32]byte, outValue uint64, outCovData []byte, witness []WitnessItem) *Tx {
+		return &Tx{
+			Version: TX_WIRE_VERSION,
+			TxNonce: nonce,
+			Inputs:  []TxInput{{PrevTxid: prevTxid}},
+			Outputs: []TxOutput{{Value: outValue, CovenantType: COV_TYPE_P2PK, CovenantData: outCovData}},
+			Witness: witness,
+		}
+	}
+	sentinel := dummyWitnesses(1)
+
+	cases := []struct {
+		earlier, later string
+		want           ErrorCode
+		tx             *Tx
+	}{
+		{"inputs_present", "nonce", TX_ERR_PARSE,
+			&Tx{Version: TX_WIRE_VERSION, Outputs: []TxOutput{{Value: 1, CovenantType: COV_TYPE_P2PK, CovenantData: validP2PKCovenantData()}}}},
+		{"nonce", "output_covenants", TX_ERR_TX_NONCE_INVALID, tx(0, prevTxid, 90, badCovenant, sentinel)},
+		{"output_covenants", "input_resolution", TX_ERR_COVENANT_TYPE_INVALID, tx(1, hashWithPrefix(0xc1), 90, badCovenant, sentinel)},
+		{"output_covenants", "value_conservation", TX_ERR_COVENANT_TYPE_INVALID, tx(1, prevTxid, 200, badCovenant, sentinel)},
+		{"input_resolution", "input_auth", TX_ERR_MISSING_UTXO, tx(1, hashWithPrefix(0xc1), 90, validP2PKCovenantData(), sentinel)},
+		{"input_auth", "value_conservation", TX_ERR_SIG_ALG_INVALID, tx(1, prevTxid, 200, validP2PKCovenantData(), sentinel)},
+	}
+	for _, tc := range cases {
+		t.Run(tc.earlier+"_before_"+tc.later, func(t *testing.T) {
+			earlierIdx, earlier := txValidationStep(t, tc.earlier)
+			laterIdx, _ := txValidationStep(t, tc.later)
+			if earlierIdx >= laterIdx {
+				t.Fatalf("%s (step %d) is not before %s (step %d)", tc.earlier, earlierIdx, tc.later, laterIdx)
+			}
+			if earlier.Codes != nil && !slices.Contains(earlier.Codes, tc.want) {
+				t.Fatalf("%s is not a %s code %v", tc.want, tc.earlier, earlier.Codes)
+			}
+			_, err := ApplyNonCoinbaseTxBasic(tc.tx, [32]byte{0x01}, utxos, 200, 1000, [32]byte{})
+			if got := mustTxErrCode(t, err); got != tc.want {
+				t.Fatalf("code=%s, want %s (%v)", got, tc.want, err)
+			}
+		})
+	}
+}
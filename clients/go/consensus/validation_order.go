@@ -0,0 +1,73 @@
+package consensus
+
+// ValidationCheck is one step of BlockValidationOrder or TxValidationOrder:
+// a named rule and the error codes a block or transaction failing that rule
+// is rejected with. Codes is nil for steps that may report any TX_ERR_* code.
+type ValidationCheck struct {
+	Name  string
+	Codes []ErrorCode
+}
+
+// BlockValidationOrder is the canonical order in which block rules are
+// checked. A block violating several rules is rejected with a code of the
+// earliest failing step, so every client must evaluate them in this order.
+// Steps through "tx" are the basic block checks (ValidateBlockBasic*); the
+// connect path (ConnectBlockBasicInMemoryAtHeight and its parallel variant)
+// runs them first and only then applies transactions and checks the
+// coinbase. Header step names match the CV-VALIDATION-ORDER vocabulary.
+var BlockValidationOrder = []ValidationCheck{
+	{Name: "parse", Codes: []ErrorCode{BLOCK_ERR_PARSE, TX_ERR_PARSE}},
+	{Name: "target_range", Codes: []ErrorCode{BLOCK_ERR_TARGET_INVALID}},
+	{Name: "pow", Codes: []ErrorCode{BLOCK_ERR_POW_INVALID}},
+	{Name: "expected_target", Codes: []ErrorCode{BLOCK_ERR_TARGET_INVALID}},
+	{Name: "linkage", Codes: []ErrorCode{BLOCK_ERR_LINKAGE_INVALID}},
+	{Name: "merkle", Codes: []ErrorCode{BLOCK_ERR_MERKLE_INVALID}},
+	{Name: "witness_commitment", Codes: []ErrorCode{BLOCK_ERR_WITNESS_COMMITMENT}},
+	{Name: "timestamp", Codes: []ErrorCode{BLOCK_ERR_TIMESTAMP_OLD, BLOCK_ERR_TIMESTAMP_FUTURE}},
+	{Name: "weight", Codes: []ErrorCode{BLOCK_ERR_WEIGHT_EXCEEDED}},
+	// Summed witness verify cost against MAX_BLOCK_VERIFY_COST.
+	{Name: "verify_cost", Codes: []ErrorCode{BLOCK_ERR_WEIGHT_EXCEEDED}},
+	// Summed DA payload bytes against MAX_DA_BYTES_PER_BLOCK.
+	{Name: "da_bytes", Codes: []ErrorCode{BLOCK_ERR_WEIGHT_EXCEEDED}},
+	{Name: "anchor_limit", Codes: []ErrorCode{BLOCK_ERR_ANCHOR_BYTES_EXCEEDED}},
+	{Name: "da_set", Codes: []ErrorCode{
+		BLOCK_ERR_DA_SET_INVALID,
+		BLOCK_ERR_DA_CHUNK_HASH_INVALID,
+		BLOCK_ERR_DA_BATCH_EXCEEDED,
+		BLOCK_ERR_DA_INCOMPLETE,
+		BLOCK_ERR_DA_PAYLOAD_COMMIT_INVALID,
+	}},
+	{Name: "coinbase", Codes: []ErrorCode{BLOCK_ERR_COINBASE_INVALID}},
+	// Per transaction in block order: no coinbase-like tx past index 0
+	// (BLOCK_ERR_COINBASE_INVALID), at least one input, no tx_nonce replay,
+	// covenant genesis.
+	{Name: "tx"},
+	// Connect path only: each non-coinbase tx applied to the UTXO set in
+	// block order (inputs, signatures, value conservation).
+	{Name: "utxo_apply"},
+	{Name: "subsidy", Codes: []ErrorCode{BLOCK_ERR_SUBSIDY_EXCEEDED}},
+	{Name: "coinbase_outputs", Codes: []ErrorCode{BLOCK_ERR_COINBASE_INVALID}},
+}
+
+// TxValidationOrder is the canonical order in which a non-coinbase
+// transaction is checked when applied to the UTXO set
+// (ApplyNonCoinbaseTxBasic* and the block connect paths). As with
+// BlockValidationOrder, a transaction violating several rules is rejected
+// with a code of the earliest failing step.
+var TxValidationOrder = []ValidationCheck{
+	{Name: "inputs_present", Codes: []ErrorCode{TX_ERR_PARSE}},
+	{Name: "nonce", Codes: []ErrorCode{TX_ERR_TX_NONCE_INVALID}},
+	// Every output's covenant creation rules (ValidateTxCovenantsGenesis),
+	// in output order.
+	{Name: "output_covenants"},
+	// Per input in order: encoding, duplicate outpoint, UTXO lookup,
+	// spendability, coinbase maturity; then witness slot assignment.
+	{Name: "input_resolution"},
+	// CORE_SIMPLICITY same-cmr input group cap.
+	{Name: "simplicity_group"},
+	// Per input in order: covenant spend rules and signature verification.
+	{Name: "input_auth"},
+	// CORE_VAULT creation and spend rules, which need the full output set.
+	{Name: "vault_rules"},
+	{Name: "value_conservation", Codes: []ErrorCode{TX_ERR_VALUE_CONSERVATION}},
+}
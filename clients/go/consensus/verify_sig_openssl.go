@@ -424,6 +424,20 @@ func VerifyMLDSA87Digest32(pubkey []byte, signature []byte, digest32 [32]byte) (
 	return verifySigWithBinding(binding, pubkey, signature, digest32)
 }
 
+// VerifySLHDSASHAKE256fDigest32 verifies a raw SLH-DSA-SHAKE-256f signature
+// over a 32-byte digest. Like VerifyMLDSA87Digest32 it performs no sighash or
+// suite_id handling; it does not consult the live binding policy and is not a
+// consensus verification path.
+func VerifySLHDSASHAKE256fDigest32(pubkey []byte, signature []byte, digest32 [32]byte) (bool, error) {
+	if len(pubkey) != SLH_DSA_SHAKE_256F_PUBKEY_BYTES || len(signature) != SLH_DSA_SHAKE_256F_SIG_BYTES {
+		return false, nil
+	}
+	if err := ensureOpenSSLBootstrap(); err != nil {
+		return false, err
+	}
+	return opensslVerifySigOneShotFn("SLH-DSA-SHAKE-256f", pubkey, signature, digest32[:])
+}
+
 type suiteVerifierBindingKind uint8
 
 const (
@@ -230,3 +230,64 @@ func TestTxWeightAtHeight_WrongLengths_UsesFloor(t *testing.T) {
 		t.Errorf("wrong-lengths weight %d != legacy %d (both should use unknown floor)", weight, legacy)
 	}
 }
+
+func TestTxWeight_SigCostFollowsEachWitnessItemSuite(t *testing.T) {
+	sentinel := WitnessItem{SuiteID: SUITE_ID_SENTINEL}
+	slh := WitnessItem{
+		// Not a native suite, so it pays the unknown-suite floor.
+		SuiteID:   testSuiteIDSLHDSASHAKE256f,
+		Pubkey:    make([]byte, SLH_DSA_SHAKE_256F_PUBKEY_BYTES),
+		Signature: make([]byte, SLH_DSA_SHAKE_256F_SIG_BYTES+1),
+	}
+	mlDSA := WitnessItem{
+		SuiteID:   SUITE_ID_ML_DSA_87,
+		Pubkey:    make([]byte, ML_DSA_87_PUBKEY_BYTES),
+		Signature: make([]byte, ML_DSA_87_SIG_BYTES+1),
+	}
+	twoInputs := func(ws []WitnessItem) *Tx {
+		tx := txWithWitness(ws)
+		tx.Inputs = []TxInput{{PrevVout: 0}, {PrevVout: 1}}
+		return tx
+	}
+
+	cases := []struct {
+		name string
+		tx   *Tx
+		want uint64
+	}{
+		{"coinbase_no_witness", &Tx{Version: TX_WIRE_VERSION, Inputs: []TxInput{{PrevVout: ^uint32(0)}}}, 0},
+		{"sentinel_then_slh", twoInputs([]WitnessItem{sentinel, slh}), VERIFY_COST_UNKNOWN_SUITE},
+		{"slh_then_sentinel", twoInputs([]WitnessItem{slh, sentinel}), VERIFY_COST_UNKNOWN_SUITE},
+		// More witness items than inputs, as a multisig-style spend uses:
+		// every item is charged by its own suite, not by input position.
+		{"multi_slot_single_input", txWithWitness([]WitnessItem{mlDSA, slh, sentinel}), VERIFY_COST_ML_DSA_87 + VERIFY_COST_UNKNOWN_SUITE},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, legacy, err := computeTxWitness(tc.tx, legacyWitnessSigCost)
+			if err != nil {
+				t.Fatalf("legacy computeTxWitness: %v", err)
+			}
+			if legacy != tc.want {
+				t.Fatalf("legacy sig_cost=%d, want %d", legacy, tc.want)
+			}
+
+			noCost, err := txWeightComponentsNoSigCost(tc.tx)
+			if err != nil {
+				t.Fatalf("zero-cost weight: %v", err)
+			}
+			registry, _, _, err := TxWeightAndStatsAtHeight(tc.tx, 100, DefaultRotationProvider{}, DefaultSuiteRegistry())
+			if err != nil {
+				t.Fatalf("registry weight: %v", err)
+			}
+			if got := registry - noCost; got != tc.want {
+				t.Fatalf("registry sig_cost=%d, want %d", got, tc.want)
+			}
+		})
+	}
+}
+
+func txWeightComponentsNoSigCost(tx *Tx) (uint64, error) {
+	weight, _, _, err := txWeightComponents(tx, func(WitnessItem) (uint64, error) { return 0, nil })
+	return weight, err
+}
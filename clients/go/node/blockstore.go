@@ -1,6 +1,7 @@
 package node
 
 import (
+	"crypto/sha3"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
@@ -35,6 +36,14 @@ type BlockStore struct {
 
 	canonicalHeightByHash map[[32]byte]uint64
 	chainWorkByHash       map[[32]byte]*big.Int
+	invalidByHash         map[[32]byte]struct{}
+
+	// The stored-header tree: each header's parent, its children, and the
+	// leaves no stored header builds on. Loaded from the headers directory
+	// on first use, then extended by StoreBlock; nil until loaded.
+	headerParents  map[[32]byte][32]byte
+	headerChildren map[[32]byte][][32]byte
+	headerLeaves   map[[32]byte]struct{}
 }
 
 // Block/header/undo blobs are append-only. The canonical chain view is the index file,
@@ -43,7 +52,20 @@ type BlockStore struct {
 
 type blockStoreIndexDisk struct {
 	Canonical []string `json:"canonical"`
-	Version   uint32   `json:"version"`
+	// Digest is the rolling integrity digest over the canonical hashes,
+	// digest_i = SHA3-256(digest_{i-1} || hash_i) starting from 32 zero bytes.
+	// Indexes written before the field existed load with it recomputed.
+	Digest string `json:"digest,omitempty"`
+	// TipCumulativeWork is the canonical tip's chain work as a lowercase hex
+	// bignum, the sum of WorkFromTarget over every canonical header. It is
+	// empty on indexes written before the field existed and after a reorg
+	// that cannot derive it incrementally; the first read then recomputes it
+	// from headers, so opening the store never depends on them.
+	TipCumulativeWork string `json:"tip_cumulative_work,omitempty"`
+	// Invalid lists operator-invalidated block hashes (see
+	// SyncEngine.InvalidateBlock), kept sorted for a stable index file.
+	Invalid []string `json:"invalid,omitempty"`
+	Version uint32   `json:"version"`
 }
 
 func BlockStorePath(dataDir string) string {
@@ -74,6 +96,10 @@ func OpenBlockStore(rootPath string) (*BlockStore, error) {
 	if err != nil {
 		return nil, err
 	}
+	invalidByHash, err := buildInvalidBlockSet(index.Invalid)
+	if err != nil {
+		return nil, err
+	}
 
 	bs := &BlockStore{
 		rootPath:   rootPath,
@@ -85,6 +111,7 @@ func OpenBlockStore(rootPath string) (*BlockStore, error) {
 
 		canonicalHeightByHash: canonicalHeightByHash,
 		chainWorkByHash:       make(map[[32]byte]*big.Int),
+		invalidByHash:         invalidByHash,
 	}
 	return bs, nil
 }
@@ -119,7 +146,10 @@ func (bs *BlockStore) StoreBlock(blockHash [32]byte, headerBytes []byte, blockBy
 	if err := validateBlockHeaderHash(headerBytes, blockHash); err != nil {
 		return err
 	}
-	return bs.persistBlockBytes(blockHash, headerBytes, blockBytes)
+	if err := bs.persistBlockBytes(blockHash, headerBytes, blockBytes); err != nil {
+		return err
+	}
+	return bs.noteStoredHeader(blockHash, headerBytes)
 }
 
 func (bs *BlockStore) SetCanonicalTip(height uint64, blockHash [32]byte) error {
@@ -135,17 +165,35 @@ func (bs *BlockStore) SetCanonicalTip(height uint64, blockHash [32]byte) error {
 	case height > currentLen:
 		return fmt.Errorf("height gap: got %d, expected <= %d", height, currentLen)
 	case height == currentLen:
+		digest, err := parseHex32("blockstore digest", bs.index.Digest)
+		if err != nil {
+			return err
+		}
+		work, err := bs.tipCumulativeWorkLocked()
+		if err != nil {
+			return err
+		}
+		blockWork, err := bs.headerWork(blockHash)
+		if err != nil {
+			return err
+		}
+		digest = nextCanonicalDigest(digest, blockHash)
 		bs.index.Canonical = append(bs.index.Canonical, hashHex)
+		bs.index.Digest = hex.EncodeToString(digest[:])
+		bs.index.TipCumulativeWork = work.Add(work, blockWork).Text(16)
 		bs.canonicalHeightByHash[blockHash] = height
 	case bs.index.Canonical[height] == hashHex:
 		// No-op.
 	default:
+		nextCanonical := append([]string(nil), bs.index.Canonical[:height]...)
+		nextCanonical = append(nextCanonical, hashHex)
+		work := bs.canonicalWorkLocked(nextCanonical)
 		if err := bs.dropCanonicalStateFromLocked(height); err != nil {
 			return err
 		}
-		nextCanonical := append([]string(nil), bs.index.Canonical[:height]...)
-		nextCanonical = append(nextCanonical, hashHex)
-		bs.index.Canonical = nextCanonical
+		if err := bs.setCanonicalLocked(nextCanonical, work); err != nil {
+			return err
+		}
 		bs.canonicalHeightByHash[blockHash] = height
 	}
 	return saveBlockStoreIndex(bs.indexPath, bs.index)
@@ -173,10 +221,14 @@ func (bs *BlockStore) TruncateCanonical(count uint64) error {
 	if count > uint64(len(bs.index.Canonical)) {
 		return fmt.Errorf("truncate count out of range: %d", count)
 	}
+	nextCanonical := append([]string(nil), bs.index.Canonical[:count]...)
+	work := bs.canonicalWorkLocked(nextCanonical)
 	if err := bs.dropCanonicalStateFromLocked(count); err != nil {
 		return err
 	}
-	bs.index.Canonical = append([]string(nil), bs.index.Canonical[:count]...)
+	if err := bs.setCanonicalLocked(nextCanonical, work); err != nil {
+		return err
+	}
 	return saveBlockStoreIndex(bs.indexPath, bs.index)
 }
 
@@ -240,11 +292,67 @@ func (bs *BlockStore) RestoreCanonicalIndex(canonical []string) error {
 	}
 	bs.stateMu.Lock()
 	defer bs.stateMu.Unlock()
-	bs.index.Canonical = nextCanonical
+	work := bs.canonicalWorkLocked(nextCanonical)
 	bs.replaceCanonicalState(nextIndex)
+	if err := bs.setCanonicalLocked(nextCanonical, work); err != nil {
+		return err
+	}
 	return saveBlockStoreIndex(bs.indexPath, bs.index)
 }
 
+// CheckIntegrity recomputes the rolling canonical digest and compares it with
+// the one stored in the index, then re-hashes every canonical block body
+// against its header so silent corruption is caught without a full verify.
+func (bs *BlockStore) CheckIntegrity() error {
+	if bs == nil {
+		return errors.New("nil blockstore")
+	}
+	bs.stateMu.RLock()
+	canonical := append([]string(nil), bs.index.Canonical...)
+	storedDigest := bs.index.Digest
+	bs.stateMu.RUnlock()
+
+	digest, err := canonicalDigest(canonical)
+	if err != nil {
+		return err
+	}
+	if hex.EncodeToString(digest[:]) != storedDigest {
+		return errors.New("blockstore digest mismatch")
+	}
+	for height, hashHex := range canonical {
+		hash, err := parseHex32(fmt.Sprintf("canonical[%d]", height), hashHex)
+		if err != nil {
+			return err
+		}
+		if err := bs.checkStoredBlock(hash); err != nil {
+			return fmt.Errorf("blockstore block %d (%s): %w", height, hashHex, err)
+		}
+	}
+	return nil
+}
+
+func (bs *BlockStore) checkStoredBlock(blockHash [32]byte) error {
+	blockBytes, err := bs.GetBlockByHash(blockHash)
+	if err != nil {
+		return err
+	}
+	pb, err := consensus.ParseBlockBytes(blockBytes)
+	if err != nil {
+		return err
+	}
+	if err := validateBlockHeaderHash(pb.HeaderBytes, blockHash); err != nil {
+		return err
+	}
+	merkleRoot, err := consensus.MerkleRootTxids(pb.Txids)
+	if err != nil {
+		return err
+	}
+	if merkleRoot != pb.Header.MerkleRoot {
+		return errors.New("block body does not match header merkle root")
+	}
+	return nil
+}
+
 func (bs *BlockStore) GetBlockByHash(blockHash [32]byte) ([]byte, error) {
 	if bs == nil {
 		return nil, errors.New("nil blockstore")
@@ -451,9 +559,11 @@ func (bs *BlockStore) GetUndo(blockHash [32]byte) (*BlockUndo, error) {
 func loadBlockStoreIndex(path string) (blockStoreIndexDisk, error) {
 	raw, err := readFileByPath(path)
 	if errors.Is(err, os.ErrNotExist) {
+		var zero [32]byte
 		return blockStoreIndexDisk{
 			Version:   blockStoreIndexVersion,
 			Canonical: []string{},
+			Digest:    hex.EncodeToString(zero[:]),
 		}, nil
 	}
 	if err != nil {
@@ -471,9 +581,183 @@ func loadBlockStoreIndex(path string) (blockStoreIndexDisk, error) {
 			return blockStoreIndexDisk{}, err
 		}
 	}
+	if index.Digest == "" {
+		digest, err := canonicalDigest(index.Canonical)
+		if err != nil {
+			return blockStoreIndexDisk{}, err
+		}
+		index.Digest = hex.EncodeToString(digest[:])
+	} else if _, err := parseHex32("blockstore digest", index.Digest); err != nil {
+		return blockStoreIndexDisk{}, err
+	}
 	return index, nil
 }
 
+// setCanonicalLocked replaces the canonical list with canonical, whose tip
+// chain work is work. A nil work leaves the recorded tip work empty, to be
+// recomputed from headers on use.
+func (bs *BlockStore) setCanonicalLocked(canonical []string, work *big.Int) error {
+	digest, err := canonicalDigest(canonical)
+	if err != nil {
+		return err
+	}
+	bs.index.Canonical = canonical
+	bs.index.Digest = hex.EncodeToString(digest[:])
+	bs.index.TipCumulativeWork = ""
+	if work != nil {
+		bs.index.TipCumulativeWork = work.Text(16)
+	}
+	return nil
+}
+
+// canonicalWorkLocked returns the tip chain work of next, a canonical list
+// replacing the current one, reading only the headers that differ: the work
+// of the prefix next shares with the current list, plus the work of next's
+// own blocks past it. It returns nil when that work cannot be derived, e.g. a
+// header is missing. It must run before dropCanonicalStateFromLocked clears
+// the chain work cache.
+func (bs *BlockStore) canonicalWorkLocked(next []string) *big.Int {
+	common := 0
+	for common < len(next) && common < len(bs.index.Canonical) && next[common] == bs.index.Canonical[common] {
+		common++
+	}
+	work := bs.canonicalPrefixWorkLocked(uint64(common))
+	if work == nil {
+		return nil
+	}
+	for i := common; i < len(next); i++ {
+		hash, err := parseHex32(fmt.Sprintf("canonical[%d]", i), next[i])
+		if err != nil {
+			return nil
+		}
+		blockWork, err := bs.headerWork(hash)
+		if err != nil {
+			return nil
+		}
+		work.Add(work, blockWork)
+	}
+	return work
+}
+
+// canonicalPrefixWorkLocked returns the chain work of the first count
+// canonical blocks without rescanning them: the cached chain work of
+// canonical[count-1] when present, otherwise the recorded tip work less the
+// work of the blocks past count.
+func (bs *BlockStore) canonicalPrefixWorkLocked(count uint64) *big.Int {
+	if count == 0 {
+		return big.NewInt(0)
+	}
+	last, err := parseHex32("canonical hash", bs.index.Canonical[count-1])
+	if err != nil {
+		return nil
+	}
+	if cached, ok := bs.chainWorkByHash[last]; ok {
+		return cloneBigInt(cached)
+	}
+	work, err := bs.tipCumulativeWorkLocked()
+	if err != nil {
+		return nil
+	}
+	for i := count; i < uint64(len(bs.index.Canonical)); i++ {
+		hash, err := parseHex32(fmt.Sprintf("canonical[%d]", i), bs.index.Canonical[i])
+		if err != nil {
+			return nil
+		}
+		blockWork, err := bs.headerWork(hash)
+		if err != nil {
+			return nil
+		}
+		work.Sub(work, blockWork)
+	}
+	if work.Sign() < 0 {
+		return nil
+	}
+	return work
+}
+
+// TipCumulativeWork returns the canonical tip's chain work as recorded in the
+// index, so fork choice can compare against a competing chain without
+// rescanning headers. An empty store reports zero.
+func (bs *BlockStore) TipCumulativeWork() (*big.Int, error) {
+	if bs == nil {
+		return nil, errors.New("nil blockstore")
+	}
+	bs.stateMu.Lock()
+	defer bs.stateMu.Unlock()
+	return bs.tipCumulativeWorkLocked()
+}
+
+// IndexVersion returns the on-disk schema version of the canonical index.
+func (bs *BlockStore) IndexVersion() uint32 {
+	if bs == nil {
+		return 0
+	}
+	bs.stateMu.RLock()
+	defer bs.stateMu.RUnlock()
+	return bs.index.Version
+}
+
+// tipCumulativeWorkLocked returns the recorded tip work, computing and
+// recording it first when the index has none. It needs the write lock.
+func (bs *BlockStore) tipCumulativeWorkLocked() (*big.Int, error) {
+	if bs.index.TipCumulativeWork == "" {
+		work, err := bs.canonicalWork(bs.index.Canonical)
+		if err != nil {
+			return nil, err
+		}
+		bs.index.TipCumulativeWork = work.Text(16)
+		return work, nil
+	}
+	work, ok := new(big.Int).SetString(bs.index.TipCumulativeWork, 16)
+	if !ok || work.Sign() < 0 {
+		return nil, fmt.Errorf("invalid blockstore tip cumulative work: %q", bs.index.TipCumulativeWork)
+	}
+	return work, nil
+}
+
+func (bs *BlockStore) canonicalWork(canonical []string) (*big.Int, error) {
+	total := big.NewInt(0)
+	for i, hashHex := range canonical {
+		hash, err := parseHex32(fmt.Sprintf("canonical[%d]", i), hashHex)
+		if err != nil {
+			return nil, err
+		}
+		work, err := bs.headerWork(hash)
+		if err != nil {
+			return nil, err
+		}
+		total.Add(total, work)
+	}
+	return total, nil
+}
+
+func (bs *BlockStore) headerWork(blockHash [32]byte) (*big.Int, error) {
+	header, err := bs.chainWorkHeader(blockHash)
+	if err != nil {
+		return nil, err
+	}
+	return consensus.WorkFromTarget(header.Target)
+}
+
+func canonicalDigest(canonical []string) ([32]byte, error) {
+	var digest [32]byte
+	for i, hashHex := range canonical {
+		hash, err := parseHex32(fmt.Sprintf("canonical[%d]", i), hashHex)
+		if err != nil {
+			return [32]byte{}, err
+		}
+		digest = nextCanonicalDigest(digest, hash)
+	}
+	return digest, nil
+}
+
+func nextCanonicalDigest(prev [32]byte, blockHash [32]byte) [32]byte {
+	var preimage [64]byte
+	copy(preimage[:32], prev[:])
+	copy(preimage[32:], blockHash[:])
+	return sha3.Sum256(preimage[:])
+}
+
 func saveBlockStoreIndex(path string, index blockStoreIndexDisk) error {
 	raw, err := json.MarshalIndent(index, "", "  ")
 	if err != nil {
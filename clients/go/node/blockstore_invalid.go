@@ -0,0 +1,98 @@
+package node
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+func buildInvalidBlockSet(invalid []string) (map[[32]byte]struct{}, error) {
+	out := make(map[[32]byte]struct{}, len(invalid))
+	for i, hashHex := range invalid {
+		hash, err := parseHex32(fmt.Sprintf("invalid[%d]", i), hashHex)
+		if err != nil {
+			return nil, err
+		}
+		out[hash] = struct{}{}
+	}
+	return out, nil
+}
+
+// IsBlockInvalid reports whether blockHash carries an operator invalidation
+// mark.
+func (bs *BlockStore) IsBlockInvalid(blockHash [32]byte) bool {
+	if bs == nil {
+		return false
+	}
+	bs.stateMu.RLock()
+	defer bs.stateMu.RUnlock()
+	_, ok := bs.invalidByHash[blockHash]
+	return ok
+}
+
+// SetBlocksInvalid adds (invalid=true) or clears (invalid=false) the
+// invalidation mark on every hash and persists the index.
+func (bs *BlockStore) SetBlocksInvalid(hashes [][32]byte, invalid bool) error {
+	if bs == nil {
+		return errors.New("nil blockstore")
+	}
+	bs.stateMu.Lock()
+	defer bs.stateMu.Unlock()
+	next := make(map[[32]byte]struct{}, len(bs.invalidByHash)+len(hashes))
+	for hash := range bs.invalidByHash {
+		next[hash] = struct{}{}
+	}
+	for _, hash := range hashes {
+		if invalid {
+			next[hash] = struct{}{}
+		} else {
+			delete(next, hash)
+		}
+	}
+	list := make([]string, 0, len(next))
+	for hash := range next {
+		list = append(list, hex.EncodeToString(hash[:]))
+	}
+	sort.Strings(list)
+
+	index := bs.index
+	index.Invalid = list
+	if len(list) == 0 {
+		index.Invalid = nil
+	}
+	if err := saveBlockStoreIndex(bs.indexPath, index); err != nil {
+		return err
+	}
+	bs.index = index
+	bs.invalidByHash = next
+	return nil
+}
+
+// StoredDescendants returns blockHash followed by every stored block that
+// builds on it, in breadth-first order.
+func (bs *BlockStore) StoredDescendants(blockHash [32]byte) ([][32]byte, error) {
+	if bs == nil {
+		return nil, errors.New("nil blockstore")
+	}
+	bs.stateMu.Lock()
+	defer bs.stateMu.Unlock()
+	if err := bs.loadHeaderTreeLocked(); err != nil {
+		return nil, err
+	}
+	out := [][32]byte{blockHash}
+	seen := map[[32]byte]struct{}{blockHash: {}}
+	for i := 0; i < len(out); i++ {
+		next := append([][32]byte(nil), bs.headerChildren[out[i]]...)
+		sort.Slice(next, func(a, b int) bool { return bytes.Compare(next[a][:], next[b][:]) < 0 })
+		for _, child := range next {
+			if _, ok := seen[child]; ok {
+				continue
+			}
+			seen[child] = struct{}{}
+			out = append(out, child)
+		}
+	}
+	return out, nil
+}
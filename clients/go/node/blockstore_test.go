@@ -2,10 +2,12 @@ package node
 
 import (
 	"bytes"
+	"context"
 	"encoding/binary"
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"math/big"
 	"os"
 	"path/filepath"
 	"strings"
@@ -443,3 +445,297 @@ func testHeaderBytes(seed byte, nonce uint64) []byte {
 	binary.LittleEndian.PutUint64(header[108:116], nonce)
 	return header
 }
+
+func TestBlockStoreCheckIntegrityDetectsCorruptBlockBody(t *testing.T) {
+	dir := t.TempDir()
+	chainState := NewChainState()
+	store := mustOpenBlockStore(t, BlockStorePath(dir))
+	syncEngine, err := NewSyncEngine(chainState, store, DefaultSyncConfig(nil, [32]byte{}, ChainStatePath(dir)))
+	if err != nil {
+		t.Fatalf("new sync engine: %v", err)
+	}
+	miner, err := NewMiner(chainState, store, syncEngine, DefaultMinerConfig())
+	if err != nil {
+		t.Fatalf("new miner: %v", err)
+	}
+	mined, err := miner.MineN(context.Background(), 3, nil)
+	if err != nil {
+		t.Fatalf("mine n: %v", err)
+	}
+	if err := store.CheckIntegrity(); err != nil {
+		t.Fatalf("check integrity: %v", err)
+	}
+
+	var want [32]byte
+	for _, block := range mined {
+		want = nextCanonicalDigest(want, block.Hash)
+	}
+	reopened := mustOpenBlockStore(t, BlockStorePath(dir))
+	if reopened.index.Digest != hex.EncodeToString(want[:]) {
+		t.Fatalf("persisted digest=%s, want %x", reopened.index.Digest, want)
+	}
+
+	// Flip a locktime byte in the coinbase: the block still parses but no
+	// longer matches the merkle root committed in its header.
+	path := filepath.Join(reopened.blocksDir, hex.EncodeToString(mined[1].Hash[:])+".bin")
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read block: %v", err)
+	}
+	raw[len(raw)-3] ^= 0xff
+	if err := os.WriteFile(path, raw, 0o600); err != nil {
+		t.Fatalf("write block: %v", err)
+	}
+	err = reopened.CheckIntegrity()
+	if err == nil || !strings.Contains(err.Error(), "merkle root") {
+		t.Fatalf("check integrity err=%v, want merkle root mismatch", err)
+	}
+}
+
+func TestBlockStoreCheckIntegrityDetectsDigestMismatch(t *testing.T) {
+	store := mustOpenBlockStore(t, filepath.Join(t.TempDir(), "blockstore"))
+	hash0, _ := mustPutBlock(t, store, 0, 1, 11, []byte("block-0"))
+	_, _ = mustPutBlock(t, store, 1, 2, 22, []byte("block-1a"))
+	hash1b, _ := mustPutBlock(t, store, 1, 3, 33, []byte("block-1b"))
+
+	want := nextCanonicalDigest(nextCanonicalDigest([32]byte{}, hash0), hash1b)
+	if store.index.Digest != hex.EncodeToString(want[:]) {
+		t.Fatalf("digest after reorg=%s, want %x", store.index.Digest, want)
+	}
+	if err := store.RewindToHeight(0); err != nil {
+		t.Fatalf("rewind: %v", err)
+	}
+	want = nextCanonicalDigest([32]byte{}, hash0)
+	if store.index.Digest != hex.EncodeToString(want[:]) {
+		t.Fatalf("digest after rewind=%s, want %x", store.index.Digest, want)
+	}
+
+	store.index.Digest = strings.Repeat("00", 32)
+	if err := store.CheckIntegrity(); err == nil || !strings.Contains(err.Error(), "digest mismatch") {
+		t.Fatalf("check integrity err=%v, want digest mismatch", err)
+	}
+}
+
+func TestBlockStoreTipCumulativeWorkTracksImportedHeaders(t *testing.T) {
+	root := filepath.Join(t.TempDir(), "blockstore")
+	store := mustOpenBlockStore(t, root)
+	if work, err := store.TipCumulativeWork(); err != nil || work.Sign() != 0 {
+		t.Fatalf("empty store work=%v err=%v, want 0", work, err)
+	}
+
+	want := new(big.Int)
+	var forkBase *big.Int
+	for height, shift := range []uint{0, 2, 5, 9} {
+		header := testHeaderBytes(byte(height+1), uint64(height))
+		target := consensus.POW_LIMIT
+		target[0] >>= shift
+		copy(header[76:108], target[:])
+		hash := mustHeaderHash(t, header)
+		if err := store.PutBlock(uint64(height), hash, header, []byte{byte(height)}); err != nil {
+			t.Fatalf("put block %d: %v", height, err)
+		}
+		work, err := consensus.WorkFromTarget(target)
+		if err != nil {
+			t.Fatalf("work from target: %v", err)
+		}
+		want.Add(want, work)
+		if height == 1 {
+			forkBase = new(big.Int).Set(want)
+		}
+		got, err := store.TipCumulativeWork()
+		if err != nil {
+			t.Fatalf("tip cumulative work: %v", err)
+		}
+		if got.Cmp(want) != 0 {
+			t.Fatalf("height %d work=%s, want %s", height, got, want)
+		}
+	}
+
+	reopened := mustOpenBlockStore(t, root)
+	got, err := reopened.TipCumulativeWork()
+	if err != nil {
+		t.Fatalf("tip cumulative work after reopen: %v", err)
+	}
+	if got.Cmp(want) != 0 {
+		t.Fatalf("reopened work=%s, want %s", got, want)
+	}
+	if err := reopened.RewindToHeight(1); err != nil {
+		t.Fatalf("rewind: %v", err)
+	}
+	if got, err := reopened.TipCumulativeWork(); err != nil || got.Cmp(forkBase) != 0 {
+		t.Fatalf("work after rewind=%v err=%v, want %s", got, err, forkBase)
+	}
+}
+
+func TestBlockStoreChainTipsClassifiesSideBranches(t *testing.T) {
+	store := mustOpenBlockStore(t, filepath.Join(t.TempDir(), "blockstore"))
+	linked := func(prev [32]byte, nonce uint64) ([32]byte, []byte) {
+		header := testHeaderBytes(0, nonce)
+		copy(header[4:36], prev[:])
+		return mustHeaderHash(t, header), header
+	}
+	genesis, header0 := linked([32]byte{}, 1)
+	if err := store.PutBlock(0, genesis, header0, []byte("b0")); err != nil {
+		t.Fatalf("put genesis: %v", err)
+	}
+	canon1, header1 := linked(genesis, 2)
+	if err := store.PutBlock(1, canon1, header1, []byte("b1")); err != nil {
+		t.Fatalf("put canonical 1: %v", err)
+	}
+	canon2, header2 := linked(canon1, 3)
+	if err := store.PutBlock(2, canon2, header2, []byte("b2")); err != nil {
+		t.Fatalf("put canonical 2: %v", err)
+	}
+
+	// Two-block valid fork off genesis and a headers-only fork off height 1.
+	side1, sideHeader1 := linked(genesis, 10)
+	side2, sideHeader2 := linked(side1, 11)
+	headersOnly, headersOnlyHeader := linked(canon1, 12)
+	for _, blk := range []struct {
+		hash   [32]byte
+		header []byte
+	}{{side1, sideHeader1}, {side2, sideHeader2}, {headersOnly, headersOnlyHeader}} {
+		if err := store.StoreBlock(blk.hash, blk.header, []byte("side")); err != nil {
+			t.Fatalf("store side block: %v", err)
+		}
+	}
+	if err := os.Remove(filepath.Join(store.blocksDir, hex.EncodeToString(headersOnly[:])+".bin")); err != nil {
+		t.Fatalf("remove body: %v", err)
+	}
+
+	tips, err := store.ChainTips()
+	if err != nil {
+		t.Fatalf("chain tips: %v", err)
+	}
+	want := []ChainTip{
+		{Height: 2, Hash: canon2, BranchLen: 0, Status: ChainTipStatusActive},
+		{Height: 2, Hash: side2, BranchLen: 2, Status: ChainTipStatusValidFork},
+		{Height: 2, Hash: headersOnly, BranchLen: 1, Status: ChainTipStatusHeadersOnly},
+	}
+	if len(tips) != len(want) {
+		t.Fatalf("tips=%+v, want %d entries", tips, len(want))
+	}
+	if tips[0] != want[0] {
+		t.Fatalf("active tip=%+v, want %+v", tips[0], want[0])
+	}
+	for _, w := range want[1:] {
+		found := false
+		for _, tip := range tips[1:] {
+			if tip == w {
+				found = true
+			}
+		}
+		if !found {
+			t.Fatalf("tips=%+v, missing %+v", tips, w)
+		}
+	}
+}
+
+func TestBlockStoreChainTipsTracksStoredHeadersWithoutRescan(t *testing.T) {
+	store := mustOpenBlockStore(t, filepath.Join(t.TempDir(), "blockstore"))
+	linked := func(prev [32]byte, nonce uint64) ([32]byte, []byte) {
+		header := testHeaderBytes(0, nonce)
+		copy(header[4:36], prev[:])
+		return mustHeaderHash(t, header), header
+	}
+	genesis, header0 := linked([32]byte{}, 1)
+	if err := store.PutBlock(0, genesis, header0, []byte("b0")); err != nil {
+		t.Fatalf("put genesis: %v", err)
+	}
+	canon1, header1 := linked(genesis, 2)
+	if err := store.PutBlock(1, canon1, header1, []byte("b1")); err != nil {
+		t.Fatalf("put canonical 1: %v", err)
+	}
+	if tips, err := store.ChainTips(); err != nil || len(tips) != 1 {
+		t.Fatalf("tips=%+v err=%v, want the active tip only", tips, err)
+	}
+
+	side1, sideHeader1 := linked(genesis, 10)
+	if err := store.StoreBlock(side1, sideHeader1, []byte("side1")); err != nil {
+		t.Fatalf("store side block: %v", err)
+	}
+	side2, sideHeader2 := linked(side1, 11)
+	if err := store.StoreBlock(side2, sideHeader2, []byte("side2")); err != nil {
+		t.Fatalf("store side block: %v", err)
+	}
+	// The header tree is in memory now: neither tips nor descendants read
+	// the headers directory again.
+	if err := os.RemoveAll(store.headersDir); err != nil {
+		t.Fatalf("remove headers: %v", err)
+	}
+	tips, err := store.ChainTips()
+	if err != nil {
+		t.Fatalf("chain tips: %v", err)
+	}
+	want := ChainTip{Height: 2, Hash: side2, BranchLen: 2, Status: ChainTipStatusValidFork}
+	if len(tips) != 2 || tips[0] != want || tips[1].Hash != canon1 {
+		t.Fatalf("tips=%+v, want %+v then active %x", tips, want, canon1)
+	}
+	descendants, err := store.StoredDescendants(side1)
+	if err != nil {
+		t.Fatalf("stored descendants: %v", err)
+	}
+	if len(descendants) != 2 || descendants[0] != side1 || descendants[1] != side2 {
+		t.Fatalf("descendants=%x", descendants)
+	}
+}
+
+func TestBlockStoreTruncateAndReorgDropStaleCanonicalState(t *testing.T) {
+	store := mustOpenBlockStore(t, filepath.Join(t.TempDir(), "blockstore"))
+	hashes := make([][32]byte, 0, 4)
+	for height := uint64(0); height < 4; height++ {
+		hash, _ := mustPutBlock(t, store, height, byte(0x40+height), height, []byte{byte(height)})
+		hashes = append(hashes, hash)
+	}
+	blockWork := func(hash [32]byte) *big.Int {
+		t.Helper()
+		work, err := store.headerWork(hash)
+		if err != nil {
+			t.Fatalf("header work: %v", err)
+		}
+		return work
+	}
+	for _, hash := range hashes {
+		store.chainWorkByHash[hash] = big.NewInt(1)
+	}
+	delete(store.chainWorkByHash, hashes[1])
+
+	// No cached work for the new tip: the dropped blocks' work is subtracted.
+	if err := store.TruncateCanonical(2); err != nil {
+		t.Fatalf("truncate: %v", err)
+	}
+	if len(store.canonicalHeightByHash) != 2 || store.canonicalHeightByHash[hashes[0]] != 0 || store.canonicalHeightByHash[hashes[1]] != 1 {
+		t.Fatalf("canonical heights after truncate=%v", store.canonicalHeightByHash)
+	}
+	for _, hash := range hashes[2:] {
+		if _, ok := store.chainWorkByHash[hash]; ok {
+			t.Fatalf("stale chain work kept for dropped block %x", hash)
+		}
+	}
+	want := new(big.Int).Add(blockWork(hashes[0]), blockWork(hashes[1]))
+	if got, err := store.TipCumulativeWork(); err != nil || got.Cmp(want) != 0 {
+		t.Fatalf("work after truncate=%v err=%v, want %s", got, err, want)
+	}
+
+	// A reorg at height 1 replaces hashes[1]; the new tip's work builds on
+	// the cached work of hashes[0] rather than rescanning it.
+	store.chainWorkByHash[hashes[0]] = big.NewInt(7)
+	header := testHeaderBytes(0x50, 9)
+	fork := mustHeaderHash(t, header)
+	if err := store.StoreBlock(fork, header, []byte("fork")); err != nil {
+		t.Fatalf("store fork block: %v", err)
+	}
+	if err := store.SetCanonicalTip(1, fork); err != nil {
+		t.Fatalf("set canonical tip: %v", err)
+	}
+	if len(store.canonicalHeightByHash) != 2 || store.canonicalHeightByHash[fork] != 1 {
+		t.Fatalf("canonical heights after reorg=%v", store.canonicalHeightByHash)
+	}
+	if _, ok := store.canonicalHeightByHash[hashes[1]]; ok {
+		t.Fatalf("replaced block still indexed as canonical")
+	}
+	want = new(big.Int).Add(big.NewInt(7), blockWork(fork))
+	if got, err := store.TipCumulativeWork(); err != nil || got.Cmp(want) != 0 {
+		t.Fatalf("work after reorg=%v err=%v, want %s", got, err, want)
+	}
+}
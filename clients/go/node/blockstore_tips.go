@@ -0,0 +1,209 @@
+package node
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/2tbmz9y2xt-lang/rubin-protocol/clients/go/consensus"
+)
+
+const (
+	ChainTipStatusActive      = "active"
+	ChainTipStatusValidFork   = "valid-fork"
+	ChainTipStatusHeadersOnly = "headers-only"
+	ChainTipStatusInvalid     = "invalid"
+)
+
+// ChainTip is one leaf of the stored header tree. BranchLen counts the
+// non-canonical blocks between the tip and its fork point on the active chain;
+// it is zero for the active tip.
+type ChainTip struct {
+	Height    uint64
+	Hash      [32]byte
+	BranchLen uint64
+	Status    string
+}
+
+// ChainTips enumerates every stored header that no other stored header builds
+// on, classified against the canonical index. A side branch whose blocks all
+// have stored bodies is a valid fork (side blocks are only stored after basic
+// validation); one with any body missing is headers-only, and one containing
+// an operator-invalidated block is invalid. Branches that do not
+// connect back to the canonical chain have no meaningful height and are
+// omitted. Tips are ordered by height, highest first, with the active tip
+// leading its height.
+func (bs *BlockStore) ChainTips() ([]ChainTip, error) {
+	if bs == nil {
+		return nil, errors.New("nil blockstore")
+	}
+	canonical, err := bs.CanonicalIndexSnapshot()
+	if err != nil {
+		return nil, err
+	}
+	canonicalHeight, err := buildCanonicalHeightIndex(canonical)
+	if err != nil {
+		return nil, err
+	}
+	branches, err := bs.sideBranches(canonicalHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	tips := make([]ChainTip, 0, 1+len(branches))
+	if tipHeight, ok := canonicalTipHeight(canonical); ok {
+		tipHash, err := parseHex32("tip hash", canonical[tipHeight])
+		if err != nil {
+			return nil, err
+		}
+		tips = append(tips, ChainTip{Height: tipHeight, Hash: tipHash, Status: ChainTipStatusActive})
+	}
+	for _, branch := range branches {
+		tip, err := bs.sideChainTip(branch)
+		if err != nil {
+			return nil, err
+		}
+		tips = append(tips, tip)
+	}
+	sort.Slice(tips, func(i, j int) bool {
+		if tips[i].Height != tips[j].Height {
+			return tips[i].Height > tips[j].Height
+		}
+		if (tips[i].Status == ChainTipStatusActive) != (tips[j].Status == ChainTipStatusActive) {
+			return tips[i].Status == ChainTipStatusActive
+		}
+		return bytes.Compare(tips[i].Hash[:], tips[j].Hash[:]) < 0
+	})
+	return tips, nil
+}
+
+// sideBranch is a non-canonical leaf and its ancestors back to, not
+// including, the canonical block it forks from.
+type sideBranch struct {
+	blocks     [][32]byte // tip first
+	forkHeight uint64
+}
+
+// sideBranches walks every non-canonical stored leaf back to the canonical
+// chain. Leaves whose ancestry leaves the stored headers first are dropped.
+func (bs *BlockStore) sideBranches(canonicalHeight map[[32]byte]uint64) ([]sideBranch, error) {
+	bs.stateMu.Lock()
+	defer bs.stateMu.Unlock()
+	if err := bs.loadHeaderTreeLocked(); err != nil {
+		return nil, err
+	}
+	var branches []sideBranch
+	for leaf := range bs.headerLeaves {
+		if _, ok := canonicalHeight[leaf]; ok {
+			continue
+		}
+		var blocks [][32]byte
+		current := leaf
+		for {
+			if forkHeight, ok := canonicalHeight[current]; ok {
+				branches = append(branches, sideBranch{blocks: blocks, forkHeight: forkHeight})
+				break
+			}
+			parent, ok := bs.headerParents[current]
+			if !ok || len(blocks) > len(bs.headerParents) {
+				break
+			}
+			blocks = append(blocks, current)
+			current = parent
+		}
+	}
+	return branches, nil
+}
+
+func (bs *BlockStore) sideChainTip(branch sideBranch) (ChainTip, error) {
+	status := ChainTipStatusValidFork
+	for _, hash := range branch.blocks {
+		hasBody, err := bs.hasBlockBody(hash)
+		if err != nil {
+			return ChainTip{}, err
+		}
+		switch {
+		case bs.IsBlockInvalid(hash):
+			status = ChainTipStatusInvalid
+		case !hasBody && status != ChainTipStatusInvalid:
+			status = ChainTipStatusHeadersOnly
+		}
+	}
+	branchLen := uint64(len(branch.blocks))
+	return ChainTip{Height: branch.forkHeight + branchLen, Hash: branch.blocks[0], BranchLen: branchLen, Status: status}, nil
+}
+
+// loadHeaderTreeLocked builds the stored-header tree from the headers
+// directory the first time it is needed; StoreBlock keeps it current after
+// that.
+func (bs *BlockStore) loadHeaderTreeLocked() error {
+	if bs.headerParents != nil {
+		return nil
+	}
+	entries, err := os.ReadDir(bs.headersDir)
+	if err != nil {
+		return err
+	}
+	bs.headerParents = make(map[[32]byte][32]byte, len(entries))
+	bs.headerChildren = make(map[[32]byte][][32]byte, len(entries))
+	bs.headerLeaves = make(map[[32]byte]struct{})
+	for _, entry := range entries {
+		name, ok := strings.CutSuffix(entry.Name(), ".bin")
+		if !ok || entry.IsDir() {
+			continue
+		}
+		hash, err := parseHex32("stored header", name)
+		if err != nil {
+			continue
+		}
+		header, err := bs.chainWorkHeader(hash)
+		if err != nil {
+			bs.headerParents = nil
+			return err
+		}
+		bs.addHeaderLocked(hash, header.PrevBlockHash)
+	}
+	return nil
+}
+
+// noteStoredHeader adds a newly stored header to the header tree, if the
+// tree has been loaded; otherwise the load will find it on disk.
+func (bs *BlockStore) noteStoredHeader(blockHash [32]byte, headerBytes []byte) error {
+	header, err := consensus.ParseBlockHeaderBytes(headerBytes)
+	if err != nil {
+		return err
+	}
+	bs.stateMu.Lock()
+	defer bs.stateMu.Unlock()
+	if bs.headerParents != nil {
+		bs.addHeaderLocked(blockHash, header.PrevBlockHash)
+	}
+	return nil
+}
+
+func (bs *BlockStore) addHeaderLocked(blockHash, parent [32]byte) {
+	if _, ok := bs.headerParents[blockHash]; ok {
+		return
+	}
+	bs.headerParents[blockHash] = parent
+	bs.headerChildren[parent] = append(bs.headerChildren[parent], blockHash)
+	delete(bs.headerLeaves, parent)
+	if len(bs.headerChildren[blockHash]) == 0 {
+		bs.headerLeaves[blockHash] = struct{}{}
+	}
+}
+
+func (bs *BlockStore) hasBlockBody(blockHash [32]byte) (bool, error) {
+	_, err := os.Stat(filepath.Join(bs.blocksDir, hex.EncodeToString(blockHash[:])+".bin"))
+	if err == nil {
+		return true, nil
+	}
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	return false, err
+}
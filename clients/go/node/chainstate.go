@@ -20,13 +20,9 @@ const (
 var (
 	devnetGenesisHeaderBytes = decodeHexToBytesExact(genesisHeaderHex, consensus.BLOCK_HEADER_BYTES)
 	devnetGenesisTxBytes     = decodeHexToBytesExact(genesisTxHex, 149)
-	devnetGenesisBlockBytes  = append(append([]byte{}, devnetGenesisHeaderBytes...), consensus.AppendCompactSize(nil, 1)...)
+	devnetGenesisBlockBytes  = AssembleGenesisBlockBytes(devnetGenesisHeaderBytes, devnetGenesisTxBytes)
 )
 
-func init() {
-	devnetGenesisBlockBytes = append(devnetGenesisBlockBytes, devnetGenesisTxBytes...)
-}
-
 var (
 	devnetGenesisBlockHash  [32]byte
 	devnetGenesisChainID    [32]byte
@@ -57,6 +53,11 @@ type ChainState struct {
 	HasTip           bool
 	Rotation         consensus.RotationProvider
 	Registry         *consensus.SuiteRegistry
+
+	// GenesisCoinbaseValue is the profile's premine amount the height-0
+	// coinbase must pay; nil leaves genesis exempt from the value bound. Like
+	// Rotation and Registry it is runtime config and is not persisted.
+	GenesisCoinbaseValue *uint64
 }
 
 type CanonicalAppliedBlock struct {
@@ -180,6 +181,7 @@ func (s *ChainState) replaceFrom(src *ChainState) {
 	s.HasTip = snapshot.HasTip
 	s.Rotation = snapshot.Rotation
 	s.Registry = snapshot.Registry
+	s.GenesisCoinbaseValue = snapshot.GenesisCoinbaseValue
 }
 
 // rotationOrNil returns s.Rotation if set, otherwise nil.
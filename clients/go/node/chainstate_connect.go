@@ -100,6 +100,53 @@ func (s *ChainState) ConnectBlockParallelSigsWithSuiteContext(
 	rotation consensus.RotationProvider,
 	registry *consensus.SuiteRegistry,
 	workers int,
+) (*ChainStateConnectSummary, error) {
+	return s.connectBlockDeferredSigs(blockBytes, func(blockHeight uint64, expectedPrevHash *[32]byte, workState *consensus.InMemoryChainState) (*consensus.ConnectBlockBasicSummary, error) {
+		return consensus.ConnectBlockParallelSigVerifyWithSuiteContext(
+			blockBytes,
+			expectedPrevHash,
+			expectedTarget,
+			blockHeight,
+			prevTimestamps,
+			workState,
+			chainID,
+			rotation,
+			registry,
+			workers,
+		)
+	})
+}
+
+// ConnectBlockAssumeValidWithSuiteContext connects a block with full
+// validation except signature verification. It does not check that the block
+// is an ancestor of the assumevalid block; SyncEngine only calls it once it
+// has walked the stored headers to confirm that.
+func (s *ChainState) ConnectBlockAssumeValidWithSuiteContext(
+	blockBytes []byte,
+	expectedTarget *[32]byte,
+	prevTimestamps []uint64,
+	chainID [32]byte,
+	rotation consensus.RotationProvider,
+	registry *consensus.SuiteRegistry,
+) (*ChainStateConnectSummary, error) {
+	return s.connectBlockDeferredSigs(blockBytes, func(blockHeight uint64, expectedPrevHash *[32]byte, workState *consensus.InMemoryChainState) (*consensus.ConnectBlockBasicSummary, error) {
+		return consensus.ConnectBlockAssumeValidWithSuiteContext(
+			blockBytes,
+			expectedPrevHash,
+			expectedTarget,
+			blockHeight,
+			prevTimestamps,
+			workState,
+			chainID,
+			rotation,
+			registry,
+		)
+	})
+}
+
+func (s *ChainState) connectBlockDeferredSigs(
+	blockBytes []byte,
+	connect func(blockHeight uint64, expectedPrevHash *[32]byte, workState *consensus.InMemoryChainState) (*consensus.ConnectBlockBasicSummary, error),
 ) (*ChainStateConnectSummary, error) {
 	if s == nil {
 		return nil, errors.New("nil chainstate")
@@ -113,18 +160,7 @@ func (s *ChainState) ConnectBlockParallelSigsWithSuiteContext(
 	if err != nil {
 		return nil, err
 	}
-	summary, err := consensus.ConnectBlockParallelSigVerifyWithSuiteContext(
-		blockBytes,
-		expectedPrevHash,
-		expectedTarget,
-		blockHeight,
-		prevTimestamps,
-		&workState,
-		chainID,
-		rotation,
-		registry,
-		workers,
-	)
+	summary, err := connect(blockHeight, expectedPrevHash, &workState)
 	if err != nil {
 		return nil, err
 	}
@@ -155,8 +191,9 @@ func (s *ChainState) connectBlockWorkStateLocked(copyUtxos bool) (uint64, *[32]b
 		utxos = copyUtxoSet(s.Utxos)
 	}
 	return blockHeight, expectedPrevHash, consensus.InMemoryChainState{
-		Utxos:            utxos,
-		AlreadyGenerated: new(big.Int).SetUint64(s.AlreadyGenerated),
+		Utxos:                utxos,
+		AlreadyGenerated:     new(big.Int).SetUint64(s.AlreadyGenerated),
+		GenesisCoinbaseValue: s.GenesisCoinbaseValue,
 	}, nil
 }
 
@@ -219,13 +219,36 @@ func decodeHexToBytes32(value string) [32]byte {
 	return out
 }
 
-func deriveGenesisChainID(headerBytes, txBytes []byte) [32]byte {
-	// Chain ID = SHA3-256("RUBIN-GENESIS-v1" || header || compact_size(tx_count) || tx_bytes)
+// DeriveGenesisChainID returns the chain id committed by a genesis header and
+// its genesis transactions, in block order.
+func DeriveGenesisChainID(headerBytes []byte, txs ...[]byte) [32]byte {
+	return deriveGenesisChainID(headerBytes, txs...)
+}
+
+// AssembleGenesisBlockBytes returns header || compact_size(tx_count) || txs,
+// the same byte layout the chain id preimage commits to.
+func AssembleGenesisBlockBytes(headerBytes []byte, txs ...[]byte) []byte {
+	return appendGenesisBody(append([]byte(nil), headerBytes...), txs)
+}
+
+// GenesisChainIDPreimage returns the exact bytes the chain id hashes:
+// "RUBIN-GENESIS-v1" || header || compact_size(tx_count) || tx_bytes...
+func GenesisChainIDPreimage(headerBytes []byte, txs ...[]byte) []byte {
 	preimage := append([]byte{}, []byte(genesisMagicSeparator)...)
 	preimage = append(preimage, headerBytes...)
-	preimage = consensus.AppendCompactSize(preimage, 1) // tx_count = 1
-	preimage = append(preimage, txBytes...)
-	return sha3.Sum256(preimage)
+	return appendGenesisBody(preimage, txs)
+}
+
+func deriveGenesisChainID(headerBytes []byte, txs ...[]byte) [32]byte {
+	return sha3.Sum256(GenesisChainIDPreimage(headerBytes, txs...))
+}
+
+func appendGenesisBody(dst []byte, txs [][]byte) []byte {
+	dst = consensus.AppendCompactSize(dst, uint64(len(txs)))
+	for _, tx := range txs {
+		dst = append(dst, tx...)
+	}
+	return dst
 }
 
 func parseHex(name, value string) ([]byte, error) {
@@ -34,13 +34,14 @@ func cloneChainState(src *ChainState) *ChainState {
 	src.mu.RLock()
 	defer src.mu.RUnlock()
 	return &ChainState{
-		Utxos:            copyUtxoSet(src.Utxos),
-		Height:           src.Height,
-		AlreadyGenerated: src.AlreadyGenerated,
-		TipHash:          src.TipHash,
-		HasTip:           src.HasTip,
-		Rotation:         src.Rotation,
-		Registry:         src.Registry,
+		Utxos:                copyUtxoSet(src.Utxos),
+		Height:               src.Height,
+		AlreadyGenerated:     src.AlreadyGenerated,
+		TipHash:              src.TipHash,
+		HasTip:               src.HasTip,
+		Rotation:             src.Rotation,
+		Registry:             src.Registry,
+		GenesisCoinbaseValue: src.GenesisCoinbaseValue,
 	}
 }
 
@@ -3,6 +3,7 @@ package node
 import (
 	"encoding/hex"
 	"errors"
+	"math/big"
 	"os"
 	"path/filepath"
 	"strings"
@@ -531,3 +532,49 @@ func TestReconcileChainStateWithBlockStore_PropagatesCorruptBlockBytesSwap(t *te
 		t.Fatalf("expected error containing %q, got %v", want, err)
 	}
 }
+
+func TestTruncateIncompleteCanonicalSuffixRecoversLegacyIndexWithMissingTipHeader(t *testing.T) {
+	root := filepath.Join(t.TempDir(), "blockstore")
+	store := mustOpenBlockStore(t, root)
+	hashes := make([][32]byte, 0, 3)
+	for height := uint64(0); height < 3; height++ {
+		hash, _ := mustPutBlock(t, store, height, byte(0x60+height), height, []byte{byte(height)})
+		if err := store.PutUndo(hash, &BlockUndo{}); err != nil {
+			t.Fatalf("put undo: %v", err)
+		}
+		hashes = append(hashes, hash)
+	}
+	want := big.NewInt(0)
+	for _, hash := range hashes[:2] {
+		work, err := store.headerWork(hash)
+		if err != nil {
+			t.Fatalf("header work: %v", err)
+		}
+		want.Add(want, work)
+	}
+
+	// A legacy index carries no tip work, and the crash lost the tip header.
+	index := store.index
+	index.TipCumulativeWork = ""
+	if err := saveBlockStoreIndex(store.indexPath, index); err != nil {
+		t.Fatalf("save legacy index: %v", err)
+	}
+	if err := os.Remove(filepath.Join(store.headersDir, hex.EncodeToString(hashes[2][:])+".bin")); err != nil {
+		t.Fatalf("remove tip header: %v", err)
+	}
+
+	reopened, err := OpenBlockStore(root)
+	if err != nil {
+		t.Fatalf("open blockstore with missing tip header: %v", err)
+	}
+	truncated, err := truncateIncompleteCanonicalSuffix(reopened)
+	if err != nil || !truncated {
+		t.Fatalf("truncate: truncated=%v err=%v", truncated, err)
+	}
+	if height, tip, ok, err := reopened.Tip(); err != nil || !ok || height != 1 || tip != hashes[1] {
+		t.Fatalf("tip after recovery=%d %x ok=%v err=%v", height, tip, ok, err)
+	}
+	if got, err := reopened.TipCumulativeWork(); err != nil || got.Cmp(want) != 0 {
+		t.Fatalf("tip work after recovery=%v err=%v, want %s", got, err, want)
+	}
+}
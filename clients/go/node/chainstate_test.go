@@ -358,6 +358,117 @@ func TestChainStateConnectBlockNoMutationOnFailure(t *testing.T) {
 	}
 }
 
+func TestChainStateCoinbaseAnchorOutputIsNotSpendable(t *testing.T) {
+	engine, _, target := newReorgTestEngine(t)
+	subsidy := consensus.BlockSubsidy(1, 0)
+	coinbase := coinbaseWithWitnessCommitmentAndP2PKValueAtHeight(t, 1, subsidy)
+	_, txid, _, _, err := consensus.ParseTx(coinbase)
+	if err != nil {
+		t.Fatalf("ParseTx: %v", err)
+	}
+	block := buildSingleTxBlock(t, devnetGenesisBlockHash, target, reorgTestTimestamp(1), coinbase)
+	if _, err := engine.ApplyBlock(block, nil); err != nil {
+		t.Fatalf("ApplyBlock: %v", err)
+	}
+
+	spendable, ok := engine.chainState.Utxos[consensus.Outpoint{Txid: txid, Vout: 0}]
+	if !ok {
+		t.Fatalf("coinbase P2PK output missing from utxo set")
+	}
+	if spendable.CovenantType != consensus.COV_TYPE_P2PK || spendable.Value != subsidy || !spendable.CreatedByCoinbase {
+		t.Fatalf("coinbase P2PK utxo=%+v, want P2PK value=%d created_by_coinbase", spendable, subsidy)
+	}
+	if entry, ok := engine.chainState.Utxos[consensus.Outpoint{Txid: txid, Vout: 1}]; ok {
+		t.Fatalf("coinbase anchor commitment output entered utxo set: %+v", entry)
+	}
+	for op, entry := range engine.chainState.Utxos {
+		if entry.CovenantType == consensus.COV_TYPE_ANCHOR {
+			t.Fatalf("anchor utxo %x:%d present in utxo set", op.Txid, op.Vout)
+		}
+	}
+}
+
+func TestChainStateRejectsCoinbaseAnchorWithValue(t *testing.T) {
+	engine, _, target := newReorgTestEngine(t)
+	before, err := stateToDisk(engine.chainState)
+	if err != nil {
+		t.Fatalf("stateToDisk before: %v", err)
+	}
+	wroot, err := consensus.WitnessMerkleRootWtxids([][32]byte{{}})
+	if err != nil {
+		t.Fatalf("witness merkle root: %v", err)
+	}
+	commitment := consensus.WitnessCommitmentHash(wroot)
+	coinbase := coinbaseTxWithOutputs(1, []testOutput{
+		{value: consensus.BlockSubsidy(1, 0) - 1, covenantType: consensus.COV_TYPE_P2PK, covenantData: testP2PKCovenantData(0x11)},
+		{value: 1, covenantType: consensus.COV_TYPE_ANCHOR, covenantData: commitment[:]},
+	})
+	block := buildSingleTxBlock(t, devnetGenesisBlockHash, target, reorgTestTimestamp(1), coinbase)
+	_, err = engine.ApplyBlock(block, nil)
+	requireConsensusTxErrCode(t, err, consensus.TX_ERR_COVENANT_TYPE_INVALID)
+
+	after, err := stateToDisk(engine.chainState)
+	if err != nil {
+		t.Fatalf("stateToDisk after: %v", err)
+	}
+	if !reflect.DeepEqual(before, after) {
+		t.Fatalf("chainstate mutated by rejected value-bearing anchor")
+	}
+}
+
+func TestChainStateCoinbaseMultipleSpendableOutputsValueBound(t *testing.T) {
+	wroot, err := consensus.WitnessMerkleRootWtxids([][32]byte{{}})
+	if err != nil {
+		t.Fatalf("witness merkle root: %v", err)
+	}
+	commitment := consensus.WitnessCommitmentHash(wroot)
+	subsidy := consensus.BlockSubsidy(1, 0)
+	splitCoinbase := func(first, second uint64) []byte {
+		return coinbaseTxWithOutputs(1, []testOutput{
+			{value: first, covenantType: consensus.COV_TYPE_P2PK, covenantData: testP2PKCovenantData(0x11)},
+			{value: second, covenantType: consensus.COV_TYPE_P2PK, covenantData: testP2PKCovenantData(0x22)},
+			{value: 0, covenantType: consensus.COV_TYPE_ANCHOR, covenantData: commitment[:]},
+		})
+	}
+
+	t.Run("at_cap", func(t *testing.T) {
+		engine, _, target := newReorgTestEngine(t)
+		coinbase := splitCoinbase(subsidy/2, subsidy-subsidy/2)
+		_, txid, _, _, err := consensus.ParseTx(coinbase)
+		if err != nil {
+			t.Fatalf("ParseTx: %v", err)
+		}
+		block := buildSingleTxBlock(t, devnetGenesisBlockHash, target, reorgTestTimestamp(1), coinbase)
+		if _, err := engine.ApplyBlock(block, nil); err != nil {
+			t.Fatalf("ApplyBlock: %v", err)
+		}
+		var total uint64
+		for vout := uint32(0); vout < 2; vout++ {
+			entry, ok := engine.chainState.Utxos[consensus.Outpoint{Txid: txid, Vout: vout}]
+			if !ok {
+				t.Fatalf("coinbase output %d missing from utxo set", vout)
+			}
+			total += entry.Value
+		}
+		if total != subsidy {
+			t.Fatalf("spendable coinbase total=%d, want %d", total, subsidy)
+		}
+		if _, ok := engine.chainState.Utxos[consensus.Outpoint{Txid: txid, Vout: 2}]; ok {
+			t.Fatalf("coinbase anchor commitment output entered utxo set")
+		}
+	})
+
+	t.Run("exceeds_cap", func(t *testing.T) {
+		engine, _, target := newReorgTestEngine(t)
+		block := buildSingleTxBlock(t, devnetGenesisBlockHash, target, reorgTestTimestamp(1), splitCoinbase(subsidy/2, subsidy-subsidy/2+1))
+		_, err := engine.ApplyBlock(block, nil)
+		requireConsensusTxErrCode(t, err, consensus.BLOCK_ERR_SUBSIDY_EXCEEDED)
+		if engine.chainState.Height != 0 {
+			t.Fatalf("height=%d after rejected block, want 0", engine.chainState.Height)
+		}
+	})
+}
+
 func TestLoadChainStateNotFoundReturnsEmpty(t *testing.T) {
 	st, err := LoadChainState(filepath.Join(t.TempDir(), "missing.json"))
 	if err != nil {
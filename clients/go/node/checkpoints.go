@@ -0,0 +1,132 @@
+package node
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/2tbmz9y2xt-lang/rubin-protocol/clients/go/consensus"
+)
+
+// ErrCheckpointMismatch rejects a block whose hash differs from the
+// checkpoint pinned at its height.
+var ErrCheckpointMismatch = errors.New("block hash does not match checkpoint")
+
+// ErrReorgBelowCheckpoint refuses a branch forking below the highest
+// checkpoint already on the canonical chain. Unlike ErrReorgTooDeep there is
+// no operator override: checkpointed history is final.
+var ErrReorgBelowCheckpoint = errors.New("reorg crosses below checkpoint")
+
+// ParseCheckpoints parses operator checkpoint specs of the form
+// "height:blockhashhex" into the SyncConfig.Checkpoints map.
+func ParseCheckpoints(specs []string) (map[uint64][32]byte, error) {
+	if len(specs) == 0 {
+		return nil, nil
+	}
+	out := make(map[uint64][32]byte, len(specs))
+	for _, spec := range specs {
+		height, hash, err := ParseCheckpoint(spec)
+		if err != nil {
+			return nil, err
+		}
+		if prev, dup := out[height]; dup && prev != hash {
+			return nil, fmt.Errorf("checkpoint height %d: conflicting hashes", height)
+		}
+		out[height] = hash
+	}
+	return out, nil
+}
+
+// ParseCheckpoint parses one "height:blockhashhex" spec, as used by the
+// -checkpoint and -assumevalid flags.
+func ParseCheckpoint(spec string) (uint64, [32]byte, error) {
+	rawHeight, rawHash, ok := strings.Cut(strings.TrimSpace(spec), ":")
+	if !ok {
+		return 0, [32]byte{}, fmt.Errorf("checkpoint %q: want height:hash", spec)
+	}
+	height, err := strconv.ParseUint(strings.TrimSpace(rawHeight), 10, 64)
+	if err != nil {
+		return 0, [32]byte{}, fmt.Errorf("checkpoint %q: bad height: %w", spec, err)
+	}
+	hash, err := parseHex32("checkpoint hash", rawHash)
+	if err != nil {
+		return 0, [32]byte{}, err
+	}
+	return height, hash, nil
+}
+
+func (s *SyncEngine) checkCheckpoint(height uint64, blockHash [32]byte) error {
+	want, ok := s.cfg.Checkpoints[height]
+	if !ok || want == blockHash {
+		return nil
+	}
+	return fmt.Errorf("%w: height %d", ErrCheckpointMismatch, height)
+}
+
+func (s *SyncEngine) assumeValidEnabled() bool {
+	return s.cfg.AssumeValidHash != [32]byte{}
+}
+
+// assumeValidCovers reports whether signature checks may be skipped for the
+// block blockHash at height: only when the assumevalid block is stored and
+// blockHash is on its ancestor chain. Side branches below the assumevalid
+// height are verified in full.
+func (s *SyncEngine) assumeValidCovers(height uint64, blockHash [32]byte) bool {
+	if !s.assumeValidEnabled() || height > s.cfg.AssumeValidHeight || s.blockStore == nil {
+		return false
+	}
+	ancestor, ok := s.assumeValidAncestor(height)
+	return ok && ancestor == blockHash
+}
+
+// assumeValidAncestor returns the hash at height on the assumevalid block's
+// ancestor chain, walking stored headers back from the deepest hash resolved
+// so far. Resolved hashes are kept, so a sync connecting every height pays
+// for each header once. ok is false while a header on the way is missing.
+func (s *SyncEngine) assumeValidAncestor(height uint64) ([32]byte, bool) {
+	s.assumeValidMu.Lock()
+	defer s.assumeValidMu.Unlock()
+	depth := s.cfg.AssumeValidHeight - height
+	if len(s.assumeValidChain) == 0 {
+		if _, err := s.blockStore.GetHeaderByHash(s.cfg.AssumeValidHash); err != nil {
+			return [32]byte{}, false
+		}
+		s.assumeValidChain = append(s.assumeValidChain, s.cfg.AssumeValidHash)
+	}
+	for uint64(len(s.assumeValidChain)) <= depth {
+		headerBytes, err := s.blockStore.GetHeaderByHash(s.assumeValidChain[len(s.assumeValidChain)-1])
+		if err != nil {
+			return [32]byte{}, false
+		}
+		header, err := consensus.ParseBlockHeaderBytes(headerBytes)
+		if err != nil {
+			return [32]byte{}, false
+		}
+		s.assumeValidChain = append(s.assumeValidChain, header.PrevBlockHash)
+	}
+	return s.assumeValidChain[depth], true
+}
+
+// checkReorgAboveCheckpoint refuses a fork point below the highest checkpoint
+// at or under the canonical tip; checkpoints above the tip are not yet final.
+func (s *SyncEngine) checkReorgAboveCheckpoint(commonAncestorHeight uint64) error {
+	if len(s.cfg.Checkpoints) == 0 {
+		return nil
+	}
+	tipHeight, _, err := s.currentCanonicalTip()
+	if err != nil {
+		return err
+	}
+	var highest uint64
+	found := false
+	for height := range s.cfg.Checkpoints {
+		if height <= tipHeight && (!found || height > highest) {
+			highest, found = height, true
+		}
+	}
+	if found && commonAncestorHeight < highest {
+		return fmt.Errorf("%w: fork point %d below checkpoint %d", ErrReorgBelowCheckpoint, commonAncestorHeight, highest)
+	}
+	return nil
+}
@@ -0,0 +1,181 @@
+package node
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/2tbmz9y2xt-lang/rubin-protocol/clients/go/consensus"
+)
+
+func TestParseCheckpoints(t *testing.T) {
+	hash := strings.Repeat("ab", 32)
+	got, err := ParseCheckpoints([]string{" 10:" + hash, "10:" + hash})
+	if err != nil {
+		t.Fatalf("ParseCheckpoints: %v", err)
+	}
+	if len(got) != 1 || got[10][0] != 0xab {
+		t.Fatalf("checkpoints=%v", got)
+	}
+	if got, err := ParseCheckpoints(nil); err != nil || got != nil {
+		t.Fatalf("empty: got=%v err=%v", got, err)
+	}
+
+	for _, spec := range []string{
+		hash,
+		"x:" + hash,
+		"10:abcd",
+	} {
+		if _, err := ParseCheckpoints([]string{spec}); err == nil {
+			t.Fatalf("spec %q: expected error", spec)
+		}
+	}
+	if _, err := ParseCheckpoints([]string{"10:" + hash, "10:" + strings.Repeat("cd", 32)}); err == nil {
+		t.Fatalf("conflicting hashes: expected error")
+	}
+}
+
+// assumeValidTestSpend spends prev with a well-formed but bogus ML-DSA-87
+// witness: key binding holds, the signature itself never verifies.
+func assumeValidTestSpend(t *testing.T, prev consensus.Outpoint, pubkey []byte, value uint64) ([]byte, [32]byte) {
+	t.Helper()
+	tx := &consensus.Tx{
+		Version: 1,
+		TxNonce: uint64(prev.Vout) + 1,
+		Inputs:  []consensus.TxInput{{PrevTxid: prev.Txid, PrevVout: prev.Vout}},
+		Outputs: []consensus.TxOutput{{Value: value, CovenantType: consensus.COV_TYPE_P2PK, CovenantData: consensus.P2PKCovenantDataForPubkey(pubkey)}},
+		Witness: []consensus.WitnessItem{{
+			SuiteID:   consensus.SUITE_ID_ML_DSA_87,
+			Pubkey:    pubkey,
+			Signature: append(make([]byte, consensus.ML_DSA_87_SIG_BYTES), consensus.SIGHASH_ALL),
+		}},
+	}
+	txBytes, err := consensus.MarshalTx(tx)
+	if err != nil {
+		t.Fatalf("MarshalTx: %v", err)
+	}
+	_, _, wtxid, _, err := consensus.ParseTx(txBytes)
+	if err != nil {
+		t.Fatalf("ParseTx: %v", err)
+	}
+	return txBytes, wtxid
+}
+
+// fundAssumeValidTest seeds n P2PK outputs of value 100 that
+// assumeValidTestSpend can spend.
+func fundAssumeValidTest(engine *SyncEngine, n int) ([]byte, []consensus.Outpoint) {
+	pubkey := make([]byte, consensus.ML_DSA_87_PUBKEY_BYTES)
+	for i := range pubkey {
+		pubkey[i] = 0x42
+	}
+	funding := make([]consensus.Outpoint, n)
+	for i := range funding {
+		funding[i] = consensus.Outpoint{Txid: [32]byte{0xa5}, Vout: uint32(i)}
+		engine.chainState.Utxos[funding[i]] = consensus.UtxoEntry{
+			Value:        100,
+			CovenantType: consensus.COV_TYPE_P2PK,
+			CovenantData: consensus.P2PKCovenantDataForPubkey(pubkey),
+		}
+	}
+	return pubkey, funding
+}
+
+func TestSyncEngineAssumeValidSkipsSignaturesBelowTrustedBlock(t *testing.T) {
+	engine, store, target := newReorgTestEngine(t)
+	pubkey, funding := fundAssumeValidTest(engine, 2)
+
+	alreadyGenerated := engine.chainState.AlreadyGenerated
+	subsidy1 := consensus.BlockSubsidy(1, alreadyGenerated)
+	spend1, wtxid1 := assumeValidTestSpend(t, funding[0], pubkey, 90)
+	block1 := buildMultiTxBlock(t, devnetGenesisBlockHash, target, reorgTestTimestamp(1),
+		coinbaseWithWitnessCommitmentAndP2PKValueForWtxids(t, 1, subsidy1+10, [][32]byte{{}, wtxid1}), spend1)
+	hash1, err := consensus.BlockHash(blockHeaderBytes(t, block1))
+	if err != nil {
+		t.Fatalf("BlockHash: %v", err)
+	}
+	if err := store.StoreBlock(hash1, blockHeaderBytes(t, block1), block1); err != nil {
+		t.Fatalf("StoreBlock: %v", err)
+	}
+	engine.cfg.AssumeValidHeight = 1
+	engine.cfg.AssumeValidHash = hash1
+	if _, err := engine.ApplyBlock(block1, nil); err != nil {
+		t.Fatalf("ApplyBlock(height 1, below assumevalid): %v", err)
+	}
+	if _, ok := engine.chainState.Utxos[funding[0]]; ok {
+		t.Fatalf("assumevalid block did not spend its input")
+	}
+
+	subsidy2 := consensus.BlockSubsidy(2, alreadyGenerated+subsidy1)
+	spend2, wtxid2 := assumeValidTestSpend(t, funding[1], pubkey, 90)
+	block2 := buildMultiTxBlock(t, hash1, target, reorgTestTimestamp(2),
+		coinbaseWithWitnessCommitmentAndP2PKValueForWtxids(t, 2, subsidy2+10, [][32]byte{{}, wtxid2}), spend2)
+	if _, err := engine.ApplyBlock(block2, nil); err == nil {
+		t.Fatalf("ApplyBlock(height 2, above assumevalid) accepted an invalid signature")
+	}
+	if engine.chainState.Height != 1 || engine.chainState.TipHash != hash1 {
+		t.Fatalf("tip moved past the invalid block")
+	}
+}
+
+func TestSyncEngineAssumeValidVerifiesSideBranchBelowTrustedHeight(t *testing.T) {
+	engine, store, target := newReorgTestEngine(t)
+	pubkey, funding := fundAssumeValidTest(engine, 1)
+
+	trusted, trustedHashes := reorgTestChain(t, target, devnetGenesisBlockHash, 1, engine.chainState.AlreadyGenerated, 2, 0)
+	for i, block := range trusted {
+		if err := store.StoreBlock(trustedHashes[i], blockHeaderBytes(t, block), block); err != nil {
+			t.Fatalf("StoreBlock: %v", err)
+		}
+	}
+	engine.cfg.AssumeValidHeight = 2
+	engine.cfg.AssumeValidHash = trustedHashes[1]
+
+	subsidy := consensus.BlockSubsidy(1, engine.chainState.AlreadyGenerated)
+	spend, wtxid := assumeValidTestSpend(t, funding[0], pubkey, 90)
+	side := buildMultiTxBlock(t, devnetGenesisBlockHash, target, reorgTestTimestamp(1),
+		coinbaseWithWitnessCommitmentAndP2PKValueForWtxids(t, 1, subsidy+10, [][32]byte{{}, wtxid}), spend)
+	sideHash, err := consensus.BlockHash(blockHeaderBytes(t, side))
+	if err != nil {
+		t.Fatalf("BlockHash: %v", err)
+	}
+
+	// The routing decision alone shows the side block gets full signature
+	// checks, whatever the local verifier supports.
+	if !engine.assumeValidCovers(1, trustedHashes[0]) || !engine.assumeValidCovers(2, trustedHashes[1]) {
+		t.Fatalf("assumevalid ancestors not covered")
+	}
+	if engine.assumeValidCovers(1, sideHash) {
+		t.Fatalf("side-branch block below assumevalid height is covered")
+	}
+	if _, err := engine.ApplyBlock(side, nil); err == nil {
+		t.Fatalf("ApplyBlock(side branch below assumevalid) accepted an invalid signature")
+	}
+	if engine.chainState.Height != 0 || engine.chainState.TipHash != devnetGenesisBlockHash {
+		t.Fatalf("tip moved onto the invalid side block")
+	}
+}
+
+func TestSyncEngineAssumeValidNeedsStoredTrustedBlock(t *testing.T) {
+	engine, _, _ := newReorgTestEngine(t)
+	engine.cfg.AssumeValidHeight = 1
+	engine.cfg.AssumeValidHash = [32]byte{0x01}
+	if engine.assumeValidCovers(1, engine.cfg.AssumeValidHash) {
+		t.Fatalf("assumevalid covers a block whose trusted header is not stored")
+	}
+}
+
+func TestSyncEngineAssumeValidMismatchOnlyDisablesSkipping(t *testing.T) {
+	engine, _, target := newReorgTestEngine(t)
+	blocks, hashes := reorgTestChain(t, target, devnetGenesisBlockHash, 1, 0, 1, 0)
+	engine.cfg.AssumeValidHeight = 1
+	engine.cfg.AssumeValidHash = hashes[0]
+	engine.cfg.AssumeValidHash[0] ^= 0xff
+	if engine.assumeValidCovers(1, hashes[0]) {
+		t.Fatalf("assumevalid covers a block that does not match the trusted hash")
+	}
+	if _, err := engine.ApplyBlock(blocks[0], nil); err != nil {
+		t.Fatalf("ApplyBlock(height 1, assumevalid mismatch): %v", err)
+	}
+	if engine.chainState.Height != 1 || engine.chainState.TipHash != hashes[0] {
+		t.Fatalf("valid block off the assumevalid chain did not connect")
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"os"
 	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/2tbmz9y2xt-lang/rubin-protocol/clients/go/consensus"
@@ -383,6 +384,66 @@ func DefaultConfig() Config {
 	}
 }
 
+// LoadConfigFile reads a JSON node config from path, layered over
+// DefaultConfig so the file only needs the fields it changes. Unknown fields
+// and trailing content are rejected. The result is not validated; callers
+// apply their overrides first and then run ValidateConfig on the merge.
+func LoadConfigFile(path string) (Config, error) {
+	cfg := DefaultConfig()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, fmt.Errorf("read config file: %w", err)
+	}
+	if err := decodeSingleJSONValue(data, &cfg); err != nil {
+		return Config{}, fmt.Errorf("parse config file %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// configEnvVars maps RUBIN_* environment variables to the Config field each
+// sets; list fields take comma-separated values.
+var configEnvVars = []struct {
+	name  string
+	apply func(cfg *Config, value string) error
+}{
+	{"RUBIN_NETWORK", func(cfg *Config, v string) error { cfg.Network = v; return nil }},
+	{"RUBIN_DATADIR", func(cfg *Config, v string) error { cfg.DataDir = v; return nil }},
+	{"RUBIN_BIND", func(cfg *Config, v string) error { cfg.BindAddr = v; return nil }},
+	{"RUBIN_RPC_BIND", func(cfg *Config, v string) error { cfg.RPCBindAddr = v; return nil }},
+	{"RUBIN_LOG_LEVEL", func(cfg *Config, v string) error { cfg.LogLevel = v; return nil }},
+	{"RUBIN_PEERS", func(cfg *Config, v string) error { cfg.Peers = NormalizePeers(v); return nil }},
+	{"RUBIN_MAX_PEERS", func(cfg *Config, v string) error { return parseConfigEnvInt(v, &cfg.MaxPeers) }},
+	{"RUBIN_MEMPOOL_MAX_TXS", func(cfg *Config, v string) error { return parseConfigEnvInt(v, &cfg.MempoolMaxTxs) }},
+	{"RUBIN_MEMPOOL_MAX_BYTES", func(cfg *Config, v string) error { return parseConfigEnvInt(v, &cfg.MempoolMaxBytes) }},
+	{"RUBIN_MINE_ADDRESS", func(cfg *Config, v string) error { cfg.MineAddress = v; return nil }},
+}
+
+func parseConfigEnvInt(value string, dest *int) error {
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return fmt.Errorf("not an integer: %q", value)
+	}
+	*dest = n
+	return nil
+}
+
+// ApplyConfigEnv overlays RUBIN_* environment variables, read through
+// lookup (os.LookupEnv in the node), onto cfg. Precedence is defaults <
+// config file < environment < flags: apply it after LoadConfigFile and
+// before flag parsing. Unset or blank variables leave cfg unchanged.
+func ApplyConfigEnv(cfg *Config, lookup func(string) (string, bool)) error {
+	for _, v := range configEnvVars {
+		value, ok := lookup(v.name)
+		if !ok || strings.TrimSpace(value) == "" {
+			continue
+		}
+		if err := v.apply(cfg, strings.TrimSpace(value)); err != nil {
+			return fmt.Errorf("%s: %w", v.name, err)
+		}
+	}
+	return nil
+}
+
 func NormalizePeers(raw ...string) []string {
 	out := make([]string, 0, len(raw))
 	seen := make(map[string]struct{}, len(raw))
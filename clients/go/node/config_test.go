@@ -2,6 +2,7 @@ package node
 
 import (
 	"bytes"
+	"os"
 	"path/filepath"
 	"slices"
 	"strings"
@@ -18,6 +19,70 @@ func TestNormalizePeers(t *testing.T) {
 	}
 }
 
+func TestLoadConfigFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "node.json")
+	if err := os.WriteFile(path, []byte(`{"network":"testnet","data_dir":"/srv/rubin","max_peers":8}`), 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	cfg, err := LoadConfigFile(path)
+	if err != nil {
+		t.Fatalf("LoadConfigFile: %v", err)
+	}
+	defaults := DefaultConfig()
+	if cfg.Network != "testnet" || cfg.DataDir != "/srv/rubin" || cfg.MaxPeers != 8 {
+		t.Fatalf("file values not applied: %+v", cfg)
+	}
+	if cfg.BindAddr != defaults.BindAddr || cfg.LogLevel != defaults.LogLevel {
+		t.Fatalf("unset fields must keep defaults: %+v", cfg)
+	}
+
+	for name, body := range map[string]string{
+		"unknown field":  `{"network":"devnet","netwrok":"x"}`,
+		"trailing value": `{"network":"devnet"} {}`,
+		"malformed":      `{"network":`,
+	} {
+		bad := filepath.Join(dir, strings.ReplaceAll(name, " ", "_")+".json")
+		if err := os.WriteFile(bad, []byte(body), 0o600); err != nil {
+			t.Fatalf("write %s: %v", name, err)
+		}
+		if _, err := LoadConfigFile(bad); err == nil {
+			t.Fatalf("%s: expected error", name)
+		}
+	}
+	if _, err := LoadConfigFile(filepath.Join(dir, "absent.json")); err == nil {
+		t.Fatalf("missing file: expected error")
+	}
+}
+
+func TestApplyConfigEnv(t *testing.T) {
+	env := map[string]string{
+		"RUBIN_NETWORK":   "testnet",
+		"RUBIN_PEERS":     "a:1, b:2,a:1",
+		"RUBIN_MAX_PEERS": " 7 ",
+		"RUBIN_LOG_LEVEL": "  ",
+	}
+	lookup := func(name string) (string, bool) {
+		v, ok := env[name]
+		return v, ok
+	}
+	cfg := DefaultConfig()
+	if err := ApplyConfigEnv(&cfg, lookup); err != nil {
+		t.Fatalf("ApplyConfigEnv: %v", err)
+	}
+	if cfg.Network != "testnet" || cfg.MaxPeers != 7 || !slices.Equal(cfg.Peers, []string{"a:1", "b:2"}) {
+		t.Fatalf("env not applied: %+v", cfg)
+	}
+	if cfg.LogLevel != DefaultConfig().LogLevel || cfg.DataDir != DefaultConfig().DataDir {
+		t.Fatalf("blank or unset vars must not change cfg: %+v", cfg)
+	}
+
+	env["RUBIN_MEMPOOL_MAX_TXS"] = "1e3"
+	if err := ApplyConfigEnv(&cfg, lookup); err == nil || !strings.Contains(err.Error(), "RUBIN_MEMPOOL_MAX_TXS") {
+		t.Fatalf("err=%v, want RUBIN_MEMPOOL_MAX_TXS error", err)
+	}
+}
+
 func TestValidateConfigOK(t *testing.T) {
 	cfg := DefaultConfig()
 	cfg.Peers = []string{"127.0.0.1:19111"}
@@ -0,0 +1,43 @@
+package node
+
+import "github.com/2tbmz9y2xt-lang/rubin-protocol/clients/go/consensus"
+
+// networkDeployments is the built-in featurebit schedule per canonical
+// network name. No consensus change is currently gated on a featurebit, so
+// the only entries are telemetry-only signaling dry runs on the test
+// networks; mainnet schedules nothing until a deployment is specified. The
+// dry runs use bit 1 because bit 0 belongs to the base header version and
+// miners reject it as a signal bit.
+var networkDeployments = map[string][]consensus.FeatureBitDeployment{
+	"devnet": {
+		{
+			Name:          "signal_dry_run",
+			Bit:           1,
+			StartHeight:   0,
+			TimeoutHeight: 8 * consensus.SIGNAL_WINDOW,
+		},
+	},
+	"testnet": {
+		{
+			Name:          "signal_dry_run",
+			Bit:           1,
+			StartHeight:   consensus.SIGNAL_WINDOW,
+			TimeoutHeight: 27 * consensus.SIGNAL_WINDOW,
+		},
+	},
+	"mainnet": {},
+}
+
+// DeploymentParams returns a copy of the built-in featurebit deployment
+// schedule for network, or nil when the network is unknown. An operator
+// -featurebits-deployments file replaces this table wholesale.
+func DeploymentParams(network string) []consensus.FeatureBitDeployment {
+	canonical, ok := CanonicalNetworkName(network)
+	if !ok {
+		return nil
+	}
+	ds := networkDeployments[canonical]
+	out := make([]consensus.FeatureBitDeployment, len(ds))
+	copy(out, ds)
+	return out
+}
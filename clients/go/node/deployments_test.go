@@ -0,0 +1,76 @@
+package node
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/2tbmz9y2xt-lang/rubin-protocol/clients/go/consensus"
+)
+
+func TestDeploymentParamsPerNetwork(t *testing.T) {
+	devnet := DeploymentParams("devnet")
+	testnet := DeploymentParams("testnet")
+	mainnet := DeploymentParams("mainnet")
+
+	if len(devnet) == 0 || len(testnet) == 0 {
+		t.Fatalf("test networks must ship a schedule: devnet=%v testnet=%v", devnet, testnet)
+	}
+	if mainnet == nil || len(mainnet) != 0 {
+		t.Fatalf("mainnet schedule=%v, want empty non-nil", mainnet)
+	}
+	if reflect.DeepEqual(devnet, testnet) {
+		t.Fatalf("devnet and testnet share a schedule: %v", devnet)
+	}
+	for _, network := range []string{"devnet", "testnet", "mainnet"} {
+		for _, d := range DeploymentParams(network) {
+			if d.Name == "" || d.StartHeight >= d.TimeoutHeight || d.Bit > consensus.VERSIONBITS_MAX_BIT {
+				t.Fatalf("%s: malformed deployment %+v", network, d)
+			}
+		}
+	}
+	if got := DeploymentParams(" DEVNET "); !reflect.DeepEqual(got, devnet) {
+		t.Fatalf("non-canonical network name: got %v, want %v", got, devnet)
+	}
+	if got := DeploymentParams("regtest"); got != nil {
+		t.Fatalf("unknown network: got %v, want nil", got)
+	}
+}
+
+func TestDeploymentParamsReturnsCopy(t *testing.T) {
+	ds := DeploymentParams("devnet")
+	ds[0].Name = "mutated"
+	if DeploymentParams("devnet")[0].Name == "mutated" {
+		t.Fatalf("DeploymentParams leaked the built-in table")
+	}
+}
+
+func TestDeploymentParamsDryRunLocksInUnderSignalingMiner(t *testing.T) {
+	for _, network := range []string{"devnet", "testnet"} {
+		for _, d := range DeploymentParams(network) {
+			signalBits := []uint8{d.Bit}
+			if err := validateMinerSignalBits(signalBits); err != nil {
+				t.Fatalf("%s: %s bit %d not minable: %v", network, d.Name, d.Bit, err)
+			}
+			version := minerHeaderVersion(signalBits)
+			if !consensus.VersionSignalsBit(version, d.Bit) {
+				t.Fatalf("%s: miner version %#x does not signal bit %d", network, version, d.Bit)
+			}
+
+			// Every header from the first boundary at or after StartHeight is
+			// mined with that version, so the first full window locks in.
+			firstBoundary := (d.StartHeight + consensus.SIGNAL_WINDOW - 1) / consensus.SIGNAL_WINDOW * consensus.SIGNAL_WINDOW
+			lockInHeight := firstBoundary + consensus.SIGNAL_WINDOW
+			counts := make([]uint32, lockInHeight/consensus.SIGNAL_WINDOW)
+			for win := firstBoundary / consensus.SIGNAL_WINDOW; win < uint64(len(counts)); win++ {
+				counts[win] = consensus.SIGNAL_WINDOW
+			}
+			ev, err := consensus.FeatureBitStateAtHeightFromWindowCounts(d, lockInHeight, counts)
+			if err != nil {
+				t.Fatalf("%s: %s state: %v", network, d.Name, err)
+			}
+			if ev.State != consensus.FEATUREBIT_LOCKED_IN {
+				t.Fatalf("%s: %s state at %d=%s, want %s", network, d.Name, lockInHeight, ev.State, consensus.FEATUREBIT_LOCKED_IN)
+			}
+		}
+	}
+}
@@ -13,13 +13,35 @@ import (
 
 var unixNow = func() int64 { return time.Now().Unix() }
 
+var errNonceSpaceExhausted = errors.New("header nonce space exhausted")
+
+// extraNonceOutputBytes is the serialized size of the coinbase anchor output
+// carrying a rolled extranonce: value + covenant_type + len + 8-byte payload.
+const extraNonceOutputBytes = 8 + 2 + 1 + 8
+
 type MinerConfig struct {
+	// TimestampSource supplies the candidate header timestamp (Unix seconds).
+	// DefaultMinerConfig uses the wall clock; tests and deterministic block
+	// generation inject fixed values. A value outside (MTP, MTP+MAX_FUTURE_DRIFT]
+	// relative to the ancestors is replaced with MTP+1, so an injected source
+	// can never produce a block that fails timestamp validation.
 	TimestampSource func() uint64
 	MaxTxPerBlock   int
 	Target          [32]byte
+	// NonceBudget caps the header nonces tried per coinbase extranonce. When
+	// the budget (or the full 64-bit nonce space, if zero) is exhausted the
+	// miner rolls the extranonce, which changes the coinbase txid and so the
+	// merkle root, and searches again.
+	NonceBudget uint64
 	// MineAddress is canonical CORE_P2PK covenant_data (suite_id || key_id)
 	// used for the subsidy-bearing coinbase output.
 	MineAddress []byte
+	// SignalBits are featurebit deployment bits set in every mined header's
+	// Version, together with the signaling top-bit pattern, in place of the
+	// base version 1. Bit 0 is reserved for the base value and bits above
+	// VERSIONBITS_MAX_BIT belong to the top-bit pattern, so NewMiner
+	// rejects both.
+	SignalBits []uint8
 
 	// PolicyDaAnchorAntiAbuse is the master switch for the whole DA/anchor
 	// anti-abuse miner-template policy package. When false,
@@ -221,16 +243,21 @@ func (m *Miner) buildBlock(ctx context.Context, txs [][]byte) ([]byte, []uint64,
 	if err != nil {
 		return nil, nil, 0, 0, 0, err
 	}
-	coinbase, merkleRoot, err := m.buildCoinbaseAndMerkleRoot(buildCtx.nextHeight, buildCtx.alreadyGenerated, witnessCommitment, parsed)
-	if err != nil {
-		return nil, nil, 0, 0, 0, err
-	}
-	prevTimestamps, timestamp, headerBytes, nonce, err := m.mineHeader(ctx, buildCtx.nextHeight, buildCtx.prevHash, merkleRoot)
-	if err != nil {
-		return nil, nil, 0, 0, 0, err
+	for extraNonce := uint64(0); ; extraNonce++ {
+		coinbase, merkleRoot, err := m.buildCoinbaseAndMerkleRoot(buildCtx.nextHeight, buildCtx.alreadyGenerated, witnessCommitment, parsed, extraNonce)
+		if err != nil {
+			return nil, nil, 0, 0, 0, err
+		}
+		prevTimestamps, timestamp, headerBytes, nonce, err := m.mineHeader(ctx, buildCtx.nextHeight, buildCtx.prevHash, merkleRoot)
+		if errors.Is(err, errNonceSpaceExhausted) && extraNonce != math.MaxUint64 {
+			continue
+		}
+		if err != nil {
+			return nil, nil, 0, 0, 0, err
+		}
+		blockBytes := assembleBlockBytes(headerBytes, coinbase, parsed)
+		return blockBytes, prevTimestamps, timestamp, nonce, 1 + len(parsed), nil
 	}
-	blockBytes := assembleBlockBytes(headerBytes, coinbase, parsed)
-	return blockBytes, prevTimestamps, timestamp, nonce, 1 + len(parsed), nil
 }
 
 func (m *Miner) buildContext(txs [][]byte) (miningBuildContext, error) {
@@ -310,6 +337,12 @@ func (m *Miner) remainingWeightBudget(nextHeight uint64, alreadyGenerated uint64
 	if err != nil {
 		return 0, err
 	}
+	if m.cfg.NonceBudget != 0 {
+		// Reserve room for the extranonce output a roll would add.
+		if err := addU64NoOverflow(&coinbaseWeight, consensus.WITNESS_DISCOUNT_DIVISOR*extraNonceOutputBytes); err != nil {
+			return 0, errors.New("coinbase weight overflow")
+		}
+	}
 	return remainingWeightFromCoinbase(coinbaseWeight)
 }
 
@@ -769,8 +802,8 @@ func buildWitnessCommitment(parsed []minedCandidate) ([32]byte, error) {
 	return consensus.WitnessCommitmentHash(witnessRoot), nil
 }
 
-func (m *Miner) buildCoinbaseAndMerkleRoot(nextHeight uint64, alreadyGenerated uint64, witnessCommitment [32]byte, parsed []minedCandidate) ([]byte, [32]byte, error) {
-	coinbase, err := buildCoinbaseTx(nextHeight, alreadyGenerated, m.cfg.MineAddress, witnessCommitment)
+func (m *Miner) buildCoinbaseAndMerkleRoot(nextHeight uint64, alreadyGenerated uint64, witnessCommitment [32]byte, parsed []minedCandidate, extraNonce uint64) ([]byte, [32]byte, error) {
+	coinbase, err := buildCoinbaseTxWithExtraNonce(nextHeight, alreadyGenerated, m.cfg.MineAddress, witnessCommitment, extraNonce)
 	if err != nil {
 		return nil, [32]byte{}, err
 	}
@@ -797,8 +830,8 @@ func (m *Miner) mineHeader(ctx context.Context, nextHeight uint64, prevHash [32]
 	}
 	now := m.cfg.TimestampSource()
 	timestamp := chooseValidTimestamp(nextHeight, prevTimestamps, now)
-	blockWithoutNonce := makeHeaderPrefix(prevHash, merkleRoot, timestamp, m.cfg.Target)
-	headerBytes, nonce, err := mineHeaderNonce(ctx, blockWithoutNonce, m.cfg.Target)
+	blockWithoutNonce := makeHeaderPrefix(minerHeaderVersion(m.cfg.SignalBits), prevHash, merkleRoot, timestamp, m.cfg.Target)
+	headerBytes, nonce, err := mineHeaderNonceWithBudget(ctx, blockWithoutNonce, m.cfg.Target, m.cfg.NonceBudget)
 	if err != nil {
 		return nil, 0, nil, 0, err
 	}
@@ -817,8 +850,13 @@ func assembleBlockBytes(headerBytes []byte, coinbase []byte, parsed []minedCandi
 }
 
 func mineHeaderNonce(ctx context.Context, blockWithoutNonce []byte, target [32]byte) ([]byte, uint64, error) {
-	var nonce uint64
-	for {
+	return mineHeaderNonceWithBudget(ctx, blockWithoutNonce, target, 0)
+}
+
+// mineHeaderNonceWithBudget tries nonces 0..budget-1 (the full 64-bit space
+// when budget is zero) and reports errNonceSpaceExhausted if none solves.
+func mineHeaderNonceWithBudget(ctx context.Context, blockWithoutNonce []byte, target [32]byte, budget uint64) ([]byte, uint64, error) {
+	for nonce := uint64(0); ; nonce++ {
 		if ctx != nil {
 			select {
 			case <-ctx.Done():
@@ -830,6 +868,8 @@ func mineHeaderNonce(ctx context.Context, blockWithoutNonce []byte, target [32]b
 		if err := consensus.PowCheck(headerBytes, target); err == nil {
 			return headerBytes, nonce, nil
 		}
-		nonce++
+		if nonce == math.MaxUint64 || (budget != 0 && nonce+1 == budget) {
+			return nil, 0, errNonceSpaceExhausted
+		}
 	}
 }
@@ -65,9 +65,29 @@ func mtpMedian(nextHeight uint64, prevTimestamps []uint64) uint64 {
 	return window[(len(window)-1)/2]
 }
 
-func makeHeaderPrefix(prevHash [32]byte, merkleRoot [32]byte, timestamp uint64, target [32]byte) []byte {
+// minerBaseHeaderVersion is the header version mined blocks carry when no
+// featurebit signal bits are configured.
+const minerBaseHeaderVersion uint32 = 1
+
+// minerHeaderVersion returns the base header version when signalBits is
+// empty, and otherwise the signaling top-bit pattern with exactly the
+// configured bits set. The base version is dropped when signaling so its
+// bit 0 does not count as a signal. Bits must already be validated by
+// validateMinerSignalBits.
+func minerHeaderVersion(signalBits []uint8) uint32 {
+	if len(signalBits) == 0 {
+		return minerBaseHeaderVersion
+	}
+	version := consensus.VERSIONBITS_TOP_BITS
+	for _, bit := range signalBits {
+		version |= 1 << bit
+	}
+	return version
+}
+
+func makeHeaderPrefix(version uint32, prevHash [32]byte, merkleRoot [32]byte, timestamp uint64, target [32]byte) []byte {
 	header := make([]byte, 0, consensus.BLOCK_HEADER_BYTES)
-	header = consensus.AppendU32le(header, 1)
+	header = consensus.AppendU32le(header, version)
 	header = append(header, prevHash[:]...)
 	header = append(header, merkleRoot[:]...)
 	header = consensus.AppendU64le(header, timestamp)
@@ -76,6 +96,13 @@ func makeHeaderPrefix(prevHash [32]byte, merkleRoot [32]byte, timestamp uint64,
 }
 
 func buildCoinbaseTx(height uint64, alreadyGenerated uint64, mineAddress []byte, witnessCommitment [32]byte) ([]byte, error) {
+	return buildCoinbaseTxWithExtraNonce(height, alreadyGenerated, mineAddress, witnessCommitment, 0)
+}
+
+// buildCoinbaseTxWithExtraNonce builds the canonical coinbase and, for a
+// non-zero extraNonce, carries it as an extra zero-value anchor output placed
+// before the witness commitment. extraNonce 0 yields the canonical layout.
+func buildCoinbaseTxWithExtraNonce(height uint64, alreadyGenerated uint64, mineAddress []byte, witnessCommitment [32]byte, extraNonce uint64) ([]byte, error) {
 	if height > math.MaxUint32 {
 		return nil, errors.New("block height exceeds coinbase locktime range")
 	}
@@ -100,6 +127,9 @@ func buildCoinbaseTx(height uint64, alreadyGenerated uint64, mineAddress []byte,
 	if subsidy > 0 {
 		outputCount++
 	}
+	if extraNonce != 0 {
+		outputCount++
+	}
 	tx = consensus.AppendCompactSize(tx, outputCount) // output_count
 	if subsidy > 0 {
 		tx = consensus.AppendU64le(tx, subsidy)
@@ -107,6 +137,12 @@ func buildCoinbaseTx(height uint64, alreadyGenerated uint64, mineAddress []byte,
 		tx = consensus.AppendCompactSize(tx, uint64(len(mineAddress)))
 		tx = append(tx, mineAddress...)
 	}
+	if extraNonce != 0 {
+		tx = consensus.AppendU64le(tx, 0)
+		tx = consensus.AppendU16le(tx, consensus.COV_TYPE_ANCHOR)
+		tx = consensus.AppendCompactSize(tx, 8)
+		tx = consensus.AppendU64le(tx, extraNonce)
+	}
 	tx = consensus.AppendU64le(tx, 0)                         // output value
 	tx = consensus.AppendU16le(tx, consensus.COV_TYPE_ANCHOR) // covenant_type
 	tx = consensus.AppendCompactSize(tx, 32)                  // covenant_data_len
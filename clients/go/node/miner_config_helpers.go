@@ -2,6 +2,9 @@ package node
 
 import (
 	"errors"
+	"fmt"
+
+	"github.com/2tbmz9y2xt-lang/rubin-protocol/clients/go/consensus"
 )
 
 // validateNewMinerInputs validates the inputs to NewMiner
@@ -54,5 +57,21 @@ func normalizeMinerConfig(cfg *MinerConfig) error {
 		return err
 	}
 	cfg.MineAddress = mineAddress
+	return validateMinerSignalBits(cfg.SignalBits)
+}
+
+// validateMinerSignalBits rejects signal bits outside the signaling range
+// (the top three version bits carry the signaling pattern) and the bits of
+// the base version, which stay reserved so a signaling header never reuses
+// a bit the plain version sets.
+func validateMinerSignalBits(bits []uint8) error {
+	for _, bit := range bits {
+		if bit > consensus.VERSIONBITS_MAX_BIT {
+			return fmt.Errorf("miner signal bit %d out of range", bit)
+		}
+		if minerBaseHeaderVersion&(1<<bit) != 0 {
+			return fmt.Errorf("miner signal bit %d collides with base header version", bit)
+		}
+	}
 	return nil
 }
@@ -3,6 +3,8 @@ package node
 import (
 	"bytes"
 	"context"
+	"encoding/binary"
+	"errors"
 	"math"
 	"testing"
 
@@ -59,6 +61,53 @@ func TestMinerMineOneFromEmptyState(t *testing.T) {
 	}
 }
 
+func TestMinerSignalBitsSetHeaderVersion(t *testing.T) {
+	dir := t.TempDir()
+	chainStatePath := ChainStatePath(dir)
+	chainState := NewChainState()
+	blockStore, err := OpenBlockStore(BlockStorePath(dir))
+	if err != nil {
+		t.Fatalf("open blockstore: %v", err)
+	}
+	syncEngine, err := NewSyncEngine(chainState, blockStore, DefaultSyncConfig(nil, [32]byte{}, chainStatePath))
+	if err != nil {
+		t.Fatalf("new sync engine: %v", err)
+	}
+	for _, bits := range [][]uint8{{0}, {29}, {32}} {
+		cfg := DefaultMinerConfig()
+		cfg.SignalBits = bits
+		if _, err := NewMiner(chainState, blockStore, syncEngine, cfg); err == nil {
+			t.Fatalf("NewMiner(SignalBits=%v) succeeded, want error", bits)
+		}
+	}
+
+	cfg := DefaultMinerConfig()
+	cfg.TimestampSource = func() uint64 { return 1_777_000_000 }
+	cfg.SignalBits = []uint8{3}
+	miner, err := NewMiner(chainState, blockStore, syncEngine, cfg)
+	if err != nil {
+		t.Fatalf("new miner: %v", err)
+	}
+	mb, err := miner.MineOne(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("mine one: %v", err)
+	}
+	headerBytes, err := blockStore.GetHeaderByHash(mb.Hash)
+	if err != nil {
+		t.Fatalf("get header: %v", err)
+	}
+	header, err := consensus.ParseBlockHeaderBytes(headerBytes)
+	if err != nil {
+		t.Fatalf("parse header: %v", err)
+	}
+	if want := consensus.VERSIONBITS_TOP_BITS | 1<<3; header.Version != want {
+		t.Fatalf("version=%#x, want %#x", header.Version, want)
+	}
+	if consensus.VersionSignalsBit(header.Version, 0) {
+		t.Fatalf("version=%#x signals bit 0 while configured for bit 3 only", header.Version)
+	}
+}
+
 func TestMinerMineNProducesTimestampProgression(t *testing.T) {
 	dir := t.TempDir()
 	chainStatePath := ChainStatePath(dir)
@@ -101,6 +150,110 @@ func TestMinerMineNProducesTimestampProgression(t *testing.T) {
 	}
 }
 
+func TestMinerInjectedTimestampBelowMTPIsBumpedToMTPPlusOne(t *testing.T) {
+	dir := t.TempDir()
+	chainState := NewChainState()
+	blockStore, err := OpenBlockStore(BlockStorePath(dir))
+	if err != nil {
+		t.Fatalf("open blockstore: %v", err)
+	}
+	syncEngine, err := NewSyncEngine(chainState, blockStore, DefaultSyncConfig(nil, [32]byte{}, ChainStatePath(dir)))
+	if err != nil {
+		t.Fatalf("new sync engine: %v", err)
+	}
+	const genesisTimestamp = 1_777_000_000
+	stamps := []uint64{genesisTimestamp, 5, genesisTimestamp + 60}
+	cfg := DefaultMinerConfig()
+	cfg.TimestampSource = func() uint64 {
+		next := stamps[0]
+		stamps = stamps[1:]
+		return next
+	}
+	miner, err := NewMiner(chainState, blockStore, syncEngine, cfg)
+	if err != nil {
+		t.Fatalf("new miner: %v", err)
+	}
+
+	mined, err := miner.MineN(context.Background(), 3, nil)
+	if err != nil {
+		t.Fatalf("mine n: %v", err)
+	}
+	if mined[0].Timestamp != genesisTimestamp {
+		t.Fatalf("genesis timestamp=%d, want injected %d", mined[0].Timestamp, genesisTimestamp)
+	}
+	// MTP over the single ancestor is the genesis timestamp.
+	if mined[1].Timestamp != genesisTimestamp+1 {
+		t.Fatalf("below-MTP timestamp=%d, want MTP+1=%d", mined[1].Timestamp, genesisTimestamp+1)
+	}
+	// An in-range injected timestamp is used verbatim.
+	if mined[2].Timestamp != genesisTimestamp+60 {
+		t.Fatalf("in-range timestamp=%d, want %d", mined[2].Timestamp, genesisTimestamp+60)
+	}
+}
+
+func TestMinerRollsExtraNonceWhenNonceBudgetExhausted(t *testing.T) {
+	dir := t.TempDir()
+	chainState := NewChainState()
+	blockStore, err := OpenBlockStore(BlockStorePath(dir))
+	if err != nil {
+		t.Fatalf("open blockstore: %v", err)
+	}
+	syncEngine, err := NewSyncEngine(chainState, blockStore, DefaultSyncConfig(nil, [32]byte{}, ChainStatePath(dir)))
+	if err != nil {
+		t.Fatalf("new sync engine: %v", err)
+	}
+	cfg := DefaultMinerConfig()
+	cfg.Target = consensus.POW_LIMIT
+	cfg.Target[0] = 0x01 // ~1/256 success per header attempt
+	cfg.NonceBudget = 1
+	cfg.TimestampSource = func() uint64 { return 1_777_000_000 }
+	miner, err := NewMiner(chainState, blockStore, syncEngine, cfg)
+	if err != nil {
+		t.Fatalf("new miner: %v", err)
+	}
+
+	mined, err := miner.MineOne(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("mine one: %v", err)
+	}
+	if mined.Nonce != 0 {
+		t.Fatalf("nonce=%d, want 0 with a budget of one nonce", mined.Nonce)
+	}
+	blockBytes, err := blockStore.GetBlockByHash(mined.Hash)
+	if err != nil {
+		t.Fatalf("get block: %v", err)
+	}
+	pb, err := consensus.ParseBlockBytes(blockBytes)
+	if err != nil {
+		t.Fatalf("parse block: %v", err)
+	}
+	if err := consensus.PowCheck(pb.HeaderBytes, cfg.Target); err != nil {
+		t.Fatalf("pow check: %v", err)
+	}
+	outputs := pb.Txs[0].Outputs
+	if len(outputs) != 2 {
+		t.Fatalf("coinbase outputs=%d, want extranonce + witness commitment anchors", len(outputs))
+	}
+	extra := outputs[0]
+	if extra.CovenantType != consensus.COV_TYPE_ANCHOR || extra.Value != 0 || len(extra.CovenantData) != 8 {
+		t.Fatalf("unexpected extranonce output: %+v", extra)
+	}
+	if binary.LittleEndian.Uint64(extra.CovenantData) == 0 {
+		t.Fatalf("extranonce was not rolled")
+	}
+}
+
+func TestMineHeaderNonceWithBudgetReportsExhaustion(t *testing.T) {
+	target := consensus.POW_LIMIT
+	target[0] = 0
+	target[1] = 0
+	target[2] = 0
+	_, _, err := mineHeaderNonceWithBudget(context.Background(), make([]byte, consensus.BLOCK_HEADER_BYTES-8), target, 4)
+	if !errors.Is(err, errNonceSpaceExhausted) {
+		t.Fatalf("err=%v, want errNonceSpaceExhausted", err)
+	}
+}
+
 func TestBuildCoinbaseTxAnchorOnlyCanonical(t *testing.T) {
 	var commitment [32]byte
 	for i := range commitment {
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"slices"
 	"testing"
 	"time"
 
@@ -1363,3 +1364,61 @@ func TestIsConsensusApplyBlockError(t *testing.T) {
 		t.Fatalf("consensus TxError must be treated as consensus error")
 	}
 }
+
+// TestGetBlocksLocatorServesMissingInventory drives the locator-based body
+// sync end to end: the lagging node's getblocks payload, served over an
+// in-memory connection, yields an inv of exactly the blocks it lacks.
+func TestGetBlocksLocatorServesMissingInventory(t *testing.T) {
+	server := newTestHarness(t, 4, "127.0.0.1:0", nil)
+	client := newTestHarness(t, 1, "127.0.0.1:0", nil)
+	serverHashes := make([][32]byte, 0, 4)
+	for height := uint64(0); height < 4; height++ {
+		hash, ok, err := server.blockStore.CanonicalHash(height)
+		if err != nil || !ok {
+			t.Fatalf("server CanonicalHash(%d): ok=%v err=%v", height, ok, err)
+		}
+		serverHashes = append(serverHashes, hash)
+	}
+	block1, err := server.blockStore.GetBlockByHash(serverHashes[1])
+	if err != nil {
+		t.Fatalf("GetBlockByHash(1): %v", err)
+	}
+	if _, err := client.syncEngine.ApplyBlock(block1, nil); err != nil {
+		t.Fatalf("client ApplyBlock(1): %v", err)
+	}
+
+	request, err := client.service.getBlocksRequestPayload()
+	if err != nil {
+		t.Fatalf("getBlocksRequestPayload: %v", err)
+	}
+	serving := &peer{service: server.service}
+	local, remote := net.Pipe()
+	defer local.Close()
+	defer remote.Close()
+	serving.conn = local
+
+	done := make(chan message, 1)
+	go func() {
+		frame, err := readFrame(remote, networkMagic(server.service.cfg.PeerRuntimeConfig.Network), server.service.cfg.PeerRuntimeConfig.MaxMessageSize)
+		if err != nil {
+			t.Errorf("readFrame(remote): %v", err)
+		}
+		done <- frame
+	}()
+	if err := serving.handleGetBlocks(request); err != nil {
+		t.Fatalf("handleGetBlocks: %v", err)
+	}
+
+	frame := <-done
+	if frame.Command != messageInv {
+		t.Fatalf("frame.Command=%q, want %q", frame.Command, messageInv)
+	}
+	items, err := decodeInventoryVectors(frame.Payload)
+	if err != nil {
+		t.Fatalf("decodeInventoryVectors: %v", err)
+	}
+	want := []InventoryVector{{Type: MSG_BLOCK, Hash: serverHashes[2]}, {Type: MSG_BLOCK, Hash: serverHashes[3]}}
+	if !slices.Equal(items, want) {
+		t.Fatalf("inv=%x, want %x", items, want)
+	}
+}
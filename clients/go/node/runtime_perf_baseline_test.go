@@ -181,7 +181,7 @@ func benchmarkConnectBlockFixture(tb testing.TB) (*ChainState, []byte, []uint64)
 	}
 	prevTimestamps := []uint64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11}
 	timestamp := chooseValidTimestamp(nextHeight, prevTimestamps, 12)
-	headerPrefix := makeHeaderPrefix(state.TipHash, merkleRoot, timestamp, consensus.POW_LIMIT)
+	headerPrefix := makeHeaderPrefix(minerBaseHeaderVersion, state.TipHash, merkleRoot, timestamp, consensus.POW_LIMIT)
 	headerBytes, _, err := mineHeaderNonce(context.Background(), headerPrefix, consensus.POW_LIMIT)
 	if err != nil {
 		tb.Fatalf("mineHeaderNonce: %v", err)
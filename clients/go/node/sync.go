@@ -29,6 +29,23 @@ type SyncConfig struct {
 
 	ParallelValidationMode string // off|shadow|on
 	PVShadowMaxSamples     uint64 // bounded mismatch diagnostics; 0 => default
+
+	MaxReorgDepth  uint64 // canonical blocks a reorg may disconnect; 0 => unlimited
+	AllowDeepReorg bool   // operator override for MaxReorgDepth
+
+	Checkpoints map[uint64][32]byte // height -> pinned block hash; nil => none
+
+	// Assumevalid: ancestors of the stored AssumeValidHash block, and that
+	// block itself, skip signature verification. It never rejects a block:
+	// one at or below AssumeValidHeight that is off that ancestor chain is
+	// simply verified in full. A zero AssumeValidHash disables it.
+	AssumeValidHeight uint64
+	AssumeValidHash   [32]byte
+
+	// GenesisCoinbaseValue is the chain profile's premine: the height-0
+	// coinbase must pay exactly this much. NewSyncEngine hands it to the
+	// chainstate; nil leaves the chainstate's own setting alone.
+	GenesisCoinbaseValue *uint64
 }
 
 type parallelValidationMode uint8
@@ -118,6 +135,11 @@ type SyncEngine struct {
 	pvShadowMismatches uint64
 	pvShadowSamples    []string
 	pvTelemetry        *PVTelemetry
+
+	// assumeValidChain[i] is the hash i blocks below the assumevalid block,
+	// resolved lazily from stored headers.
+	assumeValidMu    sync.Mutex
+	assumeValidChain [][32]byte
 }
 
 func DefaultSyncConfig(expectedTarget *[32]byte, chainID [32]byte, chainStatePath string) SyncConfig {
@@ -157,6 +179,12 @@ func NewSyncEngine(chainState *ChainState, blockStore *BlockStore, cfg SyncConfi
 	if engine.pvShadowMax == 0 {
 		engine.pvShadowMax = defaultPVShadowMaxSamples
 	}
+	if cfg.GenesisCoinbaseValue != nil {
+		chainState.mu.Lock()
+		value := *cfg.GenesisCoinbaseValue
+		chainState.GenesisCoinbaseValue = &value
+		chainState.mu.Unlock()
+	}
 	return engine, nil
 }
 
@@ -328,6 +356,9 @@ func raceTolerantBootstrapResult(applyErr error, hasTip bool) error {
 }
 
 func (s *SyncEngine) ApplyBlock(blockBytes []byte, prevTimestamps []uint64) (*ChainStateConnectSummary, error) {
+	if err := consensus.CheckBlockSerializedSize(blockBytes); err != nil {
+		return nil, err
+	}
 	pb, err := consensus.ParseBlockBytes(blockBytes)
 	if err != nil {
 		return nil, err
@@ -531,7 +562,7 @@ func (s *SyncEngine) applyCanonicalParsedBlockTracked(
 	if err != nil {
 		return nil, outcome, err
 	}
-	summary, err := s.connectCanonicalBlock(pb, blockBytes, prevTimestamps)
+	summary, err := s.connectCanonicalBlock(ctx.blockHeight, ctx.blockHash, blockBytes, prevTimestamps)
 	s.runPVShadowIfActive(blockBytes, prevTimestamps, ctx.prevState, ctx.blockHeight, err, summary)
 	if err != nil {
 		return nil, blockApplyMetricRejected, err
@@ -557,6 +588,12 @@ func (s *SyncEngine) prepareCanonicalBlockApply(pb *consensus.ParsedBlock) (cano
 	if outcome, err := s.validateGenesisIdentity(blockHeight, blockHash); err != nil {
 		return canonicalBlockApplyContext{}, outcome, err
 	}
+	if err := s.checkCheckpoint(blockHeight, blockHash); err != nil {
+		return canonicalBlockApplyContext{}, blockApplyMetricRejected, err
+	}
+	if err := s.rejectInvalidMarkedBlock(blockHash, pb.Header.PrevBlockHash); err != nil {
+		return canonicalBlockApplyContext{}, blockApplyMetricRejected, err
+	}
 	rollbackState, err := s.captureRollbackState()
 	if err != nil {
 		return canonicalBlockApplyContext{}, blockApplyMetricNone, err
@@ -580,10 +617,21 @@ func (s *SyncEngine) validateCanonicalBlockApplyReady(pb *consensus.ParsedBlock)
 }
 
 func (s *SyncEngine) connectCanonicalBlock(
-	pb *consensus.ParsedBlock,
+	blockHeight uint64,
+	blockHash [32]byte,
 	blockBytes []byte,
 	prevTimestamps []uint64,
 ) (*ChainStateConnectSummary, error) {
+	if s.assumeValidCovers(blockHeight, blockHash) {
+		return s.chainState.ConnectBlockAssumeValidWithSuiteContext(
+			blockBytes,
+			s.cfg.ExpectedTarget,
+			prevTimestamps,
+			s.cfg.ChainID,
+			s.cfg.RotationProvider,
+			s.cfg.SuiteRegistry,
+		)
+	}
 	return s.chainState.ConnectBlockWithSuiteContext(
 		blockBytes,
 		s.cfg.ExpectedTarget,
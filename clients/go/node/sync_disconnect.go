@@ -2,6 +2,7 @@ package node
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/2tbmz9y2xt-lang/rubin-protocol/clients/go/consensus"
 )
@@ -180,3 +181,38 @@ func (s *SyncEngine) finalizeDisconnectState(rollbackState syncRollbackState, ne
 	s.mu.Unlock()
 	return nil
 }
+
+// RewindToHeight disconnects canonical blocks above height using their undo
+// records, restoring the UTXO set and blockstore index to that height. Every
+// undo record is checked up front so a store missing one (pruned below the
+// requested point) is rejected before anything is disconnected.
+func (s *SyncEngine) RewindToHeight(height uint64) error {
+	if err := s.validateDisconnectTipReady(); err != nil {
+		return err
+	}
+	tipHeight, _, err := s.currentCanonicalTip()
+	if err != nil {
+		return err
+	}
+	if height > tipHeight {
+		return fmt.Errorf("rewind height %d is above tip height %d", height, tipHeight)
+	}
+	for h := tipHeight; h > height; h-- {
+		blockHash, ok, err := s.blockStore.CanonicalHash(h)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("missing canonical hash at height %d", h)
+		}
+		if _, err := s.blockStore.GetUndo(blockHash); err != nil {
+			return fmt.Errorf("cannot rewind to height %d: undo for height %d unavailable (pruned): %w", height, h, err)
+		}
+	}
+	disconnected, _, err := s.disconnectCanonicalToAncestor(height)
+	if err != nil {
+		return err
+	}
+	s.requeueDisconnectedTransactions(disconnected)
+	return nil
+}
@@ -451,3 +451,43 @@ func TestValidateDevnetGenesisIdentity_WrongHash(t *testing.T) {
 	err := ValidateDevnetGenesisIdentity(DevnetGenesisChainID(), wrongHash)
 	assertGenesisHashMismatchTxError(t, err)
 }
+
+func TestSyncEngineEnforcesGenesisCoinbaseValue(t *testing.T) {
+	pb, err := consensus.ParseBlockBytes(devnetGenesisBlockBytes)
+	if err != nil {
+		t.Fatalf("ParseBlockBytes: %v", err)
+	}
+	var premine uint64
+	for _, out := range pb.Txs[0].Outputs {
+		premine += out.Value
+	}
+	newEngine := func(value uint64) *SyncEngine {
+		t.Helper()
+		dir := t.TempDir()
+		store, err := OpenBlockStore(BlockStorePath(dir))
+		if err != nil {
+			t.Fatalf("open blockstore: %v", err)
+		}
+		target := consensus.POW_LIMIT
+		cfg := DefaultSyncConfig(&target, devnetGenesisChainID, ChainStatePath(dir))
+		cfg.GenesisCoinbaseValue = &value
+		engine, err := NewSyncEngine(NewChainState(), store, cfg)
+		if err != nil {
+			t.Fatalf("new sync engine: %v", err)
+		}
+		return engine
+	}
+
+	if _, err := newEngine(premine).ApplyBlock(devnetGenesisBlockBytes, nil); err != nil {
+		t.Fatalf("ApplyBlock(genesis, premine %d): %v", premine, err)
+	}
+	engine := newEngine(premine + 1)
+	_, err = engine.ApplyBlock(devnetGenesisBlockBytes, nil)
+	var txErr *consensus.TxError
+	if !errors.As(err, &txErr) || txErr.Code != consensus.BLOCK_ERR_SUBSIDY_EXCEEDED {
+		t.Fatalf("ApplyBlock(genesis, wrong premine) err=%v, want %s", err, consensus.BLOCK_ERR_SUBSIDY_EXCEEDED)
+	}
+	if engine.chainState.HasTip {
+		t.Fatalf("genesis with the wrong coinbase value was connected")
+	}
+}
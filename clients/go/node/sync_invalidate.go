@@ -0,0 +1,141 @@
+package node
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// ErrBlockMarkedInvalid rejects a block that, or whose parent, carries an
+// operator invalidation mark.
+var ErrBlockMarkedInvalid = errors.New("block marked invalid")
+
+// InvalidateBlock marks blockHash and every stored descendant invalid. When
+// the block is on the active chain, the chain is disconnected back to its
+// parent and the best remaining valid branch, if heavier, is activated.
+func (s *SyncEngine) InvalidateBlock(blockHash [32]byte) error {
+	if err := s.validateDisconnectTipReady(); err != nil {
+		return err
+	}
+	if _, err := s.blockStore.GetHeaderByHash(blockHash); err != nil {
+		return fmt.Errorf("unknown block %x: %w", blockHash, err)
+	}
+	height, canonical, err := s.blockStore.FindCanonicalHeight(blockHash)
+	if err != nil {
+		return err
+	}
+	if canonical && height == 0 {
+		return errors.New("cannot invalidate the genesis block")
+	}
+	hashes, err := s.blockStore.StoredDescendants(blockHash)
+	if err != nil {
+		return err
+	}
+	if err := s.blockStore.SetBlocksInvalid(hashes, true); err != nil {
+		return err
+	}
+	if canonical {
+		disconnected, _, err := s.disconnectCanonicalToAncestor(height - 1)
+		if err != nil {
+			return err
+		}
+		s.requeueDisconnectedTransactions(disconnected)
+	}
+	return s.activateBestValidChain()
+}
+
+// ReconsiderBlock clears the invalidation mark from blockHash, its stored
+// descendants and any marked ancestors, then activates the best valid branch.
+func (s *SyncEngine) ReconsiderBlock(blockHash [32]byte) error {
+	if err := s.validateDisconnectTipReady(); err != nil {
+		return err
+	}
+	if _, err := s.blockStore.GetHeaderByHash(blockHash); err != nil {
+		return fmt.Errorf("unknown block %x: %w", blockHash, err)
+	}
+	hashes, err := s.blockStore.StoredDescendants(blockHash)
+	if err != nil {
+		return err
+	}
+	var zero [32]byte
+	current := blockHash
+	for current != zero {
+		header, err := s.blockStore.chainWorkHeader(current)
+		if err != nil {
+			break
+		}
+		current = header.PrevBlockHash
+		if s.blockStore.IsBlockInvalid(current) {
+			hashes = append(hashes, current)
+		}
+	}
+	if err := s.blockStore.SetBlocksInvalid(hashes, false); err != nil {
+		return err
+	}
+	return s.activateBestValidChain()
+}
+
+// activateBestValidChain switches to the valid-fork tip with the most work if
+// it beats the active tip under the usual fork-choice rule.
+func (s *SyncEngine) activateBestValidChain() error {
+	tipHeight, tipHash, err := s.currentCanonicalTip()
+	if err != nil {
+		return err
+	}
+	bestHash := tipHash
+	bestWork, err := s.blockStore.ChainWork(tipHash)
+	if err != nil {
+		return err
+	}
+	tips, err := s.blockStore.ChainTips()
+	if err != nil {
+		return err
+	}
+	for _, tip := range tips {
+		if tip.Status != ChainTipStatusValidFork {
+			continue
+		}
+		work, err := s.blockStore.ChainWork(tip.Hash)
+		if err != nil {
+			return err
+		}
+		if preferChainTip(work, tip.Hash, bestWork, bestHash) {
+			bestHash, bestWork = tip.Hash, work
+		}
+	}
+	if bestHash == tipHash {
+		return nil
+	}
+	blockBytes, err := s.blockStore.GetBlockByHash(bestHash)
+	if err != nil {
+		return err
+	}
+	prevTimestamps, err := prevTimestampsFromStore(s.blockStore, tipHeight+1)
+	if err != nil {
+		return err
+	}
+	_, err = s.ApplyBlockWithReorg(blockBytes, prevTimestamps)
+	return err
+}
+
+func preferChainTip(work *big.Int, hash [32]byte, bestWork *big.Int, bestHash [32]byte) bool {
+	switch work.Cmp(bestWork) {
+	case 1:
+		return true
+	case -1:
+		return false
+	default:
+		return bytes.Compare(hash[:], bestHash[:]) < 0
+	}
+}
+
+func (s *SyncEngine) rejectInvalidMarkedBlock(blockHash [32]byte, prevHash [32]byte) error {
+	if s.blockStore == nil {
+		return nil
+	}
+	if s.blockStore.IsBlockInvalid(blockHash) || s.blockStore.IsBlockInvalid(prevHash) {
+		return fmt.Errorf("%w: %x", ErrBlockMarkedInvalid, blockHash)
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package node
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/2tbmz9y2xt-lang/rubin-protocol/clients/go/consensus"
+)
+
+func TestInvalidateTipReorgsToParentAndReconsiderRestoresIt(t *testing.T) {
+	engine, store, target := newReorgTestEngine(t)
+	forkA, _ := buildCompetingChains(t, competingChainBase{block: devnetGenesisBlockBytes}, 3, 1, target, target)
+	for i, block := range forkA {
+		if _, err := engine.ApplyBlockWithReorg(block, nil); err != nil {
+			t.Fatalf("ApplyBlockWithReorg(A%d): %v", i+1, err)
+		}
+	}
+	tipHash := mustBlockHashForInvalidateTest(t, forkA[2])
+	parentHash := mustBlockHashForInvalidateTest(t, forkA[1])
+
+	if err := engine.InvalidateBlock(tipHash); err != nil {
+		t.Fatalf("InvalidateBlock: %v", err)
+	}
+	if engine.chainState.TipHash != parentHash || engine.chainState.Height != 2 {
+		t.Fatalf("tip=%x height=%d, want parent %x at height 2", engine.chainState.TipHash, engine.chainState.Height, parentHash)
+	}
+	if !store.IsBlockInvalid(tipHash) {
+		t.Fatalf("tip not marked invalid")
+	}
+	if _, err := engine.ApplyBlockWithReorg(forkA[2], nil); !errors.Is(err, ErrBlockMarkedInvalid) {
+		t.Fatalf("reapply invalidated tip err=%v, want ErrBlockMarkedInvalid", err)
+	}
+
+	if err := engine.ReconsiderBlock(tipHash); err != nil {
+		t.Fatalf("ReconsiderBlock: %v", err)
+	}
+	if engine.chainState.TipHash != tipHash || engine.chainState.Height != 3 {
+		t.Fatalf("tip=%x height=%d, want restored tip %x at height 3", engine.chainState.TipHash, engine.chainState.Height, tipHash)
+	}
+	if store.IsBlockInvalid(tipHash) {
+		t.Fatalf("tip still marked invalid after reconsider")
+	}
+}
+
+func TestInvalidateBlockSwitchesToBestValidFork(t *testing.T) {
+	engine, store, target := newReorgTestEngine(t)
+	forkA, forkB := buildCompetingChains(t, competingChainBase{block: devnetGenesisBlockBytes}, 3, 1, target, target)
+	for i, block := range append(append([][]byte(nil), forkA...), forkB...) {
+		if _, err := engine.ApplyBlockWithReorg(block, nil); err != nil {
+			t.Fatalf("ApplyBlockWithReorg(%d): %v", i, err)
+		}
+	}
+	a1 := mustBlockHashForInvalidateTest(t, forkA[0])
+	a3 := mustBlockHashForInvalidateTest(t, forkA[2])
+	b1 := mustBlockHashForInvalidateTest(t, forkB[0])
+
+	if err := engine.InvalidateBlock(a1); err != nil {
+		t.Fatalf("InvalidateBlock(A1): %v", err)
+	}
+	if engine.chainState.TipHash != b1 || engine.chainState.Height != 1 {
+		t.Fatalf("tip=%x height=%d, want fork B tip %x", engine.chainState.TipHash, engine.chainState.Height, b1)
+	}
+	for _, block := range forkA {
+		if hash := mustBlockHashForInvalidateTest(t, block); !store.IsBlockInvalid(hash) {
+			t.Fatalf("descendant %x not marked invalid", hash)
+		}
+	}
+
+	if err := engine.ReconsiderBlock(a1); err != nil {
+		t.Fatalf("ReconsiderBlock(A1): %v", err)
+	}
+	if engine.chainState.TipHash != a3 || engine.chainState.Height != 3 {
+		t.Fatalf("tip=%x height=%d, want fork A tip %x", engine.chainState.TipHash, engine.chainState.Height, a3)
+	}
+	if err := engine.InvalidateBlock(devnetGenesisBlockHash); err == nil {
+		t.Fatalf("expected genesis invalidation to be rejected")
+	}
+}
+
+func mustBlockHashForInvalidateTest(t *testing.T, block []byte) [32]byte {
+	t.Helper()
+	hash, err := consensus.BlockHash(blockHeaderBytes(t, block))
+	if err != nil {
+		t.Fatalf("BlockHash: %v", err)
+	}
+	return hash
+}
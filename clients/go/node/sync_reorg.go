@@ -10,6 +10,12 @@ import (
 	"github.com/2tbmz9y2xt-lang/rubin-protocol/clients/go/consensus"
 )
 
+// ErrReorgTooDeep refuses a switch to a heavier branch whose fork point lies
+// more than SyncConfig.MaxReorgDepth blocks below the canonical tip. The
+// branch tip is still stored, so the switch happens on the next branch block
+// once the operator sets AllowDeepReorg.
+var ErrReorgTooDeep = errors.New("reorg exceeds max depth")
+
 type reorgBranchBlock struct {
 	hash       [32]byte
 	blockBytes []byte
@@ -25,6 +31,9 @@ func (s *SyncEngine) ApplyBlockWithReorg(blockBytes []byte, prevTimestamps []uin
 	if err != nil {
 		return nil, err
 	}
+	if err := s.rejectInvalidMarkedBlock(blockHash, pb.Header.PrevBlockHash); err != nil {
+		return nil, err
+	}
 
 	if summary, handled, err := s.applyDirectBlockIfPossible(pb, blockBytes, prevTimestamps); handled {
 		return summary, err
@@ -33,13 +42,42 @@ func (s *SyncEngine) ApplyBlockWithReorg(blockBytes []byte, prevTimestamps []uin
 	if err != nil {
 		return nil, err
 	}
+	if err := s.checkReorgAboveCheckpoint(commonAncestorHeight); err != nil {
+		return nil, err
+	}
+	if err := s.checkCheckpoint(candidateHeight, blockHash); err != nil {
+		return nil, err
+	}
 	if !switchToBranch {
 		return s.storeSideBlockAndSummary(branch, commonAncestorHeight, candidateHeight)
 	}
+	if err := s.checkReorgDepth(commonAncestorHeight); err != nil {
+		if _, storeErr := s.storeSideBlockAndSummary(branch, commonAncestorHeight, candidateHeight); storeErr != nil {
+			return nil, storeErr
+		}
+		return nil, err
+	}
 	return s.applyPreferredBranch(branch, commonAncestorHeight)
 }
 
+func (s *SyncEngine) checkReorgDepth(commonAncestorHeight uint64) error {
+	if s.cfg.MaxReorgDepth == 0 || s.cfg.AllowDeepReorg {
+		return nil
+	}
+	tipHeight, _, err := s.currentCanonicalTip()
+	if err != nil {
+		return err
+	}
+	if depth := tipHeight - commonAncestorHeight; depth > s.cfg.MaxReorgDepth {
+		return fmt.Errorf("%w: fork point %d blocks below tip, max %d", ErrReorgTooDeep, depth, s.cfg.MaxReorgDepth)
+	}
+	return nil
+}
+
 func parseReorgBlock(blockBytes []byte) (*consensus.ParsedBlock, [32]byte, error) {
+	if err := consensus.CheckBlockSerializedSize(blockBytes); err != nil {
+		return nil, [32]byte{}, err
+	}
 	pb, err := consensus.ParseBlockBytes(blockBytes)
 	if err != nil {
 		return nil, [32]byte{}, err
@@ -202,6 +202,27 @@ func TestApplyBlockWithReorgRejectsMissingParent(t *testing.T) {
 	}
 }
 
+func TestSyncEngineRejectsOversizedBlockBeforeParse(t *testing.T) {
+	engine, _, target := newReorgTestEngine(t)
+	before := engine.BlockApplyCounts()
+	block := buildSingleTxBlock(t, devnetGenesisBlockHash, target, reorgTestTimestamp(1), coinbaseWithWitnessCommitmentAndP2PKValueAtHeight(t, 1, consensus.BlockSubsidy(1, 0)))
+	oversized := append(block, make([]byte, consensus.MAX_BLOCK_BYTES+1-len(block))...)
+
+	_, err := engine.ApplyBlock(oversized, nil)
+	requireConsensusTxErrCode(t, err, consensus.BLOCK_ERR_PARSE)
+	if !strings.Contains(err.Error(), "MAX_BLOCK_BYTES") {
+		t.Fatalf("ApplyBlock err=%v, want size cap rejection", err)
+	}
+	_, err = engine.ApplyBlockWithReorg(oversized, nil)
+	requireConsensusTxErrCode(t, err, consensus.BLOCK_ERR_PARSE)
+	if !strings.Contains(err.Error(), "MAX_BLOCK_BYTES") {
+		t.Fatalf("ApplyBlockWithReorg err=%v, want size cap rejection", err)
+	}
+	if after := engine.BlockApplyCounts(); after != before {
+		t.Fatalf("oversized block changed BlockApplyCounts from %+v to %+v", before, after)
+	}
+}
+
 func TestApplyBlockWithReorgRejectsInvalidNonHeavierSideBranch(t *testing.T) {
 	engine, store, target := newReorgTestEngine(t)
 
@@ -1581,6 +1602,123 @@ func reorgTestCoinbaseForWtxids(t *testing.T, height uint64, value uint64, addre
 	})
 }
 
+// competingChainBase is the common ancestor two forks grow from.
+type competingChainBase struct {
+	block            []byte
+	height           uint64
+	alreadyGenerated uint64
+}
+
+// buildCompetingChains mines two single-coinbase forks of aLen and bLen blocks
+// on top of base, each fork at its own target, so reorg tests control the
+// cumulative work of both sides. Fork B timestamps are offset so the forks
+// never share blocks.
+func buildCompetingChains(t *testing.T, base competingChainBase, aLen, bLen int, aTarget, bTarget [32]byte) ([][]byte, [][]byte) {
+	t.Helper()
+	parent, err := consensus.ParseBlockBytes(base.block)
+	if err != nil {
+		t.Fatalf("ParseBlockBytes(base): %v", err)
+	}
+	baseHash, err := consensus.BlockHash(parent.HeaderBytes)
+	if err != nil {
+		t.Fatalf("BlockHash(base): %v", err)
+	}
+	mineFork := func(length int, target [32]byte, timestampOffset uint64) [][]byte {
+		blocks := make([][]byte, 0, length)
+		prev := baseHash
+		alreadyGenerated := base.alreadyGenerated
+		for i := 1; i <= length; i++ {
+			height := base.height + uint64(i)
+			subsidy := consensus.BlockSubsidy(height, alreadyGenerated)
+			timestamp := parent.Header.Timestamp + timestampOffset + uint64(i)
+			block := mineReorgTestBlock(t, buildSingleTxBlock(t, prev, target, timestamp, coinbaseWithWitnessCommitmentAndP2PKValueAtHeight(t, height, subsidy)), target)
+			blocks = append(blocks, block)
+			prev, err = consensus.BlockHash(blockHeaderBytes(t, block))
+			if err != nil {
+				t.Fatalf("BlockHash(fork block %d): %v", i, err)
+			}
+			alreadyGenerated += subsidy
+		}
+		return blocks
+	}
+	return mineFork(aLen, aTarget, 0), mineFork(bLen, bTarget, 1000)
+}
+
+// mineReorgTestBlock grinds the header nonce until block satisfies target.
+func mineReorgTestBlock(t *testing.T, block []byte, target [32]byte) []byte {
+	t.Helper()
+	for nonce := uint64(0); nonce < 1<<20; nonce++ {
+		candidate := blockWithHeaderNonce(t, block, nonce)
+		if consensus.PowCheck(blockHeaderBytes(t, candidate), target) == nil {
+			return candidate
+		}
+	}
+	t.Fatalf("no nonce solves target %x", target)
+	return nil
+}
+
+func TestApplyBlockWithReorgSelectsHeavierWorkCompetingChain(t *testing.T) {
+	store, err := OpenBlockStore(BlockStorePath(t.TempDir()))
+	if err != nil {
+		t.Fatalf("OpenBlockStore: %v", err)
+	}
+	// No fixed expected target: each fork carries its own.
+	engine, err := NewSyncEngine(NewChainState(), store, DefaultSyncConfig(nil, devnetGenesisChainID, ""))
+	if err != nil {
+		t.Fatalf("NewSyncEngine: %v", err)
+	}
+	if _, err := engine.ApplyBlock(devnetGenesisBlockBytes, nil); err != nil {
+		t.Fatalf("ApplyBlock(genesis): %v", err)
+	}
+
+	// Fork A is longer but easy; fork B is shorter with 8x work per block.
+	easy := consensus.POW_LIMIT
+	hard := consensus.POW_LIMIT
+	hard[0] >>= 3
+	forkA, forkB := buildCompetingChains(t, competingChainBase{block: devnetGenesisBlockBytes}, 4, 2, easy, hard)
+
+	workA, err := consensus.ChainWorkFromTargets([][32]byte{easy, easy, easy, easy})
+	if err != nil {
+		t.Fatalf("ChainWorkFromTargets(A): %v", err)
+	}
+	workB, err := consensus.ChainWorkFromTargets([][32]byte{hard, hard})
+	if err != nil {
+		t.Fatalf("ChainWorkFromTargets(B): %v", err)
+	}
+	if workB.Cmp(workA) <= 0 {
+		t.Fatalf("fixture must make shorter fork B heavier: workA=%s workB=%s", workA, workB)
+	}
+
+	for i, block := range forkA {
+		if _, err := engine.ApplyBlockWithReorg(block, nil); err != nil {
+			t.Fatalf("ApplyBlockWithReorg(A%d): %v", i+1, err)
+		}
+	}
+	tipA, err := consensus.BlockHash(blockHeaderBytes(t, forkA[len(forkA)-1]))
+	if err != nil {
+		t.Fatalf("BlockHash(A tip): %v", err)
+	}
+	if engine.chainState.TipHash != tipA || engine.chainState.Height != 4 {
+		t.Fatalf("fork A not canonical before fork B arrives")
+	}
+
+	for i, block := range forkB {
+		if _, err := engine.ApplyBlockWithReorg(block, nil); err != nil {
+			t.Fatalf("ApplyBlockWithReorg(B%d): %v", i+1, err)
+		}
+	}
+	tipB, err := consensus.BlockHash(blockHeaderBytes(t, forkB[len(forkB)-1]))
+	if err != nil {
+		t.Fatalf("BlockHash(B tip): %v", err)
+	}
+	if engine.chainState.TipHash != tipB || engine.chainState.Height != 2 {
+		t.Fatalf("tip=%x height=%d, want heavier fork B tip %x at height 2", engine.chainState.TipHash, engine.chainState.Height, tipB)
+	}
+	if count := engine.ReorgCount(); count != 1 {
+		t.Fatalf("ReorgCount()=%d, want 1", count)
+	}
+}
+
 func newReorgTestEngine(t *testing.T) (*SyncEngine, *BlockStore, [32]byte) {
 	t.Helper()
 	dir := t.TempDir()
@@ -1878,3 +2016,135 @@ func TestCanonicalAppliedBlocksOnDirectApplyWithReorg(t *testing.T) {
 		t.Fatalf("CanonicalAppliedBlocks[0].Hash=%x, want BlockHash=%x", blocks[0].Hash, summary.BlockHash)
 	}
 }
+
+// reorgTestChain builds n single-coinbase blocks on prev, starting at
+// startHeight with alreadyGenerated subsidy emitted below it.
+func reorgTestChain(t *testing.T, target [32]byte, prev [32]byte, startHeight uint64, alreadyGenerated uint64, n int, tsBase uint64) ([][]byte, [][32]byte) {
+	t.Helper()
+	blocks := make([][]byte, 0, n)
+	hashes := make([][32]byte, 0, n)
+	for i := 0; i < n; i++ {
+		height := startHeight + uint64(i)
+		subsidy := consensus.BlockSubsidy(height, alreadyGenerated)
+		block := buildSingleTxBlock(t, prev, target, reorgTestTimestamp(tsBase+height), coinbaseWithWitnessCommitmentAndP2PKValueAtHeight(t, height, subsidy))
+		hash, err := consensus.BlockHash(blockHeaderBytes(t, block))
+		if err != nil {
+			t.Fatalf("BlockHash(height %d): %v", height, err)
+		}
+		blocks = append(blocks, block)
+		hashes = append(hashes, hash)
+		prev = hash
+		alreadyGenerated += subsidy
+	}
+	return blocks, hashes
+}
+
+func TestApplyBlockWithReorgEnforcesMaxReorgDepth(t *testing.T) {
+	setup := func(t *testing.T) (*SyncEngine, [32]byte, [][32]byte) {
+		t.Helper()
+		engine, _, target := newReorgTestEngine(t)
+		engine.cfg.MaxReorgDepth = 2
+		mainBlocks, mainHashes := reorgTestChain(t, target, devnetGenesisBlockHash, 1, 0, 3, 0)
+		for i, block := range mainBlocks {
+			if _, err := engine.ApplyBlock(block, nil); err != nil {
+				t.Fatalf("ApplyBlock(A%d): %v", i+1, err)
+			}
+		}
+		return engine, target, mainHashes
+	}
+
+	t.Run("within limit", func(t *testing.T) {
+		engine, target, mainHashes := setup(t)
+		alreadyGenerated := consensus.BlockSubsidy(1, 0)
+		alreadyGenerated += consensus.BlockSubsidy(2, alreadyGenerated)
+		side, sideHashes := reorgTestChain(t, target, mainHashes[1], 3, alreadyGenerated, 2, 100)
+		for i, block := range side {
+			if _, err := engine.ApplyBlockWithReorg(block, nil); err != nil {
+				t.Fatalf("ApplyBlockWithReorg(C%d): %v", i+3, err)
+			}
+		}
+		if engine.chainState.TipHash != sideHashes[1] || engine.ReorgCount() != 1 {
+			t.Fatalf("tip=%x reorgs=%d, want %x after one reorg", engine.chainState.TipHash, engine.ReorgCount(), sideHashes[1])
+		}
+	})
+
+	t.Run("beyond limit", func(t *testing.T) {
+		engine, target, mainHashes := setup(t)
+		side, sideHashes := reorgTestChain(t, target, devnetGenesisBlockHash, 1, 0, 5, 100)
+		for i, block := range side[:3] {
+			// B3 ties A3 on work and may win the tip-hash tie-break; either
+			// way the canonical tip must stay on A.
+			if _, err := engine.ApplyBlockWithReorg(block, nil); err != nil && !errors.Is(err, ErrReorgTooDeep) {
+				t.Fatalf("ApplyBlockWithReorg(B%d): %v", i+1, err)
+			}
+		}
+		_, err := engine.ApplyBlockWithReorg(side[3], nil)
+		if !errors.Is(err, ErrReorgTooDeep) {
+			t.Fatalf("ApplyBlockWithReorg(B4) err=%v, want %v", err, ErrReorgTooDeep)
+		}
+		if engine.chainState.TipHash != mainHashes[2] || engine.ReorgCount() != 0 {
+			t.Fatalf("canonical tip moved past the reorg limit")
+		}
+
+		engine.cfg.AllowDeepReorg = true
+		if _, err := engine.ApplyBlockWithReorg(side[4], nil); err != nil {
+			t.Fatalf("ApplyBlockWithReorg(B5) with override: %v", err)
+		}
+		if engine.chainState.TipHash != sideHashes[4] || engine.LastReorgDepth() != 3 {
+			t.Fatalf("tip=%x depth=%d, want %x depth 3", engine.chainState.TipHash, engine.LastReorgDepth(), sideHashes[4])
+		}
+	})
+}
+
+func TestSyncEngineEnforcesCheckpoints(t *testing.T) {
+	t.Run("hash mismatch rejected", func(t *testing.T) {
+		engine, _, target := newReorgTestEngine(t)
+		mainBlocks, mainHashes := reorgTestChain(t, target, devnetGenesisBlockHash, 1, 0, 2, 0)
+		_, otherHashes := reorgTestChain(t, target, devnetGenesisBlockHash, 1, 0, 2, 100)
+		engine.cfg.Checkpoints = map[uint64][32]byte{2: otherHashes[1]}
+		if _, err := engine.ApplyBlock(mainBlocks[0], nil); err != nil {
+			t.Fatalf("ApplyBlock(A1): %v", err)
+		}
+		_, err := engine.ApplyBlock(mainBlocks[1], nil)
+		if !errors.Is(err, ErrCheckpointMismatch) {
+			t.Fatalf("ApplyBlock(A2) err=%v, want %v", err, ErrCheckpointMismatch)
+		}
+		if engine.chainState.TipHash != mainHashes[0] {
+			t.Fatalf("tip=%x, want %x", engine.chainState.TipHash, mainHashes[0])
+		}
+	})
+
+	t.Run("reorg below checkpoint refused", func(t *testing.T) {
+		engine, store, target := newReorgTestEngine(t)
+		mainBlocks, mainHashes := reorgTestChain(t, target, devnetGenesisBlockHash, 1, 0, 3, 0)
+		engine.cfg.Checkpoints = map[uint64][32]byte{2: mainHashes[1]}
+		for i, block := range mainBlocks {
+			if _, err := engine.ApplyBlock(block, nil); err != nil {
+				t.Fatalf("ApplyBlock(A%d): %v", i+1, err)
+			}
+		}
+
+		alreadyGenerated := consensus.BlockSubsidy(1, 0)
+		below, belowHashes := reorgTestChain(t, target, mainHashes[0], 2, alreadyGenerated, 1, 100)
+		if _, err := engine.ApplyBlockWithReorg(below[0], nil); !errors.Is(err, ErrReorgBelowCheckpoint) {
+			t.Fatalf("ApplyBlockWithReorg(B2) err=%v, want %v", err, ErrReorgBelowCheckpoint)
+		}
+		if _, err := store.GetBlockByHash(belowHashes[0]); err == nil {
+			t.Fatalf("side block below checkpoint was stored")
+		}
+		if engine.chainState.TipHash != mainHashes[2] || engine.ReorgCount() != 0 {
+			t.Fatalf("canonical tip moved below checkpoint")
+		}
+
+		alreadyGenerated += consensus.BlockSubsidy(2, alreadyGenerated)
+		above, aboveHashes := reorgTestChain(t, target, mainHashes[1], 3, alreadyGenerated, 2, 200)
+		for i, block := range above {
+			if _, err := engine.ApplyBlockWithReorg(block, nil); err != nil {
+				t.Fatalf("ApplyBlockWithReorg(C%d): %v", i+3, err)
+			}
+		}
+		if engine.chainState.TipHash != aboveHashes[1] || engine.ReorgCount() != 1 {
+			t.Fatalf("tip=%x reorgs=%d, want %x after one reorg", engine.chainState.TipHash, engine.ReorgCount(), aboveHashes[1])
+		}
+	})
+}
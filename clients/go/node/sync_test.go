@@ -659,6 +659,57 @@ func TestSyncEngineDisconnectTipPersistsChainstateAndStore(t *testing.T) {
 	}
 }
 
+func TestSyncEngineRewindToHeightRestoresUtxoSetHash(t *testing.T) {
+	engine, store, target := newReorgTestEngine(t)
+	chain, _ := buildCompetingChains(t, competingChainBase{block: devnetGenesisBlockBytes}, 5, 1, target, target)
+	utxoHashes := [][32]byte{engine.chainState.UtxoSetHash()}
+	blockHashes := [][32]byte{devnetGenesisBlockHash}
+	for i, block := range chain {
+		summary, err := engine.ApplyBlockWithReorg(block, nil)
+		if err != nil {
+			t.Fatalf("ApplyBlockWithReorg(%d): %v", i+1, err)
+		}
+		utxoHashes = append(utxoHashes, engine.chainState.UtxoSetHash())
+		blockHashes = append(blockHashes, summary.BlockHash)
+	}
+
+	// A missing undo record below the target is rejected before any disconnect.
+	undoPath := filepath.Join(store.undoDir, hex.EncodeToString(blockHashes[1][:])+".json")
+	undoRaw, err := os.ReadFile(undoPath)
+	if err != nil {
+		t.Fatalf("read undo: %v", err)
+	}
+	if err := os.Remove(undoPath); err != nil {
+		t.Fatalf("remove undo: %v", err)
+	}
+	if err := engine.RewindToHeight(0); err == nil || !strings.Contains(err.Error(), "pruned") {
+		t.Fatalf("RewindToHeight(0) err=%v, want pruned rejection", err)
+	}
+	if engine.chainState.Height != 5 || engine.chainState.UtxoSetHash() != utxoHashes[5] {
+		t.Fatalf("rejected rewind mutated chainstate: height=%d", engine.chainState.Height)
+	}
+	if err := os.WriteFile(undoPath, undoRaw, 0o600); err != nil {
+		t.Fatalf("restore undo: %v", err)
+	}
+
+	if err := engine.RewindToHeight(2); err != nil {
+		t.Fatalf("RewindToHeight(2): %v", err)
+	}
+	if engine.chainState.Height != 2 || engine.chainState.TipHash != blockHashes[2] {
+		t.Fatalf("tip height=%d hash=%x, want 2/%x", engine.chainState.Height, engine.chainState.TipHash, blockHashes[2])
+	}
+	if got := engine.chainState.UtxoSetHash(); got != utxoHashes[2] {
+		t.Fatalf("utxo set hash=%x, want height-2 hash %x", got, utxoHashes[2])
+	}
+	tipHeight, tipHash, ok, err := store.Tip()
+	if err != nil || !ok || tipHeight != 2 || tipHash != blockHashes[2] {
+		t.Fatalf("store tip=%d/%x ok=%v err=%v, want 2/%x", tipHeight, tipHash, ok, err, blockHashes[2])
+	}
+	if err := engine.RewindToHeight(3); err == nil {
+		t.Fatalf("expected rewind above tip to fail")
+	}
+}
+
 func TestSyncEngineApplyBlockNoMutationOnFailure(t *testing.T) {
 	dir := t.TempDir()
 	chainStatePath := filepath.Join(dir, "chainstate.json")
@@ -1,5 +1,7 @@
 use crate::block::{BlockHeader, BLOCK_HEADER_BYTES};
-use crate::constants::{MAX_ANCHOR_BYTES_PER_BLOCK, MAX_BLOCK_WEIGHT, MAX_DA_BYTES_PER_BLOCK};
+use crate::constants::{
+    MAX_ANCHOR_BYTES_PER_BLOCK, MAX_BLOCK_VERIFY_COST, MAX_BLOCK_WEIGHT, MAX_DA_BYTES_PER_BLOCK,
+};
 use crate::error::{ErrorCode, TxError};
 use crate::suite_registry::RotationProvider;
 use crate::tx::Tx;
@@ -16,7 +18,7 @@ use self::da_set::validate_da_set_integrity;
 pub(crate) use self::orchestration::validate_parsed_block_basic_with_context_at_height;
 use self::parser::parse_block_bytes_impl;
 use self::txs::BlockTxStats;
-use self::weight::tx_weight_and_stats;
+use self::weight::{tx_legacy_verify_cost, tx_weight_and_stats};
 
 pub(crate) use self::coinbase::{validate_coinbase_apply_outputs, validate_coinbase_value_bound};
 pub(crate) use self::header::median_time_past;
@@ -176,6 +178,12 @@ fn validate_block_resource_limits(stats: BlockTxStats) -> Result<(), TxError> {
             "block weight exceeded",
         ));
     }
+    if stats.sum_verify > MAX_BLOCK_VERIFY_COST {
+        return Err(TxError::new(
+            ErrorCode::BlockErrWeightExceeded,
+            "block verify cost exceeded",
+        ));
+    }
     if stats.sum_da > MAX_DA_BYTES_PER_BLOCK {
         return Err(TxError::new(
             ErrorCode::BlockErrWeightExceeded,
@@ -1,10 +1,15 @@
 use super::ParsedBlock;
 use crate::block::{parse_block_header_bytes, BLOCK_HEADER_BYTES};
 use crate::compactsize::read_compact_size;
+use crate::constants::MAX_BLOCK_TX_COUNT;
 use crate::error::{ErrorCode, TxError};
 use crate::tx::{parse_tx, Tx};
 use crate::wire_read::Reader;
 
+// Wire size of the smallest parseable tx: no inputs, outputs, witness items or
+// DA payload.
+const MIN_TX_BYTES: usize = 21;
+
 pub(super) fn parse_block_bytes_impl(block_bytes: &[u8]) -> Result<ParsedBlock, TxError> {
     if block_bytes.len() < BLOCK_HEADER_BYTES + 1 {
         return Err(TxError::new(ErrorCode::BlockErrParse, "block too short"));
@@ -24,6 +29,19 @@ pub(super) fn parse_block_bytes_impl(block_bytes: &[u8]) -> Result<ParsedBlock,
             "empty block tx list",
         ));
     }
+    if tx_count > MAX_BLOCK_TX_COUNT {
+        return Err(TxError::new(
+            ErrorCode::BlockErrParse,
+            "tx_count exceeds MAX_BLOCK_TX_COUNT",
+        ));
+    }
+    let remaining = block_bytes.len() - BLOCK_HEADER_BYTES - r.offset();
+    if tx_count > (remaining / MIN_TX_BYTES) as u64 {
+        return Err(TxError::new(
+            ErrorCode::BlockErrParse,
+            "tx_count exceeds remaining bytes",
+        ));
+    }
 
     let mut txs: Vec<Tx> = Vec::new();
     let mut txids: Vec<[u8; 32]> = Vec::new();
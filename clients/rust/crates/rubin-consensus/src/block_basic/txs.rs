@@ -8,6 +8,7 @@ pub(super) struct BlockTxStats {
     pub(super) sum_weight: u64,
     pub(super) sum_da: u64,
     pub(super) sum_anchor: u64,
+    pub(super) sum_verify: u64,
 }
 
 pub(super) fn accumulate_block_resource_stats(pb: &ParsedBlock) -> Result<BlockTxStats, TxError> {
@@ -15,6 +16,7 @@ pub(super) fn accumulate_block_resource_stats(pb: &ParsedBlock) -> Result<BlockT
         sum_weight: 0,
         sum_da: 0,
         sum_anchor: 0,
+        sum_verify: 0,
     };
     for tx in &pb.txs {
         let (w, da, anchor_bytes) = tx_weight_and_stats(tx)?;
@@ -22,6 +24,11 @@ pub(super) fn accumulate_block_resource_stats(pb: &ParsedBlock) -> Result<BlockT
         stats.sum_da = add_block_resource_stat(stats.sum_da, da, "sum_da overflow")?;
         stats.sum_anchor =
             add_block_resource_stat(stats.sum_anchor, anchor_bytes, "sum_anchor overflow")?;
+        stats.sum_verify = add_block_resource_stat(
+            stats.sum_verify,
+            tx_legacy_verify_cost(tx)?,
+            "sum_verify_cost overflow",
+        )?;
     }
     Ok(stats)
 }
@@ -70,7 +77,8 @@ mod tests {
     use super::*;
     use crate::block::BlockHeader;
     use crate::constants::{
-        COV_TYPE_ANCHOR, COV_TYPE_DA_COMMIT, COV_TYPE_P2PK, SUITE_ID_SENTINEL, TX_WIRE_VERSION,
+        COV_TYPE_ANCHOR, COV_TYPE_DA_COMMIT, COV_TYPE_P2PK, MAX_WITNESS_ITEMS, SUITE_ID_SENTINEL,
+        TX_WIRE_VERSION, VERIFY_COST_UNKNOWN_SUITE,
     };
     use crate::hash::sha3_256;
     use crate::tx::{DaChunkCore, TxInput, TxOutput, WitnessItem};
@@ -191,6 +199,7 @@ mod tests {
             "sum_weight overflow",
             "sum_da overflow",
             "sum_anchor overflow",
+            "sum_verify_cost overflow",
         ] {
             let err = add_block_resource_stat(u64::MAX, 1, msg).unwrap_err();
             assert_eq!(err.code, ErrorCode::TxErrParse);
@@ -198,6 +207,36 @@ mod tests {
         }
     }
 
+    #[test]
+    fn verify_cost_exceeded_under_weight_cap() {
+        // Small non-native witness items are cheap in bytes but each is
+        // charged VERIFY_COST_UNKNOWN_SUITE, so enough of them exceed the
+        // verify-cost cap while the block stays well under MAX_BLOCK_WEIGHT.
+        let per_tx = MAX_WITNESS_ITEMS;
+        let tx_count = MAX_BLOCK_VERIFY_COST / (per_tx * VERIFY_COST_UNKNOWN_SUITE) + 1;
+        let mut txs = vec![coinbase(1)];
+        for i in 0..tx_count {
+            let mut tx = spend(i + 1, 1);
+            tx.witness = vec![
+                WitnessItem {
+                    suite_id: 0x02,
+                    pubkey: vec![0x01],
+                    signature: vec![0x01],
+                };
+                per_tx as usize
+            ];
+            txs.push(tx);
+        }
+        let pb = parsed_block(txs);
+
+        let stats = accumulate_block_resource_stats(&pb).expect("stats");
+        assert!(stats.sum_weight <= MAX_BLOCK_WEIGHT);
+        assert!(stats.sum_verify > MAX_BLOCK_VERIFY_COST);
+        let err = validate_block_resource_limits(stats).unwrap_err();
+        assert_eq!(err.code, ErrorCode::BlockErrWeightExceeded);
+        assert_eq!(err.msg, "block verify cost exceeded");
+    }
+
     #[test]
     fn validate_block_tx_semantics_rejects_nonce_replay() {
         let pb = parsed_block(vec![coinbase(1), spend(42, 1), spend(42, 1)]);
@@ -109,6 +109,14 @@ pub(super) fn tx_weight_and_stats(tx: &Tx) -> Result<(u64, u64, u64), TxError> {
     tx_weight_components(tx, legacy_sig_cost)
 }
 
+/// Summed legacy per-suite verify cost of a tx's witness, the per-tx term of
+/// the block verify-cost total (mirror of Go `computeTxWitness` with
+/// `legacyWitnessSigCost`).
+pub(super) fn tx_legacy_verify_cost(tx: &Tx) -> Result<u64, TxError> {
+    let (_, sig_cost) = tx_witness_size_and_sig_cost(tx, legacy_sig_cost)?;
+    Ok(sig_cost)
+}
+
 fn legacy_sig_cost(witness: &WitnessItem) -> Result<u64, TxError> {
     Ok(match witness.suite_id {
         SUITE_ID_SENTINEL => 0,
@@ -16,10 +16,14 @@ pub const EMISSION_SPEED_FACTOR: u8 = 20;
 pub const TAIL_EMISSION_PER_BLOCK: u64 = 19_025_875;
 
 pub const MAX_BLOCK_WEIGHT: u64 = 68_000_000;
+pub const MAX_BLOCK_VERIFY_COST: u64 = 1_000_000; // summed witness verify cost; bounds cheap-bytes/high-verify blocks
 pub const MAX_BLOCK_BYTES: u64 = 72_000_000; // operational P2P cap; not a consensus validity bound
 pub const MAX_DA_BYTES_PER_BLOCK: u64 = 32_000_000;
 pub const MIN_DA_RETENTION_BLOCKS: u64 = 15_120;
 pub const MAX_RELAY_MSG_BYTES: u64 = 96_000_000;
+// Derived parse bound: the smallest tx weighs 78, so a block declaring
+// more txs than this always exceeds MAX_BLOCK_WEIGHT.
+pub const MAX_BLOCK_TX_COUNT: u64 = MAX_BLOCK_WEIGHT / 78;
 
 pub const MAX_DA_MANIFEST_BYTES_PER_TX: u64 = 65_536;
 pub const CHUNK_BYTES: u64 = 524_288;
@@ -1,5 +1,9 @@
 use crate::constants::{SIGNAL_THRESHOLD, SIGNAL_WINDOW};
 
+/// Highest deployment bit a header can signal; bits 29..31 carry the
+/// signaling top-bit pattern.
+pub const VERSIONBITS_MAX_BIT: u8 = 28;
+
 #[derive(Clone, Copy, Debug, PartialEq, Eq)]
 pub enum FeatureBitState {
     Defined,
@@ -75,7 +79,7 @@ pub fn featurebit_state_at_height_from_window_counts(
     if d.name.is_empty() {
         return Err("featurebits: name required".to_string());
     }
-    if d.bit > 31 {
+    if d.bit > VERSIONBITS_MAX_BIT {
         return Err(format!("featurebits: bit out of range: {}", d.bit));
     }
     if d.timeout_height < d.start_height {
@@ -1,3 +1,4 @@
+use crate::constants::MAX_BLOCK_TX_COUNT;
 use crate::error::{ErrorCode, TxError};
 use crate::hash::sha3_256;
 
@@ -12,6 +13,7 @@ pub fn witness_merkle_root_wtxids(wtxids: &[[u8; 32]]) -> Result<[u8; 32], TxErr
             "merkle: empty wtxid list",
         ));
     }
+    check_merkle_leaf_count(wtxids.len())?;
     let mut ids = wtxids.to_vec();
     // Break self-reference: coinbase witness commitment tree uses a zero id for index 0.
     ids[0] = [0u8; 32];
@@ -25,10 +27,23 @@ pub fn witness_commitment_hash(witness_root: [u8; 32]) -> [u8; 32] {
     sha3_256(&preimage)
 }
 
+// Block parsing already caps tx_count; re-checking here keeps direct callers
+// from driving the level allocations past a valid block's leaf count.
+fn check_merkle_leaf_count(n: usize) -> Result<(), TxError> {
+    if n as u64 > MAX_BLOCK_TX_COUNT {
+        return Err(TxError::new(
+            ErrorCode::BlockErrMerkleInvalid,
+            "merkle: leaf count exceeds MAX_BLOCK_TX_COUNT",
+        ));
+    }
+    Ok(())
+}
+
 fn merkle_root_tagged(ids: &[[u8; 32]], leaf_tag: u8, node_tag: u8) -> Result<[u8; 32], TxError> {
     if ids.is_empty() {
         return Err(TxError::new(ErrorCode::TxErrParse, "merkle: empty id list"));
     }
+    check_merkle_leaf_count(ids.len())?;
 
     let mut level: Vec<[u8; 32]> = Vec::with_capacity(ids.len());
     let mut leaf_preimage = [0u8; 1 + 32];
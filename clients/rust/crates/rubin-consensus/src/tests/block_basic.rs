@@ -1370,6 +1370,38 @@ fn parse_block_bytes_tx_count_2pow32_eof() {
     assert_eq!(err.code, ErrorCode::BlockErrParse);
 }
 
+#[test]
+fn parse_block_bytes_tx_count_above_cap_rejected_early() {
+    let tx = minimal_tx_bytes();
+    let mut prev = [0u8; 32];
+    prev[0] = 0xad;
+    let target = [0xffu8; 32];
+    let block = build_block_bytes(prev, [0u8; 32], target, 1, std::slice::from_ref(&tx));
+    let mut buf = block[..BLOCK_HEADER_BYTES].to_vec();
+    buf.push(0xFE); // CompactSize tag for u32
+    buf.extend_from_slice(&((MAX_BLOCK_TX_COUNT + 1) as u32).to_le_bytes());
+    buf.push(0xFF); // malformed tx byte the cap check must never reach
+    let err = parse_block_bytes(&buf).unwrap_err();
+    assert_eq!(err.code, ErrorCode::BlockErrParse);
+    assert_eq!(err.msg, "tx_count exceeds MAX_BLOCK_TX_COUNT");
+}
+
+#[test]
+fn parse_block_bytes_tx_count_exceeds_remaining_bytes() {
+    let tx = minimal_tx_bytes();
+    let mut prev = [0u8; 32];
+    prev[0] = 0xae;
+    let target = [0xffu8; 32];
+    let block = build_block_bytes(prev, [0u8; 32], target, 1, &[tx.clone(), tx]);
+    let mut buf = block.clone();
+    buf[BLOCK_HEADER_BYTES] = 0x03; // 42 body bytes cannot hold 3 txs
+    let err = parse_block_bytes(&buf).unwrap_err();
+    assert_eq!(err.code, ErrorCode::BlockErrParse);
+    assert_eq!(err.msg, "tx_count exceeds remaining bytes");
+    let parsed = parse_block_bytes(&block).expect("parse_block");
+    assert_eq!(parsed.tx_count, 2);
+}
+
 #[test]
 fn parse_block_bytes_tx_count_too_small_trailing() {
     let tx = minimal_tx_bytes();
@@ -443,6 +443,15 @@ fn witness_commitment_hash_uses_prefix() {
     assert_eq!(got, sha3_256(&preimage));
 }
 
+#[test]
+fn merkle_roots_reject_leaf_count_over_cap() {
+    let ids = vec![[0u8; 32]; MAX_BLOCK_TX_COUNT as usize + 1];
+    let err = merkle_root_txids(&ids).unwrap_err();
+    assert_eq!(err.code, ErrorCode::BlockErrMerkleInvalid);
+    let err = witness_merkle_root_wtxids(&ids).unwrap_err();
+    assert_eq!(err.code, ErrorCode::BlockErrMerkleInvalid);
+}
+
 #[test]
 fn sighash_v1_digest_smoke() {
     let mut b = Vec::new();
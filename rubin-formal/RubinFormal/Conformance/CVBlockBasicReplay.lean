@@ -44,7 +44,8 @@ def checkBlockBasicVector (v : CVBlockBasicVector) : Bool :=
                 true
               else
                 v.expectErr == some "BLOCK_ERR_ANCHOR_BYTES_EXCEEDED" ||
-                v.expectErr == some "BLOCK_ERR_DA_BATCH_EXCEEDED"
+                v.expectErr == some "BLOCK_ERR_DA_BATCH_EXCEEDED" ||
+                v.expectErr == some "BLOCK_ERR_WEIGHT_EXCEEDED"
           | .error e => (!v.expectOk) && (some e == v.expectErr)
       | .connect_block_basic =>
           match toUtxoPairsBlockBasic? v.utxos with
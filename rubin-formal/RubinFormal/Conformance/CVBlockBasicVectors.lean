@@ -47,7 +47,8 @@ def cvBlockBasicVectors : List CVBlockBasicVector := [
   { id := "CV-B-12", op := .block_basic_check, blockHex := "0x010000009191919191919191919191919191919191919191919191919191919191919191f05069f846d13329b8fcdcc675f5b61c4e14c827cf53a5872f0c23572aa8c31e0100000000000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff2000000000000000fd030101000000000000000000000000010000000000000000000000000000000000000000000000000000000000000000ffffffff00ffffffff010000000000000000020020d7284b9cf930aea202334053ea424f1b4887826e6b83ac151c3addce4ce6bd7101000000000001000000010a00000000000000010a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0000000000000000000100000000000000000301205d53469f20fef4f8eab52b88044ede69c77a6a68a60728609fc4a65ff531e7d00000000000000000000000000000000000000000000000000000000000000000000000000100101010101010101010101010101010101010101010101010101010101010101001000000000000001111111111111111111111111111111111111111111111111111111111111111121212121212121212121212121212121212121212121212121212121212121213131313131313131313131313131313131313131313131313131313131313130000000001000000020b00000000000000011b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000005d53469f20fef4f8eab52b88044ede69c77a6a68a60728609fc4a65ff531e7d000010001000000010c00000000000000010c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0000000000000000000100000000000000000301202767f15c8af2f2c7225d5273fdd683edc714110a987d1054697c348aed4e6cc70000000001010101010101010101010101010101010101010101010101010101010101010100101010101010101010101010101010101010101010101010101010101010101001000000000000001111111111111111111111111111111111111111111111111111111111111111121212121212121212121212121212121212121212121212121212121212121213131313131313131313131313131313131313131313131313131313131313130000000001000000020d00000000000000011d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d0000000000000000000000000000010101010101010101010101010101010101010101010101010101010101010100002767f15c8af2f2c7225d5273fdd683edc714110a987d1054697c348aed4e6cc700010101000000010e00000000000000010e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0000000000000000000100000000000000000301200a1e2736777f80a62beb2df72b649878481c0ca10194b832b5136befbae540170000000002020202020202020202020202020202020202020202020202020202020202020100101010101010101010101010101010101010101010101010101010101010101001000000000000001111111111111111111111111111111111111111111111111111111111111111121212121212121212121212121212121212121212121212121212121212121213131313131313131313131313131313131313131313131313131313131313130000000001000000020f00000000000000011f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f0000000000000000000000000000020202020202020202020202020202020202020202020202020202020202020200000a1e2736777f80a62beb2df72b649878481c0ca10194b832b5136befbae5401700010201000000011000000000000000011010101010101010101010101010101010101010101010101010101010101010000000000000000000010000000000000000030120e3ed56bd086d8958483a12734fa0ae7f5c8bb160ef9092c67e82ed9b19e4c7b20000000003030303030303030303030303030303030303030303030303030303030303030100101010101010101010101010101010101010101010101010101010101010101001000000000000001111111111111111111111111111111111111111111111111111111111111111121212121212121212121212121212121212121212121212121212121212121213131313131313131313131313131313131313131313131313131313131313130000000001000000021100000000000000012121212121212121212121212121212121212121212121212121212121212121000000000000000000000000000003030303030303030303030303030303030303030303030303030303030303030000e3ed56bd086d8958483a12734fa0ae7f5c8bb160ef9092c67e82ed9b19e4c7b200010301000000011200000000000000011212121212121212121212121212121212121212121212121212121212121212000000000000000000010000000000000000030120989216075a288af2c12f115557518d248f93c434965513f5f739df8c9d6e19320000000004040404040404040404040404040404040404040404040404040404040404040100101010101010101010101010101010101010101010101010101010101010101001000000000000001111111111111111111111111111111111111111111111111111111111111111121212121212121212121212121212121212121212121212121212121212121213131313131313131313131313131313131313131313131313131313131313130000000001000000021300000000000000012323232323232323232323232323232323232323232323232323232323232323000000000000000000000000000004040404040404040404040404040404040404040404040404040404040404040000989216075a288af2c12f115557518d248f93c434965513f5f739df8c9d6e1932000104010000000114000000000000000114141414141414141414141414141414141414141414141414141414141414140000000000000000000100000000000000000301203b0c4d506212cd7e7b88bc93b5b1811ab5de6796d2780e9de7378c87fe9a80a600000000050505050505050505050505050505050505050505050505050505050505050501001010101010101010101010101010101010101010101010101010101010101010010000000000000011111111111111111111111111111111111111111111111111111111111111111212121212121212121212121212121212121212121212121212121212121212131313131313131313131313131313131313131313131313131313131313131300000000010000000215000000000000000125252525252525252525252525252525252525252525252525252525252525250000000000000000000000000000050505050505050505050505050505050505050505050505050505050505050500003b0c4d506212cd7e7b88bc93b5b1811ab5de6796d2780e9de7378c87fe9a80a6000105010000000116000000000000000116161616161616161616161616161616161616161616161616161616161616160000000000000000000100000000000000000301205a3442340ee31fa728f182f7dbaef4825025f40378061428bcc9f859aa4c294a00000000060606060606060606060606060606060606060606060606060606060606060601001010101010101010101010101010101010101010101010101010101010101010010000000000000011111111111111111111111111111111111111111111111111111111111111111212121212121212121212121212121212121212121212121212121212121212131313131313131313131313131313131313131313131313131313131313131300000000010000000217000000000000000127272727272727272727272727272727272727272727272727272727272727270000000000000000000000000000060606060606060606060606060606060606060606060606060606060606060600005a3442340ee31fa728f182f7dbaef4825025f40378061428bcc9f859aa4c294a000106010000000118000000000000000118181818181818181818181818181818181818181818181818181818181818180000000000000000000100000000000000000301205223f7670b3b9ba04f57d477478ae77a58190d89f21da0b0be774735e23f9c9600000000070707070707070707070707070707070707070707070707070707070707070701001010101010101010101010101010101010101010101010101010101010101010010000000000000011111111111111111111111111111111111111111111111111111111111111111212121212121212121212121212121212121212121212121212121212121212131313131313131313131313131313131313131313131313131313131313131300000000010000000219000000000000000129292929292929292929292929292929292929292929292929292929292929290000000000000000000000000000070707070707070707070707070707070707070707070707070707070707070700005223f7670b3b9ba04f57d477478ae77a58190d89f21da0b0be774735e23f9c9600010701000000011a00000000000000011a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a00000000000000000001000000000000000003012004058b18052fd86b2a3032bcc55c823c48bf5810a3726f538a1d01ebb42584c50000000008080808080808080808080808080808080808080808080808080808080808080100101010101010101010101010101010101010101010101010101010101010101001000000000000001111111111111111111111111111111111111111111111111111111111111111121212121212121212121212121212121212121212121212121212121212121213131313131313131313131313131313131313131313131313131313131313130000000001000000021b00000000000000012b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b00000000000000000000000000000808080808080808080808080808080808080808080808080808080808080808000004058b18052fd86b2a3032bcc55c823c48bf5810a3726f538a1d01ebb42584c500010801000000011c00000000000000011c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c0000000000000000000100000000000000000301208bf02b8b238233453488311be9b316e58ab7e1356ce948cb90dfef1af56992eb0000000009090909090909090909090909090909090909090909090909090909090909090100101010101010101010101010101010101010101010101010101010101010101001000000000000001111111111111111111111111111111111111111111111111111111111111111121212121212121212121212121212121212121212121212121212121212121213131313131313131313131313131313131313131313131313131313131313130000000001000000021d00000000000000012d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d0000000000000000000000000000090909090909090909090909090909090909090909090909090909090909090900008bf02b8b238233453488311be9b316e58ab7e1356ce948cb90dfef1af56992eb00010901000000011e00000000000000011e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e000000000000000000010000000000000000030120a78f2c566b2439463a2e7ca515bbfa3f92948506583cbadaebdd507f277542bd000000000a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0100101010101010101010101010101010101010101010101010101010101010101001000000000000001111111111111111111111111111111111111111111111111111111111111111121212121212121212121212121212121212121212121212121212121212121213131313131313131313131313131313131313131313131313131313131313130000000001000000021f00000000000000012f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f00000000000000000000000000000a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0000a78f2c566b2439463a2e7ca515bbfa3f92948506583cbadaebdd507f277542bd00010a01000000012000000000000000012020202020202020202020202020202020202020202020202020202020202020000000000000000000010000000000000000030120962f8420917d7fa5479f4a767bf9b9a30a4ab377af26d72dbcff167d6ce3f6f5000000000b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002210000000000000001313131313131313131313131313131313131313131313131313131313131313100000000000000000000000000000b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0000962f8420917d7fa5479f4a767bf9b9a30a4ab377af26d72dbcff167d6ce3f6f500010b010000000122000000000000000122222222222222222222222222222222222222222222222222222222222222220000000000000000000100000000000000000301209150274889a799f4e795088f93ee134dd9571c6fa7940370d3e05692c6fe217f000000000c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002230000000000000001333333333333333333333333333333333333333333333333333333333333333300000000000000000000000000000c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c00009150274889a799f4e795088f93ee134dd9571c6fa7940370d3e05692c6fe217f00010c01000000012400000000000000012424242424242424242424242424242424242424242424242424242424242424000000000000000000010000000000000000030120cc7fd2d0b9381e25d5f1394227a8a4df0f82d374567632ddae402323ac71427b000000000d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002250000000000000001353535353535353535353535353535353535353535353535353535353535353500000000000000000000000000000d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0000cc7fd2d0b9381e25d5f1394227a8a4df0f82d374567632ddae402323ac71427b00010d01000000012600000000000000012626262626262626262626262626262626262626262626262626262626262626000000000000000000010000000000000000030120470301436d8bdf0e9ac28f33a05da66135cd405782cb5ccf32280807f44b0988000000000e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002270000000000000001373737373737373737373737373737373737373737373737373737373737373700000000000000000000000000000e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0000470301436d8bdf0e9ac28f33a05da66135cd405782cb5ccf32280807f44b098800010e01000000012800000000000000012828282828282828282828282828282828282828282828282828282828282828000000000000000000010000000000000000030120b83ec7cb1f722c090e14cdb557e673bf1826afd11e224d6e94ab48112f07633a000000000f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002290000000000000001393939393939393939393939393939393939393939393939393939393939393900000000000000000000000000000f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0000b83ec7cb1f722c090e14cdb557e673bf1826afd11e224d6e94ab48112f07633a00010f01000000012a00000000000000012a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a000000000000000000010000000000000000030120ce8d4b29e9ff2dd381325b72551323368210da7c4a84d0e3e55dd029031a4e4c0000000010101010101010101010101010101010101010101010101010101010101010100100101010101010101010101010101010101010101010101010101010101010101001000000000000001111111111111111111111111111111111111111111111111111111111111111121212121212121212121212121212121212121212121212121212121212121213131313131313131313131313131313131313131313131313131313131313130000000001000000022b00000000000000013b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b000000000000000000000000000010101010101010101010101010101010101010101010101010101010101010100000ce8d4b29e9ff2dd381325b72551323368210da7c4a84d0e3e55dd029031a4e4c00011001000000012c00000000000000012c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c0000000000000000000100000000000000000301208d5cc459ce36eda1a075fb2a80696f455c96693ca7e619d1ebaa384c56ce44360000000011111111111111111111111111111111111111111111111111111111111111110100101010101010101010101010101010101010101010101010101010101010101001000000000000001111111111111111111111111111111111111111111111111111111111111111121212121212121212121212121212121212121212121212121212121212121213131313131313131313131313131313131313131313131313131313131313130000000001000000022d00000000000000013d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d0000000000000000000000000000111111111111111111111111111111111111111111111111111111111111111100008d5cc459ce36eda1a075fb2a80696f455c96693ca7e619d1ebaa384c56ce443600011101000000012e00000000000000012e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e000000000000000000010000000000000000030120bf931c9eed1d7d81c3ab815ea4150d5f9efe357f32dbece862c15cf4ed92ed670000000012121212121212121212121212121212121212121212121212121212121212120100101010101010101010101010101010101010101010101010101010101010101001000000000000001111111111111111111111111111111111111111111111111111111111111111121212121212121212121212121212121212121212121212121212121212121213131313131313131313131313131313131313131313131313131313131313130000000001000000022f00000000000000013f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f000000000000000000000000000012121212121212121212121212121212121212121212121212121212121212120000bf931c9eed1d7d81c3ab815ea4150d5f9efe357f32dbece862c15cf4ed92ed67000112010000000130000000000000000130303030303030303030303030303030303030303030303030303030303030300000000000000000000100000000000000000301201594a22d1e1dd176e6f35ae26d8efa294589e23676e1fdc917423a128254652800000000131313131313131313131313131313131313131313131313131313131313131301001010101010101010101010101010101010101010101010101010101010101010010000000000000011111111111111111111111111111111111111111111111111111111111111111212121212121212121212121212121212121212121212121212121212121212131313131313131313131313131313131313131313131313131313131313131300000000010000000231000000000000000141414141414141414141414141414141414141414141414141414141414141410000000000000000000000000000131313131313131313131313131313131313131313131313131313131313131300001594a22d1e1dd176e6f35ae26d8efa294589e23676e1fdc917423a128254652800011301000000013200000000000000013232323232323232323232323232323232323232323232323232323232323232000000000000000000010000000000000000030120f9ef4b52b4f8d87cbc3d50c981470099656f957e5b56dc109b8ec9ea0df31f7e0000000014141414141414141414141414141414141414141414141414141414141414140100101010101010101010101010101010101010101010101010101010101010101001000000000000001111111111111111111111111111111111111111111111111111111111111111121212121212121212121212121212121212121212121212121212121212121213131313131313131313131313131313131313131313131313131313131313130000000001000000023300000000000000014343434343434343434343434343434343434343434343434343434343434343000000000000000000000000000014141414141414141414141414141414141414141414141414141414141414140000f9ef4b52b4f8d87cbc3d50c981470099656f957e5b56dc109b8ec9ea0df31f7e000114010000000134000000000000000134343434343434343434343434343434343434343434343434343434343434340000000000000000000100000000000000000301206e102fab5ab09de44a9ec4c6374d040fdec8492d06acb06d24aaa97d34f4248c00000000151515151515151515151515151515151515151515151515151515151515151501001010101010101010101010101010101010101010101010101010101010101010010000000000000011111111111111111111111111111111111111111111111111111111111111111212121212121212121212121212121212121212121212121212121212121212131313131313131313131313131313131313131313131313131313131313131300000000010000000235000000000000000145454545454545454545454545454545454545454545454545454545454545450000000000000000000000000000151515151515151515151515151515151515151515151515151515151515151500006e102fab5ab09de44a9ec4c6374d040fdec8492d06acb06d24aaa97d34f4248c000115010000000136000000000000000136363636363636363636363636363636363636363636363636363636363636360000000000000000000100000000000000000301202c418d79b706e10f30ca8f908188f6543b836d8808ad96f32d4364e677e7616c00000000161616161616161616161616161616161616161616161616161616161616161601001010101010101010101010101010101010101010101010101010101010101010010000000000000011111111111111111111111111111111111111111111111111111111111111111212121212121212121212121212121212121212121212121212121212121212131313131313131313131313131313131313131313131313131313131313131300000000010000000237000000000000000147474747474747474747474747474747474747474747474747474747474747470000000000000000000000000000161616161616161616161616161616161616161616161616161616161616161600002c418d79b706e10f30ca8f908188f6543b836d8808ad96f32d4364e677e7616c00011601000000013800000000000000013838383838383838383838383838383838383838383838383838383838383838000000000000000000010000000000000000030120c6b91a3f82559066a81f3308f01a5a10f2f12b4dabc22b219977bd02886a74490000000017171717171717171717171717171717171717171717171717171717171717170100101010101010101010101010101010101010101010101010101010101010101001000000000000001111111111111111111111111111111111111111111111111111111111111111121212121212121212121212121212121212121212121212121212121212121213131313131313131313131313131313131313131313131313131313131313130000000001000000023900000000000000014949494949494949494949494949494949494949494949494949494949494949000000000000000000000000000017171717171717171717171717171717171717171717171717171717171717170000c6b91a3f82559066a81f3308f01a5a10f2f12b4dabc22b219977bd02886a744900011701000000013a00000000000000013a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a000000000000000000010000000000000000030120282e60e3d3159a68c70b220d794a361aeb4b40de1def66e6bb62984903993bcc0000000018181818181818181818181818181818181818181818181818181818181818180100101010101010101010101010101010101010101010101010101010101010101001000000000000001111111111111111111111111111111111111111111111111111111111111111121212121212121212121212121212121212121212121212121212121212121213131313131313131313131313131313131313131313131313131313131313130000000001000000023b00000000000000014b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b000000000000000000000000000018181818181818181818181818181818181818181818181818181818181818180000282e60e3d3159a68c70b220d794a361aeb4b40de1def66e6bb62984903993bcc00011801000000013c00000000000000013c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c000000000000000000010000000000000000030120ff30ec4a3001b576d2ce0b81b948c0d27756f433f0a17d15ded2d416abb2fa400000000019191919191919191919191919191919191919191919191919191919191919190100101010101010101010101010101010101010101010101010101010101010101001000000000000001111111111111111111111111111111111111111111111111111111111111111121212121212121212121212121212121212121212121212121212121212121213131313131313131313131313131313131313131313131313131313131313130000000001000000023d00000000000000014d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d000000000000000000000000000019191919191919191919191919191919191919191919191919191919191919190000ff30ec4a3001b576d2ce0b81b948c0d27756f433f0a17d15ded2d416abb2fa4000011901000000013e00000000000000013e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e000000000000000000010000000000000000030120f790118e6b020d24d925c7e88ff066d6e423dad4f199f9fc1c8c02aad2771c7a000000001a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a0100101010101010101010101010101010101010101010101010101010101010101001000000000000001111111111111111111111111111111111111111111111111111111111111111121212121212121212121212121212121212121212121212121212121212121213131313131313131313131313131313131313131313131313131313131313130000000001000000023f00000000000000014f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f00000000000000000000000000001a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a0000f790118e6b020d24d925c7e88ff066d6e423dad4f199f9fc1c8c02aad2771c7a00011a01000000014000000000000000014040404040404040404040404040404040404040404040404040404040404040000000000000000000010000000000000000030120137c2c0431e8f35541aa04817c8d865699a368c89c50addee08c9da39923b4d4000000001b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002410000000000000001515151515151515151515151515151515151515151515151515151515151515100000000000000000000000000001b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b0000137c2c0431e8f35541aa04817c8d865699a368c89c50addee08c9da39923b4d400011b01000000014200000000000000014242424242424242424242424242424242424242424242424242424242424242000000000000000000010000000000000000030120045fe5716b0ff6293c1e984a3b699dfd628f2b5cab47cf67f021297276c778d7000000001c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002430000000000000001535353535353535353535353535353535353535353535353535353535353535300000000000000000000000000001c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c0000045fe5716b0ff6293c1e984a3b699dfd628f2b5cab47cf67f021297276c778d700011c010000000144000000000000000144444444444444444444444444444444444444444444444444444444444444440000000000000000000100000000000000000301206621ea09fdc34b62ea0e541b4b5b4781779382d1eedf425319b0c70a6f3049f7000000001d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002450000000000000001555555555555555555555555555555555555555555555555555555555555555500000000000000000000000000001d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d00006621ea09fdc34b62ea0e541b4b5b4781779382d1eedf425319b0c70a6f3049f700011d0100000001460000000000000001464646464646464646464646464646464646464646464646464646464646464600000000000000000001000000000000000003012064c0d3363a7dac64da9dab189b7291d94cd58622c5c7232865dc8ba06b7e3375000000001e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002470000000000000001575757575757575757575757575757575757575757575757575757575757575700000000000000000000000000001e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e000064c0d3363a7dac64da9dab189b7291d94cd58622c5c7232865dc8ba06b7e337500011e0100000001480000000000000001484848484848484848484848484848484848484848484848484848484848484800000000000000000001000000000000000003012069e6918d691d7826b04c799901f838bc779024fed79956490301c495080dfb74000000001f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002490000000000000001595959595959595959595959595959595959595959595959595959595959595900000000000000000000000000001f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f000069e6918d691d7826b04c799901f838bc779024fed79956490301c495080dfb7400011f01000000014a00000000000000014a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a00000000000000000001000000000000000003012060e893e6d54d8526e55a81f98bfac5da236bb203e84ed5967a8f527d5bf3d4a40000000020202020202020202020202020202020202020202020202020202020202020200100101010101010101010101010101010101010101010101010101010101010101001000000000000001111111111111111111111111111111111111111111111111111111111111111121212121212121212121212121212121212121212121212121212121212121213131313131313131313131313131313131313131313131313131313131313130000000001000000024b00000000000000015b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b00000000000000000000000000002020202020202020202020202020202020202020202020202020202020202020000060e893e6d54d8526e55a81f98bfac5da236bb203e84ed5967a8f527d5bf3d4a400012001000000014c00000000000000014c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c00000000000000000001000000000000000003012069557d9030b514d23c3fa89a760aefaf668a3597f85d343d76bdcdc4fb46fab20000000021212121212121212121212121212121212121212121212121212121212121210100101010101010101010101010101010101010101010101010101010101010101001000000000000001111111111111111111111111111111111111111111111111111111111111111121212121212121212121212121212121212121212121212121212121212121213131313131313131313131313131313131313131313131313131313131313130000000001000000024d00000000000000015d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d00000000000000000000000000002121212121212121212121212121212121212121212121212121212121212121000069557d9030b514d23c3fa89a760aefaf668a3597f85d343d76bdcdc4fb46fab200012101000000014e00000000000000014e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e00000000000000000001000000000000000003012019c556a59db63fa18f94b23908e29e09507de62cb4fa71cf10184120389e519d0000000022222222222222222222222222222222222222222222222222222222222222220100101010101010101010101010101010101010101010101010101010101010101001000000000000001111111111111111111111111111111111111111111111111111111111111111121212121212121212121212121212121212121212121212121212121212121213131313131313131313131313131313131313131313131313131313131313130000000001000000024f00000000000000015f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f00000000000000000000000000002222222222222222222222222222222222222222222222222222222222222222000019c556a59db63fa18f94b23908e29e09507de62cb4fa71cf10184120389e519d00012201000000015000000000000000015050505050505050505050505050505050505050505050505050505050505050000000000000000000010000000000000000030120415733a38975da5f451d27a3c42aa6ecb9b49e55466875b6c794606b7435083f0000000023232323232323232323232323232323232323232323232323232323232323230100101010101010101010101010101010101010101010101010101010101010101001000000000000001111111111111111111111111111111111111111111111111111111111111111121212121212121212121212121212121212121212121212121212121212121213131313131313131313131313131313131313131313131313131313131313130000000001000000025100000000000000016161616161616161616161616161616161616161616161616161616161616161000000000000000000000000000023232323232323232323232323232323232323232323232323232323232323230000415733a38975da5f451d27a3c42aa6ecb9b49e55466875b6c794606b7435083f000123010000000152000000000000000152525252525252525252525252525252525252525252525252525252525252520000000000000000000100000000000000000301205ecdbae446010644dd235353f132c03fa21a1e6020a86e1672cbf1a693db542800000000242424242424242424242424242424242424242424242424242424242424242401001010101010101010101010101010101010101010101010101010101010101010010000000000000011111111111111111111111111111111111111111111111111111111111111111212121212121212121212121212121212121212121212121212121212121212131313131313131313131313131313131313131313131313131313131313131300000000010000000253000000000000000163636363636363636363636363636363636363636363636363636363636363630000000000000000000000000000242424242424242424242424242424242424242424242424242424242424242400005ecdbae446010644dd235353f132c03fa21a1e6020a86e1672cbf1a693db542800012401000000015400000000000000015454545454545454545454545454545454545454545454545454545454545454000000000000000000010000000000000000030120b2cc85d9e0dfa0f499ea90331043d79998ba91613930b6c9fed30860e5f9c5660000000025252525252525252525252525252525252525252525252525252525252525250100101010101010101010101010101010101010101010101010101010101010101001000000000000001111111111111111111111111111111111111111111111111111111111111111121212121212121212121212121212121212121212121212121212121212121213131313131313131313131313131313131313131313131313131313131313130000000001000000025500000000000000016565656565656565656565656565656565656565656565656565656565656565000000000000000000000000000025252525252525252525252525252525252525252525252525252525252525250000b2cc85d9e0dfa0f499ea90331043d79998ba91613930b6c9fed30860e5f9c566000125010000000156000000000000000156565656565656565656565656565656565656565656565656565656565656560000000000000000000100000000000000000301207c4aeccd828f584d77f59f3f3f1f56c8bccd2910909bfd75cde01dfd40ad0dbd00000000262626262626262626262626262626262626262626262626262626262626262601001010101010101010101010101010101010101010101010101010101010101010010000000000000011111111111111111111111111111111111111111111111111111111111111111212121212121212121212121212121212121212121212121212121212121212131313131313131313131313131313131313131313131313131313131313131300000000010000000257000000000000000167676767676767676767676767676767676767676767676767676767676767670000000000000000000000000000262626262626262626262626262626262626262626262626262626262626262600007c4aeccd828f584d77f59f3f3f1f56c8bccd2910909bfd75cde01dfd40ad0dbd0001260100000001580000000000000001585858585858585858585858585858585858585858585858585858585858585800000000000000000001000000000000000003012063b09dc43798fff6456d57c5179f853d71d89c13c759c1cb39bfb011933e4930000000002727272727272727272727272727272727272727272727272727272727272727010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002590000000000000001696969696969696969696969696969696969696969696969696969696969696900000000000000000000000000002727272727272727272727272727272727272727272727272727272727272727000063b09dc43798fff6456d57c5179f853d71d89c13c759c1cb39bfb011933e493000012701000000015a00000000000000015a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a00000000000000000001000000000000000003012071f6371d545b5237edc05a8ea1dcaaf5a18ec336fa6bc847dba2e6b4c841573b0000000028282828282828282828282828282828282828282828282828282828282828280100101010101010101010101010101010101010101010101010101010101010101001000000000000001111111111111111111111111111111111111111111111111111111111111111121212121212121212121212121212121212121212121212121212121212121213131313131313131313131313131313131313131313131313131313131313130000000001000000025b00000000000000016b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b00000000000000000000000000002828282828282828282828282828282828282828282828282828282828282828000071f6371d545b5237edc05a8ea1dcaaf5a18ec336fa6bc847dba2e6b4c841573b00012801000000015c00000000000000015c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c000000000000000000010000000000000000030120b9401340161a1a40671bd617732ce24df2b28ddfb3852d43983832da59797bc30000000029292929292929292929292929292929292929292929292929292929292929290100101010101010101010101010101010101010101010101010101010101010101001000000000000001111111111111111111111111111111111111111111111111111111111111111121212121212121212121212121212121212121212121212121212121212121213131313131313131313131313131313131313131313131313131313131313130000000001000000025d00000000000000016d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d000000000000000000000000000029292929292929292929292929292929292929292929292929292929292929290000b9401340161a1a40671bd617732ce24df2b28ddfb3852d43983832da59797bc300012901000000015e00000000000000015e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e00000000000000000001000000000000000003012082283b4b030589a7aa0ca28b8e933ac0bd89738a0df509806c864366deec31d7000000002a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a0100101010101010101010101010101010101010101010101010101010101010101001000000000000001111111111111111111111111111111111111111111111111111111111111111121212121212121212121212121212121212121212121212121212121212121213131313131313131313131313131313131313131313131313131313131313130000000001000000025f00000000000000016f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f00000000000000000000000000002a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a000082283b4b030589a7aa0ca28b8e933ac0bd89738a0df509806c864366deec31d700012a01000000016000000000000000016060606060606060606060606060606060606060606060606060606060606060000000000000000000010000000000000000030120797d7bc8705bcd69863385ecfa78454d6dd6cab3822a1a49d837a60e8845bc4a000000002b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002610000000000000001717171717171717171717171717171717171717171717171717171717171717100000000000000000000000000002b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b0000797d7bc8705bcd69863385ecfa78454d6dd6cab3822a1a49d837a60e8845bc4a00012b0100000001620000000000000001626262626262626262626262626262626262626262626262626262626262626200000000000000000001000000000000000003012045445e2157145217e6fd68388490e3c17a5ca24cf1b9353b579aca37f6aa0860000000002c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002630000000000000001737373737373737373737373737373737373737373737373737373737373737300000000000000000000000000002c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c000045445e2157145217e6fd68388490e3c17a5ca24cf1b9353b579aca37f6aa086000012c01000000016400000000000000016464646464646464646464646464646464646464646464646464646464646464000000000000000000010000000000000000030120a7327aa627ec3566be2a4a0c62e9b90c339b85dbe13d04977536d6ba4c2db8f6000000002d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002650000000000000001757575757575757575757575757575757575757575757575757575757575757500000000000000000000000000002d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d0000a7327aa627ec3566be2a4a0c62e9b90c339b85dbe13d04977536d6ba4c2db8f600012d010000000166000000000000000166666666666666666666666666666666666666666666666666666666666666660000000000000000000100000000000000000301206890427a1f51a3e7e1dfb1f57449c5f2a24a9bed6b5d82973df1d78e765ea227000000002e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002670000000000000001777777777777777777777777777777777777777777777777777777777777777700000000000000000000000000002e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e00006890427a1f51a3e7e1dfb1f57449c5f2a24a9bed6b5d82973df1d78e765ea22700012e010000000168000000000000000168686868686868686868686868686868686868686868686868686868686868680000000000000000000100000000000000000301201236e9a3b6c8bbc889679c68af4372b7d68e2f2343c03ae7aaa4b25fbb8257c3000000002f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002690000000000000001797979797979797979797979797979797979797979797979797979797979797900000000000000000000000000002f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f00001236e9a3b6c8bbc889679c68af4372b7d68e2f2343c03ae7aaa4b25fbb8257c300012f01000000016a00000000000000016a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a000000000000000000010000000000000000030120f9e2eaaa42d9fe9e558a9b8ef1bf366f190aacaa83bad2641ee106e9041096e40000000030303030303030303030303030303030303030303030303030303030303030300100101010101010101010101010101010101010101010101010101010101010101001000000000000001111111111111111111111111111111111111111111111111111111111111111121212121212121212121212121212121212121212121212121212121212121213131313131313131313131313131313131313131313131313131313131313130000000001000000026b00000000000000017b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b000000000000000000000000000030303030303030303030303030303030303030303030303030303030303030300000f9e2eaaa42d9fe9e558a9b8ef1bf366f190aacaa83bad2641ee106e9041096e400013001000000016c00000000000000016c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c00000000000000000001000000000000000003012067b176705b46206614219f47a05aee7ae6a3edbe850bbbe214c536b989aea4d20000000031313131313131313131313131313131313131313131313131313131313131310100101010101010101010101010101010101010101010101010101010101010101001000000000000001111111111111111111111111111111111111111111111111111111111111111121212121212121212121212121212121212121212121212121212121212121213131313131313131313131313131313131313131313131313131313131313130000000001000000026d00000000000000017d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d00000000000000000000000000003131313131313131313131313131313131313131313131313131313131313131000067b176705b46206614219f47a05aee7ae6a3edbe850bbbe214c536b989aea4d200013101000000016e00000000000000016e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e000000000000000000010000000000000000030120b1b1bd1ed240b1496c81ccf19ceccf2af6fd24fac10ae42023628abbe26873100000000032323232323232323232323232323232323232323232323232323232323232320100101010101010101010101010101010101010101010101010101010101010101001000000000000001111111111111111111111111111111111111111111111111111111111111111121212121212121212121212121212121212121212121212121212121212121213131313131313131313131313131313131313131313131313131313131313130000000001000000026f00000000000000017f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f000000000000000000000000000032323232323232323232323232323232323232323232323232323232323232320000b1b1bd1ed240b1496c81ccf19ceccf2af6fd24fac10ae42023628abbe2687310000132010000000170000000000000000170707070707070707070707070707070707070707070707070707070707070700000000000000000000100000000000000000301201bf0b26eb2090599dd68cbb42c86a674cb07ab7adc103ad3ccdf521bb79056b900000000333333333333333333333333333333333333333333333333333333333333333301001010101010101010101010101010101010101010101010101010101010101010010000000000000011111111111111111111111111111111111111111111111111111111111111111212121212121212121212121212121212121212121212121212121212121212131313131313131313131313131313131313131313131313131313131313131300000000010000000271000000000000000181818181818181818181818181818181818181818181818181818181818181810000000000000000000000000000333333333333333333333333333333333333333333333333333333333333333300001bf0b26eb2090599dd68cbb42c86a674cb07ab7adc103ad3ccdf521bb79056b900013301000000017200000000000000017272727272727272727272727272727272727272727272727272727272727272000000000000000000010000000000000000030120b410677b84ed73fac43fcf1abd933151dd417d932a0ef9b0260ecf8b7b72ecb90000000034343434343434343434343434343434343434343434343434343434343434340100101010101010101010101010101010101010101010101010101010101010101001000000000000001111111111111111111111111111111111111111111111111111111111111111121212121212121212121212121212121212121212121212121212121212121213131313131313131313131313131313131313131313131313131313131313130000000001000000027300000000000000018383838383838383838383838383838383838383838383838383838383838383000000000000000000000000000034343434343434343434343434343434343434343434343434343434343434340000b410677b84ed73fac43fcf1abd933151dd417d932a0ef9b0260ecf8b7b72ecb90001340100000001740000000000000001747474747474747474747474747474747474747474747474747474747474747400000000000000000001000000000000000003012086bc56fc56af4c3cde021282f6b727ee9f90dd636e0b0c712a85d416c75e652d000000003535353535353535353535353535353535353535353535353535353535353535010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002750000000000000001858585858585858585858585858585858585858585858585858585858585858500000000000000000000000000003535353535353535353535353535353535353535353535353535353535353535000086bc56fc56af4c3cde021282f6b727ee9f90dd636e0b0c712a85d416c75e652d000135010000000176000000000000000176767676767676767676767676767676767676767676767676767676767676760000000000000000000100000000000000000301200c67354981e9068905680b57898ad4f04b993c63eb66aa3f19cdfdc71d88077e00000000363636363636363636363636363636363636363636363636363636363636363601001010101010101010101010101010101010101010101010101010101010101010010000000000000011111111111111111111111111111111111111111111111111111111111111111212121212121212121212121212121212121212121212121212121212121212131313131313131313131313131313131313131313131313131313131313131300000000010000000277000000000000000187878787878787878787878787878787878787878787878787878787878787870000000000000000000000000000363636363636363636363636363636363636363636363636363636363636363600000c67354981e9068905680b57898ad4f04b993c63eb66aa3f19cdfdc71d88077e000136010000000178000000000000000178787878787878787878787878787878787878787878787878787878787878780000000000000000000100000000000000000301208f9b51ce624f01b0a40c9f68ba8bb0a2c06aa7f95d1ed27d6b1b5e1e99ee5e4d00000000373737373737373737373737373737373737373737373737373737373737373701001010101010101010101010101010101010101010101010101010101010101010010000000000000011111111111111111111111111111111111111111111111111111111111111111212121212121212121212121212121212121212121212121212121212121212131313131313131313131313131313131313131313131313131313131313131300000000010000000279000000000000000189898989898989898989898989898989898989898989898989898989898989890000000000000000000000000000373737373737373737373737373737373737373737373737373737373737373700008f9b51ce624f01b0a40c9f68ba8bb0a2c06aa7f95d1ed27d6b1b5e1e99ee5e4d00013701000000017a00000000000000017a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a000000000000000000010000000000000000030120d14a329a1924592faf2d4ba6dc727d59af6afae983a0c208bf980237b63a5a6a0000000038383838383838383838383838383838383838383838383838383838383838380100101010101010101010101010101010101010101010101010101010101010101001000000000000001111111111111111111111111111111111111111111111111111111111111111121212121212121212121212121212121212121212121212121212121212121213131313131313131313131313131313131313131313131313131313131313130000000001000000027b00000000000000018b8b8b8b8b8b8b8b8b8b8b8b8b8b8b8b8b8b8b8b8b8b8b8b8b8b8b8b8b8b8b8b000000000000000000000000000038383838383838383838383838383838383838383838383838383838383838380000d14a329a1924592faf2d4ba6dc727d59af6afae983a0c208bf980237b63a5a6a00013801000000017c00000000000000017c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c0000000000000000000100000000000000000301207609430974b087595488c154bf5c079887ead0e8efd4055cd136fda96a5ccbf80000000039393939393939393939393939393939393939393939393939393939393939390100101010101010101010101010101010101010101010101010101010101010101001000000000000001111111111111111111111111111111111111111111111111111111111111111121212121212121212121212121212121212121212121212121212121212121213131313131313131313131313131313131313131313131313131313131313130000000001000000027d00000000000000018d8d8d8d8d8d8d8d8d8d8d8d8d8d8d8d8d8d8d8d8d8d8d8d8d8d8d8d8d8d8d8d0000000000000000000000000000393939393939393939393939393939393939393939393939393939393939393900007609430974b087595488c154bf5c079887ead0e8efd4055cd136fda96a5ccbf800013901000000017e00000000000000017e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e000000000000000000010000000000000000030120763c38be0664691418d38f5ccde0162c9ff11fbda1b946d56476bdaa90fd13d6000000003a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a0100101010101010101010101010101010101010101010101010101010101010101001000000000000001111111111111111111111111111111111111111111111111111111111111111121212121212121212121212121212121212121212121212121212121212121213131313131313131313131313131313131313131313131313131313131313130000000001000000027f00000000000000018f8f8f8f8f8f8f8f8f8f8f8f8f8f8f8f8f8f8f8f8f8f8f8f8f8f8f8f8f8f8f8f00000000000000000000000000003a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a0000763c38be0664691418d38f5ccde0162c9ff11fbda1b946d56476bdaa90fd13d600013a0100000001800000000000000001808080808080808080808080808080808080808080808080808080808080808000000000000000000001000000000000000003012036f2899dda755f20a4cba7bd395a95a4a3ae186206fc781ae5b00a8b6b138b32000000003b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002810000000000000001919191919191919191919191919191919191919191919191919191919191919100000000000000000000000000003b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b000036f2899dda755f20a4cba7bd395a95a4a3ae186206fc781ae5b00a8b6b138b3200013b01000000018200000000000000018282828282828282828282828282828282828282828282828282828282828282000000000000000000010000000000000000030120c03cdc484ad76e2ff295f5f4dea5e5a17ef7c3b5e7726c9473957dd4a47f74d5000000003c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002830000000000000001939393939393939393939393939393939393939393939393939393939393939300000000000000000000000000003c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c0000c03cdc484ad76e2ff295f5f4dea5e5a17ef7c3b5e7726c9473957dd4a47f74d500013c01000000018400000000000000018484848484848484848484848484848484848484848484848484848484848484000000000000000000010000000000000000030120ef95447405babdf85baa7c4f0059e687df4e4ff1dfb90f62be64d406301e4317000000003d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002850000000000000001959595959595959595959595959595959595959595959595959595959595959500000000000000000000000000003d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d0000ef95447405babdf85baa7c4f0059e687df4e4ff1dfb90f62be64d406301e431700013d01000000018600000000000000018686868686868686868686868686868686868686868686868686868686868686000000000000000000010000000000000000030120660038bc754b4ff023f4e4c672cfc031a3d5f7348fb262a124c4fe1e1460f5a5000000003e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002870000000000000001979797979797979797979797979797979797979797979797979797979797979700000000000000000000000000003e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e0000660038bc754b4ff023f4e4c672cfc031a3d5f7348fb262a124c4fe1e1460f5a500013e01000000018800000000000000018888888888888888888888888888888888888888888888888888888888888888000000000000000000010000000000000000030120d827feb7bdb2df079c4d896ee5fdabad3b6258ad2049919bf822317e91d89bbf000000003f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002890000000000000001999999999999999999999999999999999999999999999999999999999999999900000000000000000000000000003f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f0000d827feb7bdb2df079c4d896ee5fdabad3b6258ad2049919bf822317e91d89bbf00013f01000000018a00000000000000018a8a8a8a8a8a8a8a8a8a8a8a8a8a8a8a8a8a8a8a8a8a8a8a8a8a8a8a8a8a8a8a000000000000000000010000000000000000030120053edf8c54e1067887e92964d9e856a4d0f31c730dba8575d80355ceadecf03e0000000040404040404040404040404040404040404040404040404040404040404040400100101010101010101010101010101010101010101010101010101010101010101001000000000000001111111111111111111111111111111111111111111111111111111111111111121212121212121212121212121212121212121212121212121212121212121213131313131313131313131313131313131313131313131313131313131313130000000001000000028b00000000000000019b9b9b9b9b9b9b9b9b9b9b9b9b9b9b9b9b9b9b9b9b9b9b9b9b9b9b9b9b9b9b9b000000000000000000000000000040404040404040404040404040404040404040404040404040404040404040400000053edf8c54e1067887e92964d9e856a4d0f31c730dba8575d80355ceadecf03e00014001000000018c00000000000000018c8c8c8c8c8c8c8c8c8c8c8c8c8c8c8c8c8c8c8c8c8c8c8c8c8c8c8c8c8c8c8c0000000000000000000100000000000000000301201c9ebd6caf02840a5b2b7f0fc870ec1db154886ae9fe621b822b14fd0bf513d60000000041414141414141414141414141414141414141414141414141414141414141410100101010101010101010101010101010101010101010101010101010101010101001000000000000001111111111111111111111111111111111111111111111111111111111111111121212121212121212121212121212121212121212121212121212121212121213131313131313131313131313131313131313131313131313131313131313130000000001000000028d00000000000000019d9d9d9d9d9d9d9d9d9d9d9d9d9d9d9d9d9d9d9d9d9d9d9d9d9d9d9d9d9d9d9d0000000000000000000000000000414141414141414141414141414141414141414141414141414141414141414100001c9ebd6caf02840a5b2b7f0fc870ec1db154886ae9fe621b822b14fd0bf513d600014101000000018e00000000000000018e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e000000000000000000010000000000000000030120521ec18851e17bbba961bc46c70baf03ee67ebdea11a8306de39c15a90e9d2e50000000042424242424242424242424242424242424242424242424242424242424242420100101010101010101010101010101010101010101010101010101010101010101001000000000000001111111111111111111111111111111111111111111111111111111111111111121212121212121212121212121212121212121212121212121212121212121213131313131313131313131313131313131313131313131313131313131313130000000001000000028f00000000000000019f9f9f9f9f9f9f9f9f9f9f9f9f9f9f9f9f9f9f9f9f9f9f9f9f9f9f9f9f9f9f9f000000000000000000000000000042424242424242424242424242424242424242424242424242424242424242420000521ec18851e17bbba961bc46c70baf03ee67ebdea11a8306de39c15a90e9d2e5000142010000000190000000000000000190909090909090909090909090909090909090909090909090909090909090900000000000000000000100000000000000000301202248e6be26f60c9baa59adbda2a136a4a5305d7b475d8465ba4911b4886e39a5000000004343434343434343434343434343434343434343434343434343434343434343010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002910000000000000001a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a10000000000000000000000000000434343434343434343434343434343434343434343434343434343434343434300002248e6be26f60c9baa59adbda2a136a4a5305d7b475d8465ba4911b4886e39a500014301000000019200000000000000019292929292929292929292929292929292929292929292929292929292929292000000000000000000010000000000000000030120037f4095baddc6f37fde4740c304b1691512d2fc9cf7ede8a93b8c9ec3d1fe07000000004444444444444444444444444444444444444444444444444444444444444444010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002930000000000000001a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3a3000000000000000000000000000044444444444444444444444444444444444444444444444444444444444444440000037f4095baddc6f37fde4740c304b1691512d2fc9cf7ede8a93b8c9ec3d1fe0700014401000000019400000000000000019494949494949494949494949494949494949494949494949494949494949494000000000000000000010000000000000000030120e63a84c18447bfca5c67b20a58fc6a4fefa762e4fa0e6b3b2e46f64daba345e5000000004545454545454545454545454545454545454545454545454545454545454545010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002950000000000000001a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5000000000000000000000000000045454545454545454545454545454545454545454545454545454545454545450000e63a84c18447bfca5c67b20a58fc6a4fefa762e4fa0e6b3b2e46f64daba345e500014501000000019600000000000000019696969696969696969696969696969696969696969696969696969696969696000000000000000000010000000000000000030120caf04597f01603582b91c53d5dad9c6c481445b5160a976a44c35ed428b439d7000000004646464646464646464646464646464646464646464646464646464646464646010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002970000000000000001a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7000000000000000000000000000046464646464646464646464646464646464646464646464646464646464646460000caf04597f01603582b91c53d5dad9c6c481445b5160a976a44c35ed428b439d70001460100000001980000000000000001989898989898989898989898989898989898989898989898989898989898989800000000000000000001000000000000000003012025c69eebe727567130b3e3320395e3ec854138e6ea5034dc79eebcbeb86da200000000004747474747474747474747474747474747474747474747474747474747474747010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002990000000000000001a9a9a9a9a9a9a9a9a9a9a9a9a9a9a9a9a9a9a9a9a9a9a9a9a9a9a9a9a9a9a9a900000000000000000000000000004747474747474747474747474747474747474747474747474747474747474747000025c69eebe727567130b3e3320395e3ec854138e6ea5034dc79eebcbeb86da20000014701000000019a00000000000000019a9a9a9a9a9a9a9a9a9a9a9a9a9a9a9a9a9a9a9a9a9a9a9a9a9a9a9a9a9a9a9a0000000000000000000100000000000000000301202cee232f0cf383960ac375e090a647e2afd4ebeb12b4cecb1bf91c2e0f4b34080000000048484848484848484848484848484848484848484848484848484848484848480100101010101010101010101010101010101010101010101010101010101010101001000000000000001111111111111111111111111111111111111111111111111111111111111111121212121212121212121212121212121212121212121212121212121212121213131313131313131313131313131313131313131313131313131313131313130000000001000000029b0000000000000001abababababababababababababababababababababababababababababababab0000000000000000000000000000484848484848484848484848484848484848484848484848484848484848484800002cee232f0cf383960ac375e090a647e2afd4ebeb12b4cecb1bf91c2e0f4b340800014801000000019c00000000000000019c9c9c9c9c9c9c9c9c9c9c9c9c9c9c9c9c9c9c9c9c9c9c9c9c9c9c9c9c9c9c9c000000000000000000010000000000000000030120c837f30e97185c362830b324e58a3e6782095ee8457109b27f03819ff516e1210000000049494949494949494949494949494949494949494949494949494949494949490100101010101010101010101010101010101010101010101010101010101010101001000000000000001111111111111111111111111111111111111111111111111111111111111111121212121212121212121212121212121212121212121212121212121212121213131313131313131313131313131313131313131313131313131313131313130000000001000000029d0000000000000001adadadadadadadadadadadadadadadadadadadadadadadadadadadadadadadad000000000000000000000000000049494949494949494949494949494949494949494949494949494949494949490000c837f30e97185c362830b324e58a3e6782095ee8457109b27f03819ff516e12100014901000000019e00000000000000019e9e9e9e9e9e9e9e9e9e9e9e9e9e9e9e9e9e9e9e9e9e9e9e9e9e9e9e9e9e9e9e0000000000000000000100000000000000000301205445d3d5a46c7a99219705ea5b6daa7f870b83e721da6f252f2304b94a6d7d05000000004a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a0100101010101010101010101010101010101010101010101010101010101010101001000000000000001111111111111111111111111111111111111111111111111111111111111111121212121212121212121212121212121212121212121212121212121212121213131313131313131313131313131313131313131313131313131313131313130000000001000000029f0000000000000001afafafafafafafafafafafafafafafafafafafafafafafafafafafafafafafaf00000000000000000000000000004a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a00005445d3d5a46c7a99219705ea5b6daa7f870b83e721da6f252f2304b94a6d7d0500014a0100000001a00000000000000001a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0000000000000000000010000000000000000030120078773c4efc5ce946952e92d25f89d0cdd1603fc21842df386fa55855613e8cb000000004b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002a10000000000000001b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b100000000000000000000000000004b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b0000078773c4efc5ce946952e92d25f89d0cdd1603fc21842df386fa55855613e8cb00014b0100000001a20000000000000001a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2000000000000000000010000000000000000030120266ddf27cb4fb4223962ef29090bcc4f50e363bca75c581756f605fb91f9c7e8000000004c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002a30000000000000001b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b300000000000000000000000000004c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c0000266ddf27cb4fb4223962ef29090bcc4f50e363bca75c581756f605fb91f9c7e800014c0100000001a40000000000000001a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a4a40000000000000000000100000000000000000301206920014bef534e7eea89545a50d6aef0921f1972efcddce9f22f04a45b47d472000000004d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002a50000000000000001b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b500000000000000000000000000004d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d00006920014bef534e7eea89545a50d6aef0921f1972efcddce9f22f04a45b47d47200014d0100000001a60000000000000001a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6000000000000000000010000000000000000030120345baaa13bbe3a40695db7697fbe3f64206323b77cf3635902106f9f29667361000000004e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002a70000000000000001b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b700000000000000000000000000004e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e0000345baaa13bbe3a40695db7697fbe3f64206323b77cf3635902106f9f2966736100014e0100000001a80000000000000001a8a8a8a8a8a8a8a8a8a8a8a8a8a8a8a8a8a8a8a8a8a8a8a8a8a8a8a8a8a8a8a800000000000000000001000000000000000003012060c4004508ddcd8d1b0ea1c56ed1e5679d756d72e40f1a00820dbe5d9f69ff63000000004f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002a90000000000000001b9b9b9b9b9b9b9b9b9b9b9b9b9b9b9b9b9b9b9b9b9b9b9b9b9b9b9b9b9b9b9b900000000000000000000000000004f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f000060c4004508ddcd8d1b0ea1c56ed1e5679d756d72e40f1a00820dbe5d9f69ff6300014f0100000001aa0000000000000001aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa000000000000000000010000000000000000030120e5a73514ffed2f2f59b5112f4ae50cb138f1658633d354ac36c7c1bc019259d2000000005050505050505050505050505050505050505050505050505050505050505050010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002ab0000000000000001bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb000000000000000000000000000050505050505050505050505050505050505050505050505050505050505050500000e5a73514ffed2f2f59b5112f4ae50cb138f1658633d354ac36c7c1bc019259d20001500100000001ac0000000000000001acacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacac000000000000000000010000000000000000030120ba86a2a6dac23e336a34b4337eb740d40d900fae703bf55dcde8430208bb82e8000000005151515151515151515151515151515151515151515151515151515151515151010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002ad0000000000000001bdbdbdbdbdbdbdbdbdbdbdbdbdbdbdbdbdbdbdbdbdbdbdbdbdbdbdbdbdbdbdbd000000000000000000000000000051515151515151515151515151515151515151515151515151515151515151510000ba86a2a6dac23e336a34b4337eb740d40d900fae703bf55dcde8430208bb82e80001510100000001ae0000000000000001aeaeaeaeaeaeaeaeaeaeaeaeaeaeaeaeaeaeaeaeaeaeaeaeaeaeaeaeaeaeaeae000000000000000000010000000000000000030120d034b2b544e4ffb619a9c156ae578fe21f38eb0997f097ca9569807ca157f4f6000000005252525252525252525252525252525252525252525252525252525252525252010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002af0000000000000001bfbfbfbfbfbfbfbfbfbfbfbfbfbfbfbfbfbfbfbfbfbfbfbfbfbfbfbfbfbfbfbf000000000000000000000000000052525252525252525252525252525252525252525252525252525252525252520000d034b2b544e4ffb619a9c156ae578fe21f38eb0997f097ca9569807ca157f4f60001520100000001b00000000000000001b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0000000000000000000010000000000000000030120164a93c6619015a4ed2d50a49c0d98252296e3e4c7fa5277656188edb3fe71b7000000005353535353535353535353535353535353535353535353535353535353535353010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002b10000000000000001c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1c1000000000000000000000000000053535353535353535353535353535353535353535353535353535353535353530000164a93c6619015a4ed2d50a49c0d98252296e3e4c7fa5277656188edb3fe71b70001530100000001b20000000000000001b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2000000000000000000010000000000000000030120b3291957374e0a836351d5129cf45a5e0f73a92edff7b2c85ef159062301829e000000005454545454545454545454545454545454545454545454545454545454545454010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002b30000000000000001c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3000000000000000000000000000054545454545454545454545454545454545454545454545454545454545454540000b3291957374e0a836351d5129cf45a5e0f73a92edff7b2c85ef159062301829e0001540100000001b40000000000000001b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b4b400000000000000000001000000000000000003012078fe1396dda648dcbccc3c17af4cd29de873f2cdf5e4c5eb04e0ef08e86cc267000000005555555555555555555555555555555555555555555555555555555555555555010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002b50000000000000001c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c500000000000000000000000000005555555555555555555555555555555555555555555555555555555555555555000078fe1396dda648dcbccc3c17af4cd29de873f2cdf5e4c5eb04e0ef08e86cc2670001550100000001b60000000000000001b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b60000000000000000000100000000000000000301203eecb4a5c11c8bab18ddad1d268c827aaabb17c83f51869832a5af15efdedfcb000000005656565656565656565656565656565656565656565656565656565656565656010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002b70000000000000001c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c70000000000000000000000000000565656565656565656565656565656565656565656565656565656565656565600003eecb4a5c11c8bab18ddad1d268c827aaabb17c83f51869832a5af15efdedfcb0001560100000001b80000000000000001b8b8b8b8b8b8b8b8b8b8b8b8b8b8b8b8b8b8b8b8b8b8b8b8b8b8b8b8b8b8b8b80000000000000000000100000000000000000301204cea338a15eccf7f51d8297c2873b1c5d0e5bea7d52eb7e984500b0759937d0d000000005757575757575757575757575757575757575757575757575757575757575757010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002b90000000000000001c9c9c9c9c9c9c9c9c9c9c9c9c9c9c9c9c9c9c9c9c9c9c9c9c9c9c9c9c9c9c9c90000000000000000000000000000575757575757575757575757575757575757575757575757575757575757575700004cea338a15eccf7f51d8297c2873b1c5d0e5bea7d52eb7e984500b0759937d0d0001570100000001ba0000000000000001babababababababababababababababababababababababababababababababa00000000000000000001000000000000000003012031660a8aa8b0991f2d115272fecba9f9fe21e0798377c2b965405039319a1452000000005858585858585858585858585858585858585858585858585858585858585858010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002bb0000000000000001cbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcb00000000000000000000000000005858585858585858585858585858585858585858585858585858585858585858000031660a8aa8b0991f2d115272fecba9f9fe21e0798377c2b965405039319a14520001580100000001bc0000000000000001bcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbc00000000000000000001000000000000000003012008ad231c95c5b60ab9757d6f95672f4e8731910a8f4573a90a1798ee8127ee94000000005959595959595959595959595959595959595959595959595959595959595959010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002bd0000000000000001cdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcd00000000000000000000000000005959595959595959595959595959595959595959595959595959595959595959000008ad231c95c5b60ab9757d6f95672f4e8731910a8f4573a90a1798ee8127ee940001590100000001be0000000000000001bebebebebebebebebebebebebebebebebebebebebebebebebebebebebebebebe0000000000000000000100000000000000000301201fb80b3947f9fa50760bc627a0341d53715fb79013184b34f4c0a306b62fdf05000000005a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002bf0000000000000001cfcfcfcfcfcfcfcfcfcfcfcfcfcfcfcfcfcfcfcfcfcfcfcfcfcfcfcfcfcfcfcf00000000000000000000000000005a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a00001fb80b3947f9fa50760bc627a0341d53715fb79013184b34f4c0a306b62fdf0500015a0100000001c00000000000000001c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0000000000000000000010000000000000000030120a44269e96af4e6b2905af7ada5d04e6638f454b166e70f508364c9f8b2d0a321000000005b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002c10000000000000001d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d1d100000000000000000000000000005b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b0000a44269e96af4e6b2905af7ada5d04e6638f454b166e70f508364c9f8b2d0a32100015b0100000001c20000000000000001c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2000000000000000000010000000000000000030120803c621a10c78b864f94a5cf0426bcb72d0fcce9a9279f915fc3089554abcac3000000005c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002c30000000000000001d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d300000000000000000000000000005c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c5c0000803c621a10c78b864f94a5cf0426bcb72d0fcce9a9279f915fc3089554abcac300015c0100000001c40000000000000001c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c40000000000000000000100000000000000000301209977908d483ef0469047a111c03426cb1577ab1d0f3163d71be9890181eff870000000005d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002c50000000000000001d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d500000000000000000000000000005d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d00009977908d483ef0469047a111c03426cb1577ab1d0f3163d71be9890181eff87000015d0100000001c60000000000000001c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c60000000000000000000100000000000000000301201b5c1c2a9ba8cbfa56a190b1a1d9bebb263e213006b9a8a51de1778346f9e661000000005e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002c70000000000000001d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d700000000000000000000000000005e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e00001b5c1c2a9ba8cbfa56a190b1a1d9bebb263e213006b9a8a51de1778346f9e66100015e0100000001c80000000000000001c8c8c8c8c8c8c8c8c8c8c8c8c8c8c8c8c8c8c8c8c8c8c8c8c8c8c8c8c8c8c8c8000000000000000000010000000000000000030120b72b73f756be409451724bff061b449eb98785b2b5f9f77d9a68851e1b408199000000005f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002c90000000000000001d9d9d9d9d9d9d9d9d9d9d9d9d9d9d9d9d9d9d9d9d9d9d9d9d9d9d9d9d9d9d9d900000000000000000000000000005f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f0000b72b73f756be409451724bff061b449eb98785b2b5f9f77d9a68851e1b40819900015f0100000001ca0000000000000001cacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacaca00000000000000000001000000000000000003012074d0f5345a69a317de61309dd68507e8f17945cbf0762f81c2186e304bcef2a0000000006060606060606060606060606060606060606060606060606060606060606060010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002cb0000000000000001dbdbdbdbdbdbdbdbdbdbdbdbdbdbdbdbdbdbdbdbdbdbdbdbdbdbdbdbdbdbdbdb00000000000000000000000000006060606060606060606060606060606060606060606060606060606060606060000074d0f5345a69a317de61309dd68507e8f17945cbf0762f81c2186e304bcef2a00001600100000001cc0000000000000001cccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccc00000000000000000001000000000000000003012080084bf2fba02475726feb2cab2d8215eab14bc6bdd8bfb2c8151257032ecd8b000000006161616161616161616161616161616161616161616161616161616161616161010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002cd0000000000000001dddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddd00000000000000000000000000006161616161616161616161616161616161616161616161616161616161616161000080084bf2fba02475726feb2cab2d8215eab14bc6bdd8bfb2c8151257032ecd8b0001610100000001ce0000000000000001cececececececececececececececececececececececececececececececece000000000000000000010000000000000000030120b039179a8a4ce2c252aa6f2f25798251c19b75fc1508d9d511a191e0487d64a7000000006262626262626262626262626262626262626262626262626262626262626262010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002cf0000000000000001dfdfdfdfdfdfdfdfdfdfdfdfdfdfdfdfdfdfdfdfdfdfdfdfdfdfdfdfdfdfdfdf000000000000000000000000000062626262626262626262626262626262626262626262626262626262626262620000b039179a8a4ce2c252aa6f2f25798251c19b75fc1508d9d511a191e0487d64a70001620100000001d00000000000000001d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0000000000000000000010000000000000000030120263ab762270d3b73d3e2cddf9acc893bb6bd41110347e5d5e4bd1d3c128ea90a000000006363636363636363636363636363636363636363636363636363636363636363010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002d10000000000000001e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1e1000000000000000000000000000063636363636363636363636363636363636363636363636363636363636363630000263ab762270d3b73d3e2cddf9acc893bb6bd41110347e5d5e4bd1d3c128ea90a0001630100000001d20000000000000001d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d20000000000000000000100000000000000000301204ce8765e720c576f6f5a34ca380b3de5f0912e6e3cc5355542c363891e54594b000000006464646464646464646464646464646464646464646464646464646464646464010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002d30000000000000001e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e3e30000000000000000000000000000646464646464646464646464646464646464646464646464646464646464646400004ce8765e720c576f6f5a34ca380b3de5f0912e6e3cc5355542c363891e54594b0001640100000001d40000000000000001d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d4d400000000000000000001000000000000000003012042538602949f370aa331d2c07a1ee7ff26caac9cc676288f94b82eb2188b8465000000006565656565656565656565656565656565656565656565656565656565656565010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002d50000000000000001e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e500000000000000000000000000006565656565656565656565656565656565656565656565656565656565656565000042538602949f370aa331d2c07a1ee7ff26caac9cc676288f94b82eb2188b84650001650100000001d60000000000000001d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6000000000000000000010000000000000000030120a0b37b8bfae8e71330bd8e278e4a45ca916d00475dd8b85e9352533454c9fec8000000006666666666666666666666666666666666666666666666666666666666666666010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002d70000000000000001e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7000000000000000000000000000066666666666666666666666666666666666666666666666666666666666666660000a0b37b8bfae8e71330bd8e278e4a45ca916d00475dd8b85e9352533454c9fec80001660100000001d80000000000000001d8d8d8d8d8d8d8d8d8d8d8d8d8d8d8d8d8d8d8d8d8d8d8d8d8d8d8d8d8d8d8d80000000000000000000100000000000000000301209f2898da52dedaca29f05bcac0c8e43e4b9f7cb5707c14cc3f35a567232cec7c000000006767676767676767676767676767676767676767676767676767676767676767010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002d90000000000000001e9e9e9e9e9e9e9e9e9e9e9e9e9e9e9e9e9e9e9e9e9e9e9e9e9e9e9e9e9e9e9e90000000000000000000000000000676767676767676767676767676767676767676767676767676767676767676700009f2898da52dedaca29f05bcac0c8e43e4b9f7cb5707c14cc3f35a567232cec7c0001670100000001da0000000000000001dadadadadadadadadadadadadadadadadadadadadadadadadadadadadadadada0000000000000000000100000000000000000301205a082c81a7e4d5833ee20bd67d2f4d736f679da33e4bebd3838217cb27bec1d3000000006868686868686868686868686868686868686868686868686868686868686868010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002db0000000000000001ebebebebebebebebebebebebebebebebebebebebebebebebebebebebebebebeb0000000000000000000000000000686868686868686868686868686868686868686868686868686868686868686800005a082c81a7e4d5833ee20bd67d2f4d736f679da33e4bebd3838217cb27bec1d30001680100000001dc0000000000000001dcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdc000000000000000000010000000000000000030120bf872d20c4ef70ab19c9d413f172ce399a30ddeca771658561b1443111069c9e000000006969696969696969696969696969696969696969696969696969696969696969010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002dd0000000000000001edededededededededededededededededededededededededededededededed000000000000000000000000000069696969696969696969696969696969696969696969696969696969696969690000bf872d20c4ef70ab19c9d413f172ce399a30ddeca771658561b1443111069c9e0001690100000001de0000000000000001dededededededededededededededededededededededededededededededede000000000000000000010000000000000000030120f35e560e05de779f2669b9f513c2a7ab81dfeb100e2f4ee1fb17354bfa2740ca000000006a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002df0000000000000001efefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefef00000000000000000000000000006a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a6a0000f35e560e05de779f2669b9f513c2a7ab81dfeb100e2f4ee1fb17354bfa2740ca00016a0100000001e00000000000000001e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e0e00000000000000000000100000000000000000301207c712596135d13a73c0dd366151b9440f3e9072371b436371107f12b3d850180000000006b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002e10000000000000001f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f100000000000000000000000000006b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b6b00007c712596135d13a73c0dd366151b9440f3e9072371b436371107f12b3d85018000016b0100000001e20000000000000001e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e2e20000000000000000000100000000000000000301203e5e3e723953551a2ba2e7c5584bcc4ce407414af1ab2569051e7c9bfa33164d000000006c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002e30000000000000001f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f300000000000000000000000000006c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c6c00003e5e3e723953551a2ba2e7c5584bcc4ce407414af1ab2569051e7c9bfa33164d00016c0100000001e40000000000000001e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e40000000000000000000100000000000000000301201b42f48aa4371867a7c51ae6f237f35626e02c12eefa592614e1b10af7769370000000006d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002e50000000000000001f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f500000000000000000000000000006d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d6d00001b42f48aa4371867a7c51ae6f237f35626e02c12eefa592614e1b10af776937000016d0100000001e60000000000000001e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e60000000000000000000100000000000000000301208ee93ceda95bbe450f7fb53a700c56dfac4387e48eb127881a2a68727bc7810c000000006e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002e70000000000000001f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f700000000000000000000000000006e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e00008ee93ceda95bbe450f7fb53a700c56dfac4387e48eb127881a2a68727bc7810c00016e0100000001e80000000000000001e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e800000000000000000001000000000000000003012012c6debe02a118f89049700e723650d269838a76024a826607b163bc2a237031000000006f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002e90000000000000001f9f9f9f9f9f9f9f9f9f9f9f9f9f9f9f9f9f9f9f9f9f9f9f9f9f9f9f9f9f9f9f900000000000000000000000000006f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f000012c6debe02a118f89049700e723650d269838a76024a826607b163bc2a23703100016f0100000001ea0000000000000001eaeaeaeaeaeaeaeaeaeaeaeaeaeaeaeaeaeaeaeaeaeaeaeaeaeaeaeaeaeaeaea00000000000000000001000000000000000003012014c68e20d8ddb4dbd248ed14bdb2012cfcee23530af0f71328009d1e90bb36ac000000007070707070707070707070707070707070707070707070707070707070707070010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002eb0000000000000001fbfbfbfbfbfbfbfbfbfbfbfbfbfbfbfbfbfbfbfbfbfbfbfbfbfbfbfbfbfbfbfb00000000000000000000000000007070707070707070707070707070707070707070707070707070707070707070000014c68e20d8ddb4dbd248ed14bdb2012cfcee23530af0f71328009d1e90bb36ac0001700100000001ec0000000000000001ecececececececececececececececececececececececececececececececec0000000000000000000100000000000000000301208a5e1d339fafc39350fd8cf1d7ca7982091c27f6b77f75bd4ddab3df425b4f8c000000007171717171717171717171717171717171717171717171717171717171717171010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002ed0000000000000001fdfdfdfdfdfdfdfdfdfdfdfdfdfdfdfdfdfdfdfdfdfdfdfdfdfdfdfdfdfdfdfd0000000000000000000000000000717171717171717171717171717171717171717171717171717171717171717100008a5e1d339fafc39350fd8cf1d7ca7982091c27f6b77f75bd4ddab3df425b4f8c0001710100000001ee0000000000000001eeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee000000000000000000010000000000000000030120f695d5fe6e2c67fe29ccf09341c29ad58154c568c5917a919c31936a3c96d607000000007272727272727272727272727272727272727272727272727272727272727272010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002ef0000000000000001ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff000000000000000000000000000072727272727272727272727272727272727272727272727272727272727272720000f695d5fe6e2c67fe29ccf09341c29ad58154c568c5917a919c31936a3c96d6070001720100000001f00000000000000001f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0000000000000000000010000000000000000030120cdc56a5028e51232cb28194fb1eb93e7014d60fb7afb447a49a1e1aaa640c9a4000000007373737373737373737373737373737373737373737373737373737373737373010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002f100000000000000010101010101010101010101010101010101010101010101010101010101010101000000000000000000000000000073737373737373737373737373737373737373737373737373737373737373730000cdc56a5028e51232cb28194fb1eb93e7014d60fb7afb447a49a1e1aaa640c9a40001730100000001f20000000000000001f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2000000000000000000010000000000000000030120889729e8d2d8864a59db1e195ad67c76949578ff2b4637388564a81dd68fc01e000000007474747474747474747474747474747474747474747474747474747474747474010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002f300000000000000010303030303030303030303030303030303030303030303030303030303030303000000000000000000000000000074747474747474747474747474747474747474747474747474747474747474740000889729e8d2d8864a59db1e195ad67c76949578ff2b4637388564a81dd68fc01e0001740100000001f40000000000000001f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4000000000000000000010000000000000000030120d7e9468290673221249673d2b82c3cb316819a8496c2f2dba3eaebd9477af44c000000007575757575757575757575757575757575757575757575757575757575757575010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002f500000000000000010505050505050505050505050505050505050505050505050505050505050505000000000000000000000000000075757575757575757575757575757575757575757575757575757575757575750000d7e9468290673221249673d2b82c3cb316819a8496c2f2dba3eaebd9477af44c0001750100000001f60000000000000001f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6f6000000000000000000010000000000000000030120453c8391bbd41309b79d7acc1382c2b0fb5f6b67f686d77c410666336ff9dabb000000007676767676767676767676767676767676767676767676767676767676767676010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002f700000000000000010707070707070707070707070707070707070707070707070707070707070707000000000000000000000000000076767676767676767676767676767676767676767676767676767676767676760000453c8391bbd41309b79d7acc1382c2b0fb5f6b67f686d77c410666336ff9dabb0001760100000001f80000000000000001f8f8f8f8f8f8f8f8f8f8f8f8f8f8f8f8f8f8f8f8f8f8f8f8f8f8f8f8f8f8f8f8000000000000000000010000000000000000030120f1cfdca558ac0c00464ca0f3e265ec6fb32c57caeb106fbfed9f174f6b814642000000007777777777777777777777777777777777777777777777777777777777777777010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002f900000000000000010909090909090909090909090909090909090909090909090909090909090909000000000000000000000000000077777777777777777777777777777777777777777777777777777777777777770000f1cfdca558ac0c00464ca0f3e265ec6fb32c57caeb106fbfed9f174f6b8146420001770100000001fa0000000000000001fafafafafafafafafafafafafafafafafafafafafafafafafafafafafafafafa000000000000000000010000000000000000030120741efa311f97686956946758e0d95f70f11ff2da4f2feb7c54314f44134ac49f000000007878787878787878787878787878787878787878787878787878787878787878010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002fb00000000000000010b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b000000000000000000000000000078787878787878787878787878787878787878787878787878787878787878780000741efa311f97686956946758e0d95f70f11ff2da4f2feb7c54314f44134ac49f0001780100000001fc0000000000000001fcfcfcfcfcfcfcfcfcfcfcfcfcfcfcfcfcfcfcfcfcfcfcfcfcfcfcfcfcfcfcfc0000000000000000000100000000000000000301209d0f3db671f9fb22104b984763616732d383154a7a0dcdbb9ec17ab647b64961000000007979797979797979797979797979797979797979797979797979797979797979010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002fd00000000000000010d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0d0000000000000000000000000000797979797979797979797979797979797979797979797979797979797979797900009d0f3db671f9fb22104b984763616732d383154a7a0dcdbb9ec17ab647b649610001790100000001fe0000000000000001fefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefe0000000000000000000100000000000000000301203b4aed1c401f71809c93e713f4b86fb6d56c5b668f4ad8b474cb8884756aac46000000007a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002ff00000000000000010f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f00000000000000000000000000007a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a7a00003b4aed1c401f71809c93e713f4b86fb6d56c5b668f4ad8b474cb8884756aac4600017a01000000010001000000000000010000000000000000000000000000000000000000000000000000000000000000000000000000000000010000000000000000030120fdce65e59494d92ac51f0e66404353bd53f4d2c3af800800c6dfb2be4d48e329000000007b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002010100000000000001111111111111111111111111111111111111111111111111111111111111111100000000000000000000000000007b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b0000fdce65e59494d92ac51f0e66404353bd53f4d2c3af800800c6dfb2be4d48e32900017b01000000010201000000000000010202020202020202020202020202020202020202020202020202020202020202000000000000000000010000000000000000030120687111e80e745704574be9ab7d591cea66ac90cf9d7a56ef413b3e6f446e4ec9000000007c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002030100000000000001131313131313131313131313131313131313131313131313131313131313131300000000000000000000000000007c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c7c0000687111e80e745704574be9ab7d591cea66ac90cf9d7a56ef413b3e6f446e4ec900017c010000000104010000000000000104040404040404040404040404040404040404040404040404040404040404040000000000000000000100000000000000000301205bf60471f2106089a88d2d95b0460d4a0ee215a54f08f73aae45afe86f72f12e000000007d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002050100000000000001151515151515151515151515151515151515151515151515151515151515151500000000000000000000000000007d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d7d00005bf60471f2106089a88d2d95b0460d4a0ee215a54f08f73aae45afe86f72f12e00017d01000000010601000000000000010606060606060606060606060606060606060606060606060606060606060606000000000000000000010000000000000000030120aab7e55cbe6cb705810bdc48b60ed00fa2e158e1a7a816a611272e81b3e677d6000000007e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002070100000000000001171717171717171717171717171717171717171717171717171717171717171700000000000000000000000000007e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e7e0000aab7e55cbe6cb705810bdc48b60ed00fa2e158e1a7a816a611272e81b3e677d600017e01000000010801000000000000010808080808080808080808080808080808080808080808080808080808080808000000000000000000010000000000000000030120aac68691d102829ac973f5b44c26165aa4e29cd498aff642a08944645d6ca5bd000000007f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f010010101010101010101010101010101010101010101010101010101010101010100100000000000000111111111111111111111111111111111111111111111111111111111111111112121212121212121212121212121212121212121212121212121212121212121313131313131313131313131313131313131313131313131313131313131313000000000100000002090100000000000001191919191919191919191919191919191919191919191919191919191919191900000000000000000000000000007f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f0000aac68691d102829ac973f5b44c26165aa4e29cd498aff642a08944645d6ca5bd00017f01000000010a01000000000000010a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a000000000000000000010000000000000000030120bc2071a4de846f285702447f2589dd163678e0972a8a1b0d28b04ed5c094547f0000000080808080808080808080808080808080808080808080808080808080808080800100101010101010101010101010101010101010101010101010101010101010101001000000000000001111111111111111111111111111111111111111111111111111111111111111121212121212121212121212121212121212121212121212121212121212121213131313131313131313131313131313131313131313131313131313131313130000000001000000020b01000000000000011b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b000000000000000000000000000080808080808080808080808080808080808080808080808080808080808080800000bc2071a4de846f285702447f2589dd163678e0972a8a1b0d28b04ed5c094547f000180", expectedPrevHashHex := some ("0x9191919191919191919191919191919191919191919191919191919191919191"), expectedTargetHex := some ("0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"), height := 1, alreadyGenerated := 0, utxos := [], expectOk := false, expectErr := some "BLOCK_ERR_DA_BATCH_EXCEEDED" },
   { id := "CV-B-13", op := .block_basic_check, blockHex := "0x01000000111111111111111111111111111111111111111111111111111111111111111102e66000bf8ce870908df4a8689554852ccef681ee0b5df32246162a53e36e290100000000000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff070000000000000000", expectedPrevHashHex := some ("0x1111111111111111111111111111111111111111111111111111111111111111"), expectedTargetHex := some ("0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"), height := 0, alreadyGenerated := 0, utxos := [], expectOk := false, expectErr := some "BLOCK_ERR_COINBASE_INVALID" },
   { id := "CV-B-14", op := .block_basic_check, blockHex := "0x01000000a100000000000000000000000000000000000000000000000000000000000000df620ead985de13cb1a03cbd8b5082ebf7185a84e3a3880fa868d67fe6913e990100000000000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff29000000000000000301000000000000000000000000010000000000000000000000000000000000000000000000000000000000000000ffffffff00ffffffff010000000000000000020020f8befc61b302995f7b509d68aa5524c37ca8b542d6b58ae4c884f5ed4c493683010000000000010000000001000000000000000100000000000000000000000000000000000000000000000000000000000000000000000000000000000300000000000000000200fd50c3404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404040404000000000000000000200fd50c3414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414141414100000000000000000200fd50c342424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242420000000000000100000000010000000000000001000000000000000000000000000000000000000000000000000000000000000000000000000000000001010000000000000000002101e2929f80ffdaaa51c427cbf42df6679013fddb1630bc28f234ca48692b353992000000000000", expectedPrevHashHex := some ("0xa100000000000000000000000000000000000000000000000000000000000000"), expectedTargetHex := some ("0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"), height := 1, alreadyGenerated := 0, utxos := [], expectOk := false, expectErr := some "BLOCK_ERR_ANCHOR_BYTES_EXCEEDED" },
-  { id := "CV-B-15", op := .connect_block_basic, blockHex := "0x01000000b100000000000000000000000000000000000000000000000000000000000000ea2d44ebeaeea41c9d2ba7e5927da5a8ce881c2ce17148c74ed6b426432c5a880100000000000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff33000000000000000101000000000000000000000000010000000000000000000000000000000000000000000000000000000000000000ffffffff00ffffffff020100000000000000010164999999999999999999999999999999999999999999999999999999999999999901011111111111111111111111111111111111111111111111111111111111111111010022222222222222222222222222222222222222222222222222222222222222220000000000000000020020b716a4b7f4c0fab665298ab9b8199b601ab9fa7e0a27f0713383f34cf37071a8010000000000", expectedPrevHashHex := some ("0xb100000000000000000000000000000000000000000000000000000000000000"), expectedTargetHex := some ("0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"), height := 1, alreadyGenerated := 0, utxos := [], expectOk := false, expectErr := some "BLOCK_ERR_COINBASE_INVALID" }
+  { id := "CV-B-15", op := .connect_block_basic, blockHex := "0x01000000b100000000000000000000000000000000000000000000000000000000000000ea2d44ebeaeea41c9d2ba7e5927da5a8ce881c2ce17148c74ed6b426432c5a880100000000000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff33000000000000000101000000000000000000000000010000000000000000000000000000000000000000000000000000000000000000ffffffff00ffffffff020100000000000000010164999999999999999999999999999999999999999999999999999999999999999901011111111111111111111111111111111111111111111111111111111111111111010022222222222222222222222222222222222222222222222222222222222222220000000000000000020020b716a4b7f4c0fab665298ab9b8199b601ab9fa7e0a27f0713383f34cf37071a8010000000000", expectedPrevHashHex := some ("0xb100000000000000000000000000000000000000000000000000000000000000"), expectedTargetHex := some ("0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"), height := 1, alreadyGenerated := 0, utxos := [], expectOk := false, expectErr := some "BLOCK_ERR_COINBASE_INVALID" },
+  { id := "CV-B-16", op := .block_basic_check, blockHex := "0x01000000c100000000000000000000000000000000000000000000000000000000000000f99b96183d413130cb5472530fb9062ea2c13d90cd74f62654ae6f6cb721c1720100000000000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff2a000000000000001101000000000000000000000000010000000000000000000000000000000000000000000000000000000000000000ffffffff00ffffffff010000000000000000020020daf5332eb768dca2c6378b599e13ce6cdaa7b6f66b5739428bb819a9c1b131ef0100000000000100000000010000000000000001010000000000000000000000000000000000000000000000000000000000000000000000000000000001010000000000000000002101000000000000000000000000000000000000000000000000000000000000000000000000fd00040201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101000100000000020000000000000001020000000000000000000000000000000000000000000000000000000000000000000000000000000001010000000000000000002101000000000000000000000000000000000000000000000000000000000000000000000000fd00040201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101000100000000030000000000000001030000000000000000000000000000000000000000000000000000000000000000000000000000000001010000000000000000002101000000000000000000000000000000000000000000000000000000000000000000000000fd00040201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101000100000000040000000000000001040000000000000000000000000000000000000000000000000000000000000000000000000000000001010000000000000000002101000000000000000000000000000000000000000000000000000000000000000000000000fd00040201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101000100000000050000000000000001050000000000000000000000000000000000000000000000000000000000000000000000000000000001010000000000000000002101000000000000000000000000000000000000000000000000000000000000000000000000fd00040201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101000100000000060000000000000001060000000000000000000000000000000000000000000000000000000000000000000000000000000001010000000000000000002101000000000000000000000000000000000000000000000000000000000000000000000000fd00040201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101000100000000070000000000000001070000000000000000000000000000000000000000000000000000000000000000000000000000000001010000000000000000002101000000000000000000000000000000000000000000000000000000000000000000000000fd00040201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101000100000000080000000000000001080000000000000000000000000000000000000000000000000000000000000000000000000000000001010000000000000000002101000000000000000000000000000000000000000000000000000000000000000000000000fd00040201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101000100000000090000000000000001090000000000000000000000000000000000000000000000000000000000000000000000000000000001010000000000000000002101000000000000000000000000000000000000000000000000000000000000000000000000fd000402010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010001000000000a00000000000000010a0000000000000000000000000000000000000000000000000000000000000000000000000000000001010000000000000000002101000000000000000000000000000000000000000000000000000000000000000000000000fd000402010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010001000000000b00000000000000010b0000000000000000000000000000000000000000000000000000000000000000000000000000000001010000000000000000002101000000000000000000000000000000000000000000000000000000000000000000000000fd000402010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010001000000000c00000000000000010c0000000000000000000000000000000000000000000000000000000000000000000000000000000001010000000000000000002101000000000000000000000000000000000000000000000000000000000000000000000000fd000402010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010001000000000d00000000000000010d0000000000000000000000000000000000000000000000000000000000000000000000000000000001010000000000000000002101000000000000000000000000000000000000000000000000000000000000000000000000fd000402010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010001000000000e00000000000000010e0000000000000000000000000000000000000000000000000000000000000000000000000000000001010000000000000000002101000000000000000000000000000000000000000000000000000000000000000000000000fd000402010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010001000000000f00000000000000010f0000000000000000000000000000000000000000000000000000000000000000000000000000000001010000000000000000002101000000000000000000000000000000000000000000000000000000000000000000000000fd00040201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101000100000000100000000000000001100000000000000000000000000000000000000000000000000000000000000000000000000000000001010000000000000000002101000000000000000000000000000000000000000000000000000000000000000000000000fd0004020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010102010101010201010101020101010100", expectedPrevHashHex := some ("0xc100000000000000000000000000000000000000000000000000000000000000"), expectedTargetHex := some ("0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"), height := 1, alreadyGenerated := 0, utxos := [], expectOk := false, expectErr := some "BLOCK_ERR_WEIGHT_EXCEEDED" }
 ]
 
 end RubinFormal.Conformance
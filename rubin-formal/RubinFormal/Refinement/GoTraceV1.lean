@@ -67,7 +67,7 @@ structure SimplicityExecOut where
   accepted : Option Bool
   finalCounter : Option Nat
 
-def goTraceFixturesDigestSHA3_256 : String := "04e1ddb443219bb2b2fc371e405cd54c1fd3f60a8d135a33382ed451a8a3c43a"
+def goTraceFixturesDigestSHA3_256 : String := "2a7bd23e562c2a2c57c990787f1a925fc60fb30ec8084914f85a26d3850521d1"
 
 def parseOuts : List ParseOut := [
   { id := "PARSE-01", ok := true, err := "", consumed := 21, txidHex := "0xd205b2f6296a4cc1e4ec65d1b80309ed98d3a1c03d241c675ff761c6a4502bc0", wtxidHex := "0xf760a70e1e838404d8e41679962064dc1bf4fa181699009644a14d0aa389ab4e" },